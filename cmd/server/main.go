@@ -14,6 +14,7 @@ import (
 	"github.com/yourusername/issue-tracker/internal/api"
 	"github.com/yourusername/issue-tracker/pkg/cache"
 	"github.com/yourusername/issue-tracker/pkg/database"
+	"github.com/yourusername/issue-tracker/pkg/ldap"
 )
 
 // @title Flow Issue Tracker API
@@ -81,6 +82,27 @@ func main() {
 		SMTPUsername:         config.SMTPUsername,
 		SMTPPassword:         config.SMTPPassword,
 		SMTPFrom:             config.SMTPFrom,
+		LDAP: ldap.Config{
+			URL:                config.LDAPURL,
+			BindDN:             config.LDAPBindDN,
+			BindPassword:       config.LDAPBindPassword,
+			BaseDN:             config.LDAPBaseDN,
+			UserFilter:         config.LDAPUserFilter,
+			StartTLS:           config.LDAPStartTLS,
+			InsecureSkipVerify: config.LDAPInsecureSkipVerify,
+			Attributes: ldap.AttributeMapping{
+				ID:       config.LDAPAttrID,
+				Username: config.LDAPAttrUsername,
+				Email:    config.LDAPAttrEmail,
+				Name:     config.LDAPAttrName,
+			},
+		},
+		LDAPLinkExistingAccounts: config.LDAPLinkExistingAccounts,
+
+		ReminderInterval:   config.ReminderInterval,
+		ReminderDaysBefore: config.ReminderDaysBefore,
+		TrashRetention:     config.TrashRetention,
+		TrashPurgeInterval: config.TrashPurgeInterval,
 	})
 
 	// Create HTTP server
@@ -141,6 +163,30 @@ type Config struct {
 	SMTPFrom           string
 	StoragePath        string
 	StorageMaxFileSize int64
+
+	// Due date reminders (disabled when ReminderInterval is zero)
+	ReminderInterval   time.Duration
+	ReminderDaysBefore int
+
+	// Trash retention (disabled when TrashRetention is zero)
+	TrashRetention     time.Duration
+	TrashPurgeInterval time.Duration
+
+	// LDAP authentication (disabled when LDAPURL is empty)
+	LDAPURL                string
+	LDAPBindDN             string
+	LDAPBindPassword       string
+	LDAPBaseDN             string
+	LDAPUserFilter         string
+	LDAPStartTLS           bool
+	LDAPInsecureSkipVerify bool
+	LDAPAttrID             string
+	LDAPAttrUsername       string
+	LDAPAttrEmail          string
+	LDAPAttrName           string
+
+	// Link local accounts to LDAP entries with the same email on first login
+	LDAPLinkExistingAccounts bool
 }
 
 // loadConfig loads configuration from environment variables
@@ -167,6 +213,30 @@ func loadConfig() Config {
 		SMTPFrom:           getEnv("SMTP_FROM", "noreply@issuetracker.com"),
 		StoragePath:        getEnv("STORAGE_PATH", "./uploads"),
 		StorageMaxFileSize: parseInt64(getEnv("STORAGE_MAX_FILE_SIZE", "10485760"), 10*1024*1024), // 10MB
+
+		// Due date reminders (disabled when REMINDER_INTERVAL is 0)
+		ReminderInterval:   parseDuration(getEnv("REMINDER_INTERVAL", "15m"), 15*time.Minute),
+		ReminderDaysBefore: parseInt(getEnv("REMINDER_DAYS_BEFORE", "1"), 1),
+
+		// Trash retention in days (deleted issues are kept forever when TRASH_RETENTION_DAYS is 0)
+		TrashRetention:     time.Duration(parseInt(getEnv("TRASH_RETENTION_DAYS", "30"), 30)) * 24 * time.Hour,
+		TrashPurgeInterval: parseDuration(getEnv("TRASH_PURGE_INTERVAL", "1h"), time.Hour),
+
+		// LDAP authentication (disabled when LDAP_URL is empty)
+		LDAPURL:                getEnv("LDAP_URL", ""),
+		LDAPBindDN:             getEnv("LDAP_BIND_DN", ""),
+		LDAPBindPassword:       getEnv("LDAP_BIND_PASSWORD", ""),
+		LDAPBaseDN:             getEnv("LDAP_BASE_DN", ""),
+		LDAPUserFilter:         getEnv("LDAP_USER_FILTER", "(uid=%s)"),
+		LDAPStartTLS:           parseBool(getEnv("LDAP_START_TLS", "false"), false),
+		LDAPInsecureSkipVerify: parseBool(getEnv("LDAP_INSECURE_SKIP_VERIFY", "false"), false),
+		LDAPAttrID:             getEnv("LDAP_ATTR_ID", "entryUUID"),
+		LDAPAttrUsername:       getEnv("LDAP_ATTR_USERNAME", "uid"),
+		LDAPAttrEmail:          getEnv("LDAP_ATTR_EMAIL", "mail"),
+		LDAPAttrName:           getEnv("LDAP_ATTR_NAME", "cn"),
+
+		// Let LDAP logins take over local accounts with the same email (off by default)
+		LDAPLinkExistingAccounts: parseBool(getEnv("LDAP_LINK_EXISTING_ACCOUNTS", "false"), false),
 	}
 }
 
@@ -0,0 +1,150 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/yourusername/issue-tracker/internal/api/middleware"
+	"github.com/yourusername/issue-tracker/internal/models"
+	"github.com/yourusername/issue-tracker/internal/service"
+	pkgerrors "github.com/yourusername/issue-tracker/pkg/errors"
+)
+
+// AccountHandler handles personal data export and account deletion requests
+type AccountHandler struct {
+	accountService *service.AccountService
+}
+
+// NewAccountHandler creates a new account handler
+func NewAccountHandler(accountService *service.AccountService) *AccountHandler {
+	return &AccountHandler{
+		accountService: accountService,
+	}
+}
+
+// RequestExport handles starting a personal data export
+// @Summary Export personal data
+// @Description Asynchronously builds a ZIP archive with the user's profile, issues, comments, reactions, watches, notifications, mentions and attachments
+// @Tags account
+// @Security BearerAuth
+// @Produce json
+// @Success 202 {object} models.UserExport
+// @Router /users/me/export [post]
+func (h *AccountHandler) RequestExport(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value(middleware.UserIDContextKey).(int)
+
+	export, err := h.accountService.RequestExport(r.Context(), userID)
+	if err != nil {
+		respondServiceError(w, err, errorMessages{
+			NotFound:  "Export not found",
+			Forbidden: "Operation not allowed for this account",
+			Internal:  "Failed to start export",
+		})
+		return
+	}
+
+	respondJSON(w, http.StatusAccepted, export)
+}
+
+// GetExport handles retrieving the status of a personal data export
+// @Summary Get export status
+// @Tags account
+// @Security BearerAuth
+// @Produce json
+// @Param id path int true "Export ID"
+// @Success 200 {object} models.UserExport
+// @Router /users/me/exports/{id} [get]
+func (h *AccountHandler) GetExport(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value(middleware.UserIDContextKey).(int)
+
+	exportID, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid export ID")
+		return
+	}
+
+	export, err := h.accountService.GetExport(r.Context(), userID, exportID)
+	if err != nil {
+		respondServiceError(w, err, errorMessages{
+			NotFound:  "Export not found",
+			Forbidden: "Operation not allowed for this account",
+			Internal:  "Failed to get export",
+		})
+		return
+	}
+
+	respondJSON(w, http.StatusOK, export)
+}
+
+// DownloadExport handles the one-time download of a completed export
+// @Summary Download export archive
+// @Description The archive can be downloaded once and is deleted afterwards. An interrupted download can be retried
+// @Tags account
+// @Security BearerAuth
+// @Produce application/zip
+// @Param id path int true "Export ID"
+// @Success 200 {file} binary
+// @Router /users/me/exports/{id}/download [get]
+func (h *AccountHandler) DownloadExport(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value(middleware.UserIDContextKey).(int)
+
+	exportID, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid export ID")
+		return
+	}
+
+	file, err := h.accountService.DownloadExport(r.Context(), userID, exportID)
+	if err != nil {
+		if err == pkgerrors.ErrNotFound {
+			respondError(w, http.StatusNotFound, "Export not found, not ready, being downloaded or already downloaded")
+			return
+		}
+		respondServiceError(w, err, errorMessages{
+			NotFound:  "Export not found",
+			Forbidden: "Operation not allowed for this account",
+			Internal:  "Failed to download export",
+		})
+		return
+	}
+	defer file.Close()
+
+	w.Header().Set("Content-Type", "application/zip")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"export-%d.zip\"", exportID))
+
+	// Headers are already sent, so a copy error cannot be reported
+	_, _ = io.Copy(w, file)
+}
+
+// DeleteAccount handles deleting the current user's account
+// @Summary Delete account
+// @Description Anonymizes authored content to a "Deleted user" placeholder and deletes the account. Project ownership must be transferred first.
+// @Tags account
+// @Security BearerAuth
+// @Accept json
+// @Param request body models.DeleteAccountRequest true "Confirmation"
+// @Success 204 "No Content"
+// @Router /users/me [delete]
+func (h *AccountHandler) DeleteAccount(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value(middleware.UserIDContextKey).(int)
+
+	var req models.DeleteAccountRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	if err := h.accountService.DeleteAccount(r.Context(), userID, &req); err != nil {
+		respondServiceError(w, err, errorMessages{
+			NotFound:  "User not found",
+			Forbidden: "Operation not allowed for this account",
+			Internal:  "Failed to delete account",
+		})
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -59,8 +59,12 @@ func (h *AuthHandler) Login(w http.ResponseWriter, r *http.Request) {
 
 	response, err := h.authService.Login(r.Context(), &req)
 	if err != nil {
-		if err == pkgerrors.ErrInvalidCredentials {
+		if appErr, ok := err.(*pkgerrors.AppError); ok {
+			respondAppError(w, appErr)
+		} else if err == pkgerrors.ErrInvalidCredentials {
 			respondError(w, http.StatusUnauthorized, "Invalid credentials")
+		} else if err == pkgerrors.ErrConflict {
+			respondError(w, http.StatusConflict, "A local account already uses this email; ask an administrator to link it")
 		} else {
 			respondError(w, http.StatusInternalServerError, "Internal server error")
 		}
@@ -126,7 +130,9 @@ func (h *AuthHandler) SearchUsers(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
-	users, err := h.authService.SearchUsers(r.Context(), query, limit)
+	includeBots := r.URL.Query().Get("include_bots") == "true"
+
+	users, err := h.authService.SearchUsers(r.Context(), query, limit, includeBots)
 	if err != nil {
 		respondError(w, http.StatusInternalServerError, "Failed to search users")
 		return
@@ -191,3 +197,55 @@ func respondError(w http.ResponseWriter, status int, message string) {
 		},
 	})
 }
+
+// respondAppError writes an AppError, including its code when set
+func respondAppError(w http.ResponseWriter, appErr *pkgerrors.AppError) {
+	if appErr.Code == "" {
+		respondError(w, appErr.StatusCode, appErr.Message)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(appErr.StatusCode)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"error": map[string]interface{}{
+			"code":    appErr.Code,
+			"message": appErr.Message,
+		},
+	})
+}
+
+// errorMessages are the messages for the sentinel service errors of one call;
+// empty messages fall back to a generic one for the status
+type errorMessages struct {
+	NotFound   string
+	Forbidden  string
+	Conflict   string
+	Validation string
+	Internal   string
+}
+
+// respondServiceError writes the response for a service error: an AppError
+// with its own status and message, a sentinel error with the call's message
+func respondServiceError(w http.ResponseWriter, err error, messages errorMessages) {
+	if appErr, ok := err.(*pkgerrors.AppError); ok {
+		respondAppError(w, appErr)
+		return
+	}
+
+	status, message, fallback := http.StatusInternalServerError, messages.Internal, "Internal server error"
+	switch err {
+	case pkgerrors.ErrNotFound:
+		status, message, fallback = http.StatusNotFound, messages.NotFound, "Not found"
+	case pkgerrors.ErrForbidden:
+		status, message, fallback = http.StatusForbidden, messages.Forbidden, "Access denied"
+	case pkgerrors.ErrConflict:
+		status, message, fallback = http.StatusConflict, messages.Conflict, "Conflict"
+	case pkgerrors.ErrValidation:
+		status, message, fallback = http.StatusBadRequest, messages.Validation, "Validation error"
+	}
+	if message == "" {
+		message = fallback
+	}
+	respondError(w, status, message)
+}
@@ -75,6 +75,10 @@ func (h *BoardHandler) Create(w http.ResponseWriter, r *http.Request) {
 
 	column, err := h.boardService.CreateColumn(r.Context(), projectID, &req, userID)
 	if err != nil {
+		if appErr, ok := err.(*pkgerrors.AppError); ok {
+			respondError(w, appErr.StatusCode, appErr.Message)
+			return
+		}
 		if err == pkgerrors.ErrNotFound {
 			respondError(w, http.StatusNotFound, "Project not found")
 			return
@@ -109,6 +113,10 @@ func (h *BoardHandler) Update(w http.ResponseWriter, r *http.Request) {
 
 	column, err := h.boardService.UpdateColumn(r.Context(), id, &req, userID)
 	if err != nil {
+		if appErr, ok := err.(*pkgerrors.AppError); ok {
+			respondError(w, appErr.StatusCode, appErr.Message)
+			return
+		}
 		if err == pkgerrors.ErrNotFound {
 			respondError(w, http.StatusNotFound, "Board column not found")
 			return
@@ -151,3 +159,270 @@ func (h *BoardHandler) Delete(w http.ResponseWriter, r *http.Request) {
 
 	w.WriteHeader(http.StatusNoContent)
 }
+
+// ListWIPOverrides handles listing the audited WIP limit overrides of a column
+func (h *BoardHandler) ListWIPOverrides(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value(middleware.UserIDContextKey).(int)
+
+	idStr := r.PathValue("id")
+	id, err := strconv.Atoi(idStr)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid column ID")
+		return
+	}
+
+	overrides, err := h.boardService.ListWIPOverrides(r.Context(), id, userID)
+	if err != nil {
+		if appErr, ok := err.(*pkgerrors.AppError); ok {
+			respondError(w, appErr.StatusCode, appErr.Message)
+			return
+		}
+		if err == pkgerrors.ErrNotFound {
+			respondError(w, http.StatusNotFound, "Board column not found")
+			return
+		}
+		if err == pkgerrors.ErrForbidden {
+			respondError(w, http.StatusForbidden, "Access denied")
+			return
+		}
+		respondError(w, http.StatusInternalServerError, "Failed to list WIP overrides")
+		return
+	}
+
+	respondJSON(w, http.StatusOK, overrides)
+}
+
+// CreateBoard handles creating a board
+// @Summary Create a board
+// @Description Creates a board with its own columns, saved filter and swimlanes; without columns the default board's columns are copied
+// @Tags boards
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param projectId path int true "Project ID"
+// @Param request body models.CreateBoardRequest true "Board"
+// @Success 201 {object} models.Board
+// @Router /projects/{projectId}/boards [post]
+func (h *BoardHandler) CreateBoard(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value(middleware.UserIDContextKey).(int)
+
+	projectID, err := strconv.Atoi(r.PathValue("projectId"))
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid project ID")
+		return
+	}
+
+	var req models.CreateBoardRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	board, err := h.boardService.CreateBoard(r.Context(), projectID, &req, userID)
+	if err != nil {
+		respondServiceError(w, err, errorMessages{
+			NotFound:   "Board not found",
+			Conflict:   "A board with this name already exists",
+			Validation: "Columns must have distinct positions",
+			Internal:   "Failed to create board",
+		})
+		return
+	}
+
+	respondJSON(w, http.StatusCreated, board)
+}
+
+// ListBoards handles listing the boards of a project
+// @Summary List boards
+// @Tags boards
+// @Security BearerAuth
+// @Produce json
+// @Param projectId path int true "Project ID"
+// @Success 200 {array} models.Board
+// @Router /projects/{projectId}/boards [get]
+func (h *BoardHandler) ListBoards(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value(middleware.UserIDContextKey).(int)
+
+	projectID, err := strconv.Atoi(r.PathValue("projectId"))
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid project ID")
+		return
+	}
+
+	boards, err := h.boardService.ListBoards(r.Context(), projectID, userID)
+	if err != nil {
+		respondServiceError(w, err, errorMessages{
+			NotFound: "Board not found",
+			Internal: "Failed to list boards",
+		})
+		return
+	}
+
+	respondJSON(w, http.StatusOK, boards)
+}
+
+// GetBoard handles getting a board with its columns
+// @Summary Get a board
+// @Tags boards
+// @Security BearerAuth
+// @Produce json
+// @Param id path int true "Board ID"
+// @Success 200 {object} models.Board
+// @Router /boards/{id} [get]
+func (h *BoardHandler) GetBoard(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value(middleware.UserIDContextKey).(int)
+
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid board ID")
+		return
+	}
+
+	board, err := h.boardService.GetBoard(r.Context(), id, userID)
+	if err != nil {
+		respondServiceError(w, err, errorMessages{
+			NotFound: "Board not found",
+			Internal: "Failed to get board",
+		})
+		return
+	}
+
+	respondJSON(w, http.StatusOK, board)
+}
+
+// UpdateBoard handles updating a board
+// @Summary Update a board
+// @Tags boards
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path int true "Board ID"
+// @Param request body models.UpdateBoardRequest true "Board changes"
+// @Success 200 {object} models.Board
+// @Router /boards/{id} [put]
+func (h *BoardHandler) UpdateBoard(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value(middleware.UserIDContextKey).(int)
+
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid board ID")
+		return
+	}
+
+	var req models.UpdateBoardRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	board, err := h.boardService.UpdateBoard(r.Context(), id, &req, userID)
+	if err != nil {
+		respondServiceError(w, err, errorMessages{
+			NotFound: "Board not found",
+			Conflict: "A board with this name already exists",
+			Internal: "Failed to update board",
+		})
+		return
+	}
+
+	respondJSON(w, http.StatusOK, board)
+}
+
+// DeleteBoard handles deleting a board
+// @Summary Delete a board
+// @Tags boards
+// @Security BearerAuth
+// @Param id path int true "Board ID"
+// @Success 204 "No Content"
+// @Router /boards/{id} [delete]
+func (h *BoardHandler) DeleteBoard(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value(middleware.UserIDContextKey).(int)
+
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid board ID")
+		return
+	}
+
+	if err := h.boardService.DeleteBoard(r.Context(), id, userID); err != nil {
+		respondServiceError(w, err, errorMessages{
+			NotFound: "Board not found",
+			Internal: "Failed to delete board",
+		})
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// CreateBoardColumn handles adding a column to a board
+// @Summary Add a board column
+// @Tags boards
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path int true "Board ID"
+// @Param request body models.CreateBoardColumnRequest true "Column"
+// @Success 201 {object} models.BoardColumn
+// @Router /boards/{id}/columns [post]
+func (h *BoardHandler) CreateBoardColumn(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value(middleware.UserIDContextKey).(int)
+
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid board ID")
+		return
+	}
+
+	var req models.CreateBoardColumnRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	if req.Name == "" {
+		respondError(w, http.StatusBadRequest, "Name is required")
+		return
+	}
+
+	column, err := h.boardService.CreateBoardColumn(r.Context(), id, &req, userID)
+	if err != nil {
+		respondServiceError(w, err, errorMessages{
+			NotFound: "Board not found",
+			Internal: "Failed to create board column",
+		})
+		return
+	}
+
+	respondJSON(w, http.StatusCreated, column)
+}
+
+// GetBoardView handles getting a fully materialized board
+// @Summary Get a board with its cards
+// @Description Issues matching the board's saved filter, grouped by swimlane and column
+// @Tags boards
+// @Security BearerAuth
+// @Produce json
+// @Param id path int true "Board ID"
+// @Success 200 {object} models.BoardView
+// @Router /boards/{id}/view [get]
+func (h *BoardHandler) GetBoardView(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value(middleware.UserIDContextKey).(int)
+
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid board ID")
+		return
+	}
+
+	view, err := h.boardService.GetBoardView(r.Context(), id, userID)
+	if err != nil {
+		respondServiceError(w, err, errorMessages{
+			NotFound: "Board not found",
+			Internal: "Failed to get board",
+		})
+		return
+	}
+
+	respondJSON(w, http.StatusOK, view)
+}
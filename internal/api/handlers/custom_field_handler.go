@@ -0,0 +1,157 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/yourusername/issue-tracker/internal/api/middleware"
+	"github.com/yourusername/issue-tracker/internal/models"
+	"github.com/yourusername/issue-tracker/internal/service"
+)
+
+// CustomFieldHandler handles custom field HTTP requests
+type CustomFieldHandler struct {
+	fieldService *service.CustomFieldService
+}
+
+// NewCustomFieldHandler creates a new custom field handler
+func NewCustomFieldHandler(fieldService *service.CustomFieldService) *CustomFieldHandler {
+	return &CustomFieldHandler{
+		fieldService: fieldService,
+	}
+}
+
+// Create handles defining a custom field on a project
+// @Summary Create a custom field
+// @Description Field types are text, number, date, single_select, multi_select, user and url. Select fields need options. Without issue_types the field applies to every issue type
+// @Tags custom-fields
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path int true "Project ID"
+// @Param request body models.CreateCustomFieldRequest true "Custom field"
+// @Success 201 {object} models.CustomField
+// @Router /projects/{id}/custom-fields [post]
+func (h *CustomFieldHandler) Create(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value(middleware.UserIDContextKey).(int)
+
+	projectID, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid project ID")
+		return
+	}
+
+	var req models.CreateCustomFieldRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	field, err := h.fieldService.Create(r.Context(), projectID, &req, userID)
+	if err != nil {
+		respondServiceError(w, err, errorMessages{
+			NotFound: "Project not found",
+			Conflict: "A custom field with this key already exists",
+			Internal: "Failed to create custom field",
+		})
+		return
+	}
+
+	respondJSON(w, http.StatusCreated, field)
+}
+
+// List handles listing the custom fields of a project
+// @Summary List custom fields
+// @Tags custom-fields
+// @Security BearerAuth
+// @Produce json
+// @Param id path int true "Project ID"
+// @Success 200 {array} models.CustomField
+// @Router /projects/{id}/custom-fields [get]
+func (h *CustomFieldHandler) List(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value(middleware.UserIDContextKey).(int)
+
+	projectID, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid project ID")
+		return
+	}
+
+	fields, err := h.fieldService.List(r.Context(), projectID, userID)
+	if err != nil {
+		respondServiceError(w, err, errorMessages{
+			NotFound: "Project not found",
+			Internal: "Failed to list custom fields",
+		})
+		return
+	}
+
+	respondJSON(w, http.StatusOK, fields)
+}
+
+// Update handles changing a custom field
+// @Summary Update a custom field
+// @Description The key and type of a field can't be changed
+// @Tags custom-fields
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path int true "Custom field ID"
+// @Param request body models.UpdateCustomFieldRequest true "Changes"
+// @Success 200 {object} models.CustomField
+// @Router /custom-fields/{id} [put]
+func (h *CustomFieldHandler) Update(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value(middleware.UserIDContextKey).(int)
+
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid custom field ID")
+		return
+	}
+
+	var req models.UpdateCustomFieldRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	field, err := h.fieldService.Update(r.Context(), id, &req, userID)
+	if err != nil {
+		respondServiceError(w, err, errorMessages{
+			NotFound: "Custom field not found",
+			Internal: "Failed to update custom field",
+		})
+		return
+	}
+
+	respondJSON(w, http.StatusOK, field)
+}
+
+// Delete handles removing a custom field
+// @Summary Delete a custom field
+// @Description Also removes the field's values from every issue
+// @Tags custom-fields
+// @Security BearerAuth
+// @Param id path int true "Custom field ID"
+// @Success 204
+// @Router /custom-fields/{id} [delete]
+func (h *CustomFieldHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value(middleware.UserIDContextKey).(int)
+
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid custom field ID")
+		return
+	}
+
+	if err := h.fieldService.Delete(r.Context(), id, userID); err != nil {
+		respondServiceError(w, err, errorMessages{
+			NotFound: "Custom field not found",
+			Internal: "Failed to delete custom field",
+		})
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
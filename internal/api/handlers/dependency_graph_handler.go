@@ -0,0 +1,105 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/yourusername/issue-tracker/internal/api/middleware"
+	"github.com/yourusername/issue-tracker/internal/models"
+	"github.com/yourusername/issue-tracker/internal/service"
+	pkgerrors "github.com/yourusername/issue-tracker/pkg/errors"
+)
+
+// DependencyGraphHandler handles dependency graph HTTP requests
+type DependencyGraphHandler struct {
+	graphService *service.DependencyGraphService
+}
+
+// NewDependencyGraphHandler creates a new dependency graph handler
+func NewDependencyGraphHandler(graphService *service.DependencyGraphService) *DependencyGraphHandler {
+	return &DependencyGraphHandler{
+		graphService: graphService,
+	}
+}
+
+// Get handles getting the dependency graph of a project
+// @Summary Get dependency graph
+// @Description Blocking links between the project's issues, optionally limited to an epic or milestone, with the critical path (the blocking chain with the most remaining work). format=dot or format=mermaid returns the graph as text for embedding in docs
+// @Tags issue-links
+// @Security BearerAuth
+// @Produce json,plain
+// @Param id path int true "Project ID"
+// @Param epic_id query int false "Epic ID"
+// @Param milestone_id query int false "Milestone ID"
+// @Param format query string false "json (default), dot or mermaid"
+// @Success 200 {object} models.DependencyGraph
+// @Router /projects/{id}/dependency-graph [get]
+func (h *DependencyGraphHandler) Get(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value(middleware.UserIDContextKey).(int)
+
+	projectID, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid project ID")
+		return
+	}
+
+	filter := &models.DependencyGraphFilter{ProjectID: projectID}
+	query := r.URL.Query()
+	if epicID := query.Get("epic_id"); epicID != "" {
+		id, err := strconv.Atoi(epicID)
+		if err != nil {
+			respondError(w, http.StatusBadRequest, "Invalid epic ID")
+			return
+		}
+		filter.EpicID = &id
+	}
+	if milestoneID := query.Get("milestone_id"); milestoneID != "" {
+		id, err := strconv.Atoi(milestoneID)
+		if err != nil {
+			respondError(w, http.StatusBadRequest, "Invalid milestone ID")
+			return
+		}
+		filter.MilestoneID = &id
+	}
+
+	format := query.Get("format")
+	switch format {
+	case "", models.GraphFormatJSON, models.GraphFormatDOT, models.GraphFormatMermaid:
+	default:
+		respondError(w, http.StatusBadRequest, "format must be json, dot or mermaid")
+		return
+	}
+
+	graph, err := h.graphService.GetGraph(r.Context(), filter, userID)
+	if err != nil {
+		if appErr, ok := err.(*pkgerrors.AppError); ok {
+			respondAppError(w, appErr)
+			return
+		}
+		switch err {
+		case pkgerrors.ErrNotFound:
+			respondError(w, http.StatusNotFound, "Project not found")
+		case pkgerrors.ErrForbidden:
+			respondError(w, http.StatusForbidden, "Access denied")
+		default:
+			respondError(w, http.StatusInternalServerError, "Failed to get dependency graph")
+		}
+		return
+	}
+
+	switch format {
+	case models.GraphFormatDOT:
+		writeGraphText(w, service.RenderDOT(graph))
+	case models.GraphFormatMermaid:
+		writeGraphText(w, service.RenderMermaid(graph))
+	default:
+		respondJSON(w, http.StatusOK, graph)
+	}
+}
+
+// writeGraphText writes a rendered graph as plain text
+func writeGraphText(w http.ResponseWriter, text string) {
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write([]byte(text))
+}
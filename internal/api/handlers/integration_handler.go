@@ -60,6 +60,10 @@ func (h *IntegrationHandler) Create(w http.ResponseWriter, r *http.Request) {
 
 	integration, err := h.integrationService.Create(r.Context(), projectID, &req, userID)
 	if err != nil {
+		if appErr, ok := err.(*pkgerrors.AppError); ok {
+			respondError(w, appErr.StatusCode, appErr.Message)
+			return
+		}
 		if err == pkgerrors.ErrForbidden {
 			respondError(w, http.StatusForbidden, "Admin permission required")
 			return
@@ -0,0 +1,107 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/yourusername/issue-tracker/internal/api/middleware"
+	"github.com/yourusername/issue-tracker/internal/models"
+	"github.com/yourusername/issue-tracker/internal/service"
+	pkgerrors "github.com/yourusername/issue-tracker/pkg/errors"
+)
+
+// IssueBulkHandler handles bulk changes of issues
+type IssueBulkHandler struct {
+	bulkService *service.IssueBulkService
+}
+
+// NewIssueBulkHandler creates a new issue bulk handler
+func NewIssueBulkHandler(bulkService *service.IssueBulkService) *IssueBulkHandler {
+	return &IssueBulkHandler{
+		bulkService: bulkService,
+	}
+}
+
+// Apply handles applying one change to many issues
+// @Summary Change many issues at once
+// @Description Applies a status, priority, assignee, milestone, label or column change, or a delete, to up to 500 issues selected by ID or filter. The outcome is reported per issue (updated, deleted, conflict or failed); issues whose version differs from the one in versions are reported as conflicts. Integrations receive one message for the batch. Changes of more than 50 issues run in the background: the response is then 202 with a job whose progress can be polled
+// @Tags issues
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param projectId path int true "Project ID"
+// @Param request body models.BulkIssueRequest true "Issues and change"
+// @Success 200 {object} models.BulkIssueResult
+// @Success 202 {object} models.IssueBulkJob
+// @Router /projects/{projectId}/issues/bulk [post]
+func (h *IssueBulkHandler) Apply(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value(middleware.UserIDContextKey).(int)
+
+	projectID, err := strconv.Atoi(r.PathValue("projectId"))
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid project ID")
+		return
+	}
+
+	var req models.BulkIssueRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	result, job, err := h.bulkService.Apply(r.Context(), projectID, &req, userID)
+	if err != nil {
+		if appErr, ok := err.(*pkgerrors.AppError); ok {
+			respondAppError(w, appErr)
+			return
+		}
+
+		switch err {
+		case pkgerrors.ErrNotFound:
+			respondError(w, http.StatusNotFound, "Project not found")
+		case pkgerrors.ErrForbidden:
+			respondError(w, http.StatusForbidden, "Permission denied")
+		default:
+			respondError(w, http.StatusInternalServerError, "Failed to change issues")
+		}
+		return
+	}
+
+	if job != nil {
+		respondJSON(w, http.StatusAccepted, job)
+		return
+	}
+	respondJSON(w, http.StatusOK, result)
+}
+
+// GetJob handles retrieving the progress of a background bulk change
+// @Summary Get bulk job status
+// @Description Returns the status and progress of a bulk job started by the current user; result holds the per-issue outcomes once it completed
+// @Tags issues
+// @Security BearerAuth
+// @Produce json
+// @Param id path int true "Bulk job ID"
+// @Success 200 {object} models.IssueBulkJob
+// @Router /bulk-jobs/{id} [get]
+func (h *IssueBulkHandler) GetJob(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value(middleware.UserIDContextKey).(int)
+
+	jobID, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid bulk job ID")
+		return
+	}
+
+	job, err := h.bulkService.GetJob(r.Context(), jobID, userID)
+	if err != nil {
+		if err == pkgerrors.ErrNotFound {
+			respondError(w, http.StatusNotFound, "Bulk job not found")
+			return
+		}
+		respondError(w, http.StatusInternalServerError, "Failed to get bulk job")
+		return
+	}
+
+	respondJSON(w, http.StatusOK, job)
+}
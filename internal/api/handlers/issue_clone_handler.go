@@ -0,0 +1,107 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/yourusername/issue-tracker/internal/api/middleware"
+	"github.com/yourusername/issue-tracker/internal/models"
+	"github.com/yourusername/issue-tracker/internal/service"
+	pkgerrors "github.com/yourusername/issue-tracker/pkg/errors"
+)
+
+// IssueCloneHandler handles cloning issues
+type IssueCloneHandler struct {
+	cloneService *service.IssueCloneService
+}
+
+// NewIssueCloneHandler creates a new issue clone handler
+func NewIssueCloneHandler(cloneService *service.IssueCloneService) *IssueCloneHandler {
+	return &IssueCloneHandler{
+		cloneService: cloneService,
+	}
+}
+
+// Clone handles cloning an issue
+// @Summary Clone an issue
+// @Description Clones the issue into the same or another project, optionally with its subtasks, epic children, labels, tasklist items, attachments and a clones link to the source. Clones of more than 20 issues run in the background: the response is then 202 with a job whose progress can be polled. The clone is transactional, a failure creates no issues
+// @Tags issues
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path int true "Issue ID"
+// @Param request body models.CloneIssueRequest true "Target project and what to include"
+// @Success 201 {object} models.Issue
+// @Success 202 {object} models.IssueCloneJob
+// @Router /issues/{id}/clone [post]
+func (h *IssueCloneHandler) Clone(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value(middleware.UserIDContextKey).(int)
+
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid issue ID")
+		return
+	}
+
+	var req models.CloneIssueRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	issue, job, err := h.cloneService.Clone(r.Context(), id, &req, userID)
+	if err != nil {
+		if appErr, ok := err.(*pkgerrors.AppError); ok {
+			respondAppError(w, appErr)
+			return
+		}
+
+		switch err {
+		case pkgerrors.ErrNotFound:
+			respondError(w, http.StatusNotFound, "Issue or project not found")
+		case pkgerrors.ErrForbidden:
+			respondError(w, http.StatusForbidden, "Write access to the target project is required")
+		default:
+			respondError(w, http.StatusInternalServerError, "Failed to clone issue")
+		}
+		return
+	}
+
+	if job != nil {
+		respondJSON(w, http.StatusAccepted, job)
+		return
+	}
+	respondJSON(w, http.StatusCreated, issue)
+}
+
+// GetJob handles retrieving the progress of a background clone
+// @Summary Get clone job status
+// @Description Returns the status and progress of a clone job started by the current user; cloned_issue_id is set once it completed
+// @Tags issues
+// @Security BearerAuth
+// @Produce json
+// @Param id path int true "Clone job ID"
+// @Success 200 {object} models.IssueCloneJob
+// @Router /clone-jobs/{id} [get]
+func (h *IssueCloneHandler) GetJob(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value(middleware.UserIDContextKey).(int)
+
+	jobID, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid clone job ID")
+		return
+	}
+
+	job, err := h.cloneService.GetJob(r.Context(), jobID, userID)
+	if err != nil {
+		if err == pkgerrors.ErrNotFound {
+			respondError(w, http.StatusNotFound, "Clone job not found")
+			return
+		}
+		respondError(w, http.StatusInternalServerError, "Failed to get clone job")
+		return
+	}
+
+	respondJSON(w, http.StatusOK, job)
+}
@@ -4,6 +4,8 @@ import (
 	"encoding/json"
 	"net/http"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/yourusername/issue-tracker/internal/api/middleware"
 	"github.com/yourusername/issue-tracker/internal/models"
@@ -48,6 +50,10 @@ func (h *IssueHandler) Create(w http.ResponseWriter, r *http.Request) {
 
 	issue, err := h.issueService.Create(r.Context(), projectID, &req, userID)
 	if err != nil {
+		if appErr, ok := err.(*pkgerrors.AppError); ok {
+			respondAppError(w, appErr)
+			return
+		}
 		if err == pkgerrors.ErrForbidden {
 			respondError(w, http.StatusForbidden, "Access denied")
 			return
@@ -187,6 +193,12 @@ func (h *IssueHandler) List(w http.ResponseWriter, r *http.Request) {
 		filter.Priority = &priority
 	}
 
+	// Status category filter (todo, in_progress, done)
+	if categoryStr := r.URL.Query().Get("status_category"); categoryStr != "" {
+		category := models.StatusCategory(categoryStr)
+		filter.StatusCategory = &category
+	}
+
 	// Assignee filter
 	if assigneeStr := r.URL.Query().Get("assignee_id"); assigneeStr != "" {
 		assigneeID, err := strconv.Atoi(assigneeStr)
@@ -195,6 +207,14 @@ func (h *IssueHandler) List(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
+	// Assignee team filter
+	if teamStr := r.URL.Query().Get("assignee_team_id"); teamStr != "" {
+		teamID, err := strconv.Atoi(teamStr)
+		if err == nil {
+			filter.AssigneeTeamID = &teamID
+		}
+	}
+
 	// Milestone filter
 	if milestoneStr := r.URL.Query().Get("milestone_id"); milestoneStr != "" {
 		milestoneID, err := strconv.Atoi(milestoneStr)
@@ -203,6 +223,19 @@ func (h *IssueHandler) List(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
+	// Sprint filter
+	if sprintStr := r.URL.Query().Get("sprint_id"); sprintStr != "" {
+		sprintID, err := strconv.Atoi(sprintStr)
+		if err == nil {
+			filter.SprintID = &sprintID
+		}
+	}
+
+	// Backlog filter (issues not planned into any sprint)
+	if r.URL.Query().Get("backlog") == "true" {
+		filter.InBacklog = true
+	}
+
 	// Label filter
 	if labelStr := r.URL.Query().Get("label_id"); labelStr != "" {
 		labelID, err := strconv.Atoi(labelStr)
@@ -239,6 +272,54 @@ func (h *IssueHandler) List(w http.ResponseWriter, r *http.Request) {
 		filter.HasParent = &hasParent
 	}
 
+	// Due date filters (YYYY-MM-DD, inclusive)
+	if filter.DueAfter, err = parseDateQuery(r, "due_after"); err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid due_after date, expected YYYY-MM-DD")
+		return
+	}
+	if filter.DueBefore, err = parseDateQuery(r, "due_before"); err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid due_before date, expected YYYY-MM-DD")
+		return
+	}
+	if overdueStr := r.URL.Query().Get("overdue"); overdueStr != "" {
+		overdue := overdueStr == "true"
+		filter.Overdue = &overdue
+	}
+	if pinnedStr := r.URL.Query().Get("pinned"); pinnedStr != "" {
+		pinned := pinnedStr == "true"
+		filter.Pinned = &pinned
+	}
+
+	// Demand filters
+	if minVotesStr := r.URL.Query().Get("min_votes"); minVotesStr != "" {
+		minVotes, err := strconv.Atoi(minVotesStr)
+		if err != nil {
+			respondError(w, http.StatusBadRequest, "Invalid min_votes")
+			return
+		}
+		filter.MinVotes = &minVotes
+	}
+	if minCustomersStr := r.URL.Query().Get("min_customer_requests"); minCustomersStr != "" {
+		minCustomers, err := strconv.Atoi(minCustomersStr)
+		if err != nil {
+			respondError(w, http.StatusBadRequest, "Invalid min_customer_requests")
+			return
+		}
+		filter.MinCustomers = &minCustomers
+	}
+
+	// Custom field filters (e.g. cf.affected_version=2.1)
+	filter.CustomFields = parseCustomFieldQuery(r)
+
+	// Sort (e.g. due_date, -start_date)
+	if sort := r.URL.Query().Get("sort"); sort != "" {
+		if _, ok := models.IssueOrderBy(sort, ""); !ok {
+			respondError(w, http.StatusBadRequest, "Invalid sort field")
+			return
+		}
+		filter.Sort = sort
+	}
+
 	// Search filter (support both 'q' and 'search')
 	if search := r.URL.Query().Get("q"); search != "" {
 		filter.Search = search
@@ -272,6 +353,36 @@ func (h *IssueHandler) List(w http.ResponseWriter, r *http.Request) {
 	respondJSON(w, http.StatusOK, issues)
 }
 
+// parseCustomFieldQuery collects cf.<key>=<value> query parameters
+func parseCustomFieldQuery(r *http.Request) map[string]string {
+	var fields map[string]string
+	for name, values := range r.URL.Query() {
+		key, ok := strings.CutPrefix(name, "cf.")
+		if !ok || key == "" || len(values) == 0 {
+			continue
+		}
+		if fields == nil {
+			fields = make(map[string]string)
+		}
+		fields[key] = values[0]
+	}
+	return fields
+}
+
+// parseDateQuery parses an optional YYYY-MM-DD query parameter
+func parseDateQuery(r *http.Request, name string) (*time.Time, error) {
+	value := r.URL.Query().Get(name)
+	if value == "" {
+		return nil, nil
+	}
+
+	date, err := time.Parse(time.DateOnly, value)
+	if err != nil {
+		return nil, err
+	}
+	return &date, nil
+}
+
 // Update handles updating an issue
 func (h *IssueHandler) Update(w http.ResponseWriter, r *http.Request) {
 	userID := r.Context().Value(middleware.UserIDContextKey).(int)
@@ -291,6 +402,10 @@ func (h *IssueHandler) Update(w http.ResponseWriter, r *http.Request) {
 
 	issue, err := h.issueService.Update(r.Context(), id, &req, userID)
 	if err != nil {
+		if appErr, ok := err.(*pkgerrors.AppError); ok {
+			respondAppError(w, appErr)
+			return
+		}
 		if err == pkgerrors.ErrNotFound {
 			respondError(w, http.StatusNotFound, "Issue not found")
 			return
@@ -361,6 +476,10 @@ func (h *IssueHandler) MoveToColumn(w http.ResponseWriter, r *http.Request) {
 
 	issue, err := h.issueService.MoveToColumn(r.Context(), id, &req, userID)
 	if err != nil {
+		if appErr, ok := err.(*pkgerrors.AppError); ok {
+			respondAppError(w, appErr)
+			return
+		}
 		if err == pkgerrors.ErrNotFound {
 			respondError(w, http.StatusNotFound, "Issue or column not found")
 			return
@@ -468,13 +587,8 @@ func (h *IssueHandler) GetEpics(w http.ResponseWriter, r *http.Request) {
 	respondJSON(w, http.StatusOK, epics)
 }
 
-// SubtaskProgressResponse represents subtask progress data
-type SubtaskProgressResponse struct {
-	Total     int `json:"total"`
-	Completed int `json:"completed"`
-}
-
 // GetSubtaskProgress handles getting subtask progress for an issue
+// Progress is weighted by the subtasks' estimates when they have any
 func (h *IssueHandler) GetSubtaskProgress(w http.ResponseWriter, r *http.Request) {
 	userID := r.Context().Value(middleware.UserIDContextKey).(int)
 
@@ -485,7 +599,7 @@ func (h *IssueHandler) GetSubtaskProgress(w http.ResponseWriter, r *http.Request
 		return
 	}
 
-	total, completed, err := h.issueService.GetSubtaskProgress(r.Context(), id, userID)
+	progress, err := h.issueService.GetSubtaskProgress(r.Context(), id, userID)
 	if err != nil {
 		if err == pkgerrors.ErrNotFound {
 			respondError(w, http.StatusNotFound, "Issue not found")
@@ -499,13 +613,11 @@ func (h *IssueHandler) GetSubtaskProgress(w http.ResponseWriter, r *http.Request
 		return
 	}
 
-	respondJSON(w, http.StatusOK, SubtaskProgressResponse{
-		Total:     total,
-		Completed: completed,
-	})
+	respondJSON(w, http.StatusOK, progress)
 }
 
 // GetEpicProgress handles getting issue completion progress for an epic
+// Progress is weighted by the issues' estimates when they have any
 func (h *IssueHandler) GetEpicProgress(w http.ResponseWriter, r *http.Request) {
 	userID := r.Context().Value(middleware.UserIDContextKey).(int)
 
@@ -516,7 +628,7 @@ func (h *IssueHandler) GetEpicProgress(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	total, completed, err := h.issueService.GetEpicProgress(r.Context(), id, userID)
+	progress, err := h.issueService.GetEpicProgress(r.Context(), id, userID)
 	if err != nil {
 		if err == pkgerrors.ErrNotFound {
 			respondError(w, http.StatusNotFound, "Epic not found")
@@ -534,8 +646,5 @@ func (h *IssueHandler) GetEpicProgress(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	respondJSON(w, http.StatusOK, SubtaskProgressResponse{
-		Total:     total,
-		Completed: completed,
-	})
+	respondJSON(w, http.StatusOK, progress)
 }
@@ -0,0 +1,119 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/yourusername/issue-tracker/internal/api/middleware"
+	"github.com/yourusername/issue-tracker/internal/models"
+	"github.com/yourusername/issue-tracker/internal/service"
+)
+
+// IssueLinkHandler handles issue link HTTP requests
+type IssueLinkHandler struct {
+	linkService *service.IssueLinkService
+}
+
+// NewIssueLinkHandler creates a new issue link handler
+func NewIssueLinkHandler(linkService *service.IssueLinkService) *IssueLinkHandler {
+	return &IssueLinkHandler{
+		linkService: linkService,
+	}
+}
+
+// Create handles linking an issue to another one
+// @Summary Link issues
+// @Description Links the issue to another one. Relations are blocks, is_blocked_by, duplicates, is_duplicated_by, relates_to, clones and is_cloned_by. Marking an issue as a duplicate closes it with the duplicate resolution and copies its watchers to the other issue
+// @Tags issue-links
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param issueId path int true "Issue ID"
+// @Param request body models.CreateIssueLinkRequest true "Link"
+// @Success 201 {object} models.IssueLink
+// @Router /issues/{issueId}/links [post]
+func (h *IssueLinkHandler) Create(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value(middleware.UserIDContextKey).(int)
+
+	issueID, err := strconv.Atoi(r.PathValue("issueId"))
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid issue ID")
+		return
+	}
+
+	var req models.CreateIssueLinkRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	link, err := h.linkService.Create(r.Context(), issueID, &req, userID)
+	if err != nil {
+		respondServiceError(w, err, errorMessages{
+			NotFound: "Issue not found",
+			Conflict: "These issues are already linked",
+			Internal: "Failed to link issues",
+		})
+		return
+	}
+
+	respondJSON(w, http.StatusCreated, link)
+}
+
+// List handles listing the links of an issue
+// @Summary List issue links
+// @Tags issue-links
+// @Security BearerAuth
+// @Produce json
+// @Param issueId path int true "Issue ID"
+// @Success 200 {array} models.IssueLink
+// @Router /issues/{issueId}/links [get]
+func (h *IssueLinkHandler) List(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value(middleware.UserIDContextKey).(int)
+
+	issueID, err := strconv.Atoi(r.PathValue("issueId"))
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid issue ID")
+		return
+	}
+
+	links, err := h.linkService.ListByIssueID(r.Context(), issueID, userID)
+	if err != nil {
+		respondServiceError(w, err, errorMessages{
+			NotFound: "Issue not found",
+			Internal: "Failed to list issue links",
+		})
+		return
+	}
+
+	respondJSON(w, http.StatusOK, links)
+}
+
+// Delete handles removing an issue link
+// @Summary Remove an issue link
+// @Description Removing a duplicates link doesn't reopen the duplicate
+// @Tags issue-links
+// @Security BearerAuth
+// @Param id path int true "Link ID"
+// @Success 204
+// @Router /issue-links/{id} [delete]
+func (h *IssueLinkHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value(middleware.UserIDContextKey).(int)
+
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid link ID")
+		return
+	}
+
+	if err := h.linkService.Delete(r.Context(), id, userID); err != nil {
+		respondServiceError(w, err, errorMessages{
+			NotFound: "Issue link not found",
+			Internal: "Failed to remove issue link",
+		})
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
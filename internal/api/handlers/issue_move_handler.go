@@ -0,0 +1,75 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/yourusername/issue-tracker/internal/api/middleware"
+	"github.com/yourusername/issue-tracker/internal/models"
+	"github.com/yourusername/issue-tracker/internal/service"
+	pkgerrors "github.com/yourusername/issue-tracker/pkg/errors"
+)
+
+// IssueMoveHandler handles moving issues between projects
+type IssueMoveHandler struct {
+	moveService *service.IssueMoveService
+}
+
+// NewIssueMoveHandler creates a new issue move handler
+func NewIssueMoveHandler(moveService *service.IssueMoveService) *IssueMoveHandler {
+	return &IssueMoveHandler{
+		moveService: moveService,
+	}
+}
+
+// MoveToProject handles moving an issue to another project
+// @Summary Move an issue to another project
+// @Description Moves the issue and its subtasks to the target project, where they get new keys. The old keys keep resolving to the moved issues. Labels, milestones and board columns are replaced through the mappings or dropped when unmapped. Requires write permission on both projects
+// @Tags issues
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path int true "Issue ID"
+// @Param request body models.MoveIssueProjectRequest true "Target project and mappings"
+// @Success 200 {object} models.Issue
+// @Router /issues/{id}/move-project [post]
+func (h *IssueMoveHandler) MoveToProject(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value(middleware.UserIDContextKey).(int)
+
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid issue ID")
+		return
+	}
+
+	var req models.MoveIssueProjectRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+	if req.TargetProjectID == 0 {
+		respondError(w, http.StatusBadRequest, "target_project_id is required")
+		return
+	}
+
+	issue, err := h.moveService.MoveToProject(r.Context(), id, &req, userID)
+	if err != nil {
+		if appErr, ok := err.(*pkgerrors.AppError); ok {
+			respondAppError(w, appErr)
+			return
+		}
+
+		switch err {
+		case pkgerrors.ErrNotFound:
+			respondError(w, http.StatusNotFound, "Issue or project not found")
+		case pkgerrors.ErrForbidden:
+			respondError(w, http.StatusForbidden, "Write access to both projects is required")
+		default:
+			respondError(w, http.StatusInternalServerError, "Failed to move issue")
+		}
+		return
+	}
+
+	respondJSON(w, http.StatusOK, issue)
+}
@@ -0,0 +1,83 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/yourusername/issue-tracker/internal/api/middleware"
+	"github.com/yourusername/issue-tracker/internal/service"
+)
+
+// IssuePinHandler handles pinning issues
+type IssuePinHandler struct {
+	pinService *service.IssuePinService
+}
+
+// NewIssuePinHandler creates a new issue pin handler
+func NewIssuePinHandler(pinService *service.IssuePinService) *IssuePinHandler {
+	return &IssuePinHandler{
+		pinService: pinService,
+	}
+}
+
+// Pin handles pinning an issue
+// @Summary Pin an issue
+// @Description Keeps the issue at the top of its project's issue list. Requires write permission; a project can have at most models.MaxPinnedIssues pinned issues. Pinning a pinned issue changes nothing
+// @Tags issues
+// @Security BearerAuth
+// @Produce json
+// @Param id path int true "Issue ID"
+// @Success 200 {object} models.Issue
+// @Router /issues/{id}/pin [post]
+func (h *IssuePinHandler) Pin(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value(middleware.UserIDContextKey).(int)
+
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid issue ID")
+		return
+	}
+
+	issue, err := h.pinService.Pin(r.Context(), id, userID)
+	if err != nil {
+		respondServiceError(w, err, errorMessages{
+			NotFound:  "Issue not found",
+			Forbidden: "Permission denied",
+			Internal:  "Failed to pin issue",
+		})
+		return
+	}
+
+	respondJSON(w, http.StatusOK, issue)
+}
+
+// Unpin handles unpinning an issue
+// @Summary Unpin an issue
+// @Description Returns the issue to its normal place in the issue list. Requires write permission
+// @Tags issues
+// @Security BearerAuth
+// @Produce json
+// @Param id path int true "Issue ID"
+// @Success 200 {object} models.Issue
+// @Router /issues/{id}/pin [delete]
+func (h *IssuePinHandler) Unpin(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value(middleware.UserIDContextKey).(int)
+
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid issue ID")
+		return
+	}
+
+	issue, err := h.pinService.Unpin(r.Context(), id, userID)
+	if err != nil {
+		respondServiceError(w, err, errorMessages{
+			NotFound:  "Issue not found",
+			Forbidden: "Permission denied",
+			Internal:  "Failed to unpin issue",
+		})
+		return
+	}
+
+	respondJSON(w, http.StatusOK, issue)
+}
@@ -0,0 +1,229 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/yourusername/issue-tracker/internal/api/middleware"
+	"github.com/yourusername/issue-tracker/internal/models"
+	"github.com/yourusername/issue-tracker/internal/service"
+)
+
+// IssueVoteHandler handles issue votes and customer requests
+type IssueVoteHandler struct {
+	voteService *service.IssueVoteService
+}
+
+// NewIssueVoteHandler creates a new issue vote handler
+func NewIssueVoteHandler(voteService *service.IssueVoteService) *IssueVoteHandler {
+	return &IssueVoteHandler{
+		voteService: voteService,
+	}
+}
+
+// GetVotes handles getting the vote totals of an issue
+// @Summary Get issue votes
+// @Description Returns the up and down votes of an issue, its score and the requesting user's own vote
+// @Tags votes
+// @Security BearerAuth
+// @Produce json
+// @Param id path int true "Issue ID"
+// @Success 200 {object} models.IssueVoteSummary
+// @Router /issues/{id}/votes [get]
+func (h *IssueVoteHandler) GetVotes(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value(middleware.UserIDContextKey).(int)
+
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid issue ID")
+		return
+	}
+
+	summary, err := h.voteService.GetSummary(r.Context(), id, userID)
+	if err != nil {
+		respondServiceError(w, err, errorMessages{
+			NotFound:  "Issue not found",
+			Forbidden: "Permission denied",
+			Internal:  "Failed to get votes",
+		})
+		return
+	}
+
+	respondJSON(w, http.StatusOK, summary)
+}
+
+// Vote handles voting on an issue
+// @Summary Vote on an issue
+// @Description Votes the issue up (1) or down (-1). Each user has one vote per issue; voting again replaces it. Votes are separate from emoji reactions
+// @Tags votes
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path int true "Issue ID"
+// @Param request body models.VoteIssueRequest true "Vote"
+// @Success 200 {object} models.IssueVoteSummary
+// @Router /issues/{id}/vote [put]
+func (h *IssueVoteHandler) Vote(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value(middleware.UserIDContextKey).(int)
+
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid issue ID")
+		return
+	}
+
+	var req models.VoteIssueRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	summary, err := h.voteService.Vote(r.Context(), id, &req, userID)
+	if err != nil {
+		respondServiceError(w, err, errorMessages{
+			NotFound:  "Issue not found",
+			Forbidden: "Permission denied",
+			Internal:  "Failed to vote",
+		})
+		return
+	}
+
+	respondJSON(w, http.StatusOK, summary)
+}
+
+// Unvote handles removing the user's vote from an issue
+// @Summary Remove vote
+// @Description Removes the requesting user's vote from the issue
+// @Tags votes
+// @Security BearerAuth
+// @Produce json
+// @Param id path int true "Issue ID"
+// @Success 200 {object} models.IssueVoteSummary
+// @Router /issues/{id}/vote [delete]
+func (h *IssueVoteHandler) Unvote(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value(middleware.UserIDContextKey).(int)
+
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid issue ID")
+		return
+	}
+
+	summary, err := h.voteService.Unvote(r.Context(), id, userID)
+	if err != nil {
+		respondServiceError(w, err, errorMessages{
+			NotFound:  "Issue not found",
+			Forbidden: "Permission denied",
+			Internal:  "Failed to remove vote",
+		})
+		return
+	}
+
+	respondJSON(w, http.StatusOK, summary)
+}
+
+// ListCustomerRequests handles listing the customers that requested an issue
+// @Summary List customer requests
+// @Description Returns the organizations that requested the issue, oldest first
+// @Tags votes
+// @Security BearerAuth
+// @Produce json
+// @Param id path int true "Issue ID"
+// @Success 200 {array} models.CustomerRequest
+// @Router /issues/{id}/customer-requests [get]
+func (h *IssueVoteHandler) ListCustomerRequests(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value(middleware.UserIDContextKey).(int)
+
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid issue ID")
+		return
+	}
+
+	requests, err := h.voteService.ListCustomerRequests(r.Context(), id, userID)
+	if err != nil {
+		respondServiceError(w, err, errorMessages{
+			NotFound:  "Issue not found",
+			Forbidden: "Permission denied",
+			Internal:  "Failed to list customer requests",
+		})
+		return
+	}
+
+	respondJSON(w, http.StatusOK, requests)
+}
+
+// AddCustomerRequest handles recording a customer request on an issue
+// @Summary Add customer request
+// @Description Project admins only: records that an organization requested the issue. Organization names are free text and can be added once per issue
+// @Tags votes
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path int true "Issue ID"
+// @Param request body models.CreateCustomerRequestRequest true "Customer request"
+// @Success 201 {object} models.CustomerRequest
+// @Router /issues/{id}/customer-requests [post]
+func (h *IssueVoteHandler) AddCustomerRequest(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value(middleware.UserIDContextKey).(int)
+
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid issue ID")
+		return
+	}
+
+	var req models.CreateCustomerRequestRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	request, err := h.voteService.AddCustomerRequest(r.Context(), id, &req, userID)
+	if err != nil {
+		respondServiceError(w, err, errorMessages{
+			NotFound:  "Issue not found",
+			Forbidden: "Permission denied",
+			Internal:  "Failed to add customer request",
+		})
+		return
+	}
+
+	respondJSON(w, http.StatusCreated, request)
+}
+
+// DeleteCustomerRequest handles removing a customer request from an issue
+// @Summary Delete customer request
+// @Description Project admins only: removes a customer request from the issue
+// @Tags votes
+// @Security BearerAuth
+// @Param id path int true "Issue ID"
+// @Param requestId path int true "Customer request ID"
+// @Success 204
+// @Router /issues/{id}/customer-requests/{requestId} [delete]
+func (h *IssueVoteHandler) DeleteCustomerRequest(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value(middleware.UserIDContextKey).(int)
+
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid issue ID")
+		return
+	}
+	requestID, err := strconv.Atoi(r.PathValue("requestId"))
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid customer request ID")
+		return
+	}
+
+	if err := h.voteService.DeleteCustomerRequest(r.Context(), id, requestID, userID); err != nil {
+		respondServiceError(w, err, errorMessages{
+			NotFound:  "Customer request not found",
+			Forbidden: "Permission denied",
+			Internal:  "Failed to delete customer request",
+		})
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,398 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/yourusername/issue-tracker/internal/api/middleware"
+	"github.com/yourusername/issue-tracker/internal/models"
+	"github.com/yourusername/issue-tracker/internal/service"
+)
+
+// OrganizationHandler handles organization HTTP requests
+type OrganizationHandler struct {
+	orgService *service.OrganizationService
+}
+
+// NewOrganizationHandler creates a new organization handler
+func NewOrganizationHandler(orgService *service.OrganizationService) *OrganizationHandler {
+	return &OrganizationHandler{
+		orgService: orgService,
+	}
+}
+
+// Create handles organization creation
+// @Summary Create an organization
+// @Tags organizations
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param request body models.CreateOrganizationRequest true "Organization"
+// @Success 201 {object} models.Organization
+// @Router /organizations [post]
+func (h *OrganizationHandler) Create(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value(middleware.UserIDContextKey).(int)
+
+	var req models.CreateOrganizationRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	org, err := h.orgService.Create(r.Context(), &req, userID)
+	if err != nil {
+		respondServiceError(w, err, errorMessages{
+			NotFound:  "Organization not found",
+			Forbidden: "Insufficient organization role",
+			Conflict:  "Organization slug already exists",
+			Internal:  "Failed to create organization",
+		})
+		return
+	}
+
+	respondJSON(w, http.StatusCreated, org)
+}
+
+// List handles listing the current user's organizations
+// @Summary List organizations
+// @Tags organizations
+// @Security BearerAuth
+// @Produce json
+// @Success 200 {array} models.Organization
+// @Router /organizations [get]
+func (h *OrganizationHandler) List(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value(middleware.UserIDContextKey).(int)
+
+	orgs, err := h.orgService.List(r.Context(), userID)
+	if err != nil {
+		respondServiceError(w, err, errorMessages{
+			NotFound:  "Organization not found",
+			Forbidden: "Insufficient organization role",
+			Internal:  "Failed to list organizations",
+		})
+		return
+	}
+
+	respondJSON(w, http.StatusOK, orgs)
+}
+
+// GetByID handles getting an organization
+// @Summary Get an organization
+// @Tags organizations
+// @Security BearerAuth
+// @Produce json
+// @Param id path int true "Organization ID"
+// @Success 200 {object} models.Organization
+// @Router /organizations/{id} [get]
+func (h *OrganizationHandler) GetByID(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value(middleware.UserIDContextKey).(int)
+
+	orgID, ok := parseOrganizationID(w, r)
+	if !ok {
+		return
+	}
+
+	org, err := h.orgService.GetByID(r.Context(), orgID, userID)
+	if err != nil {
+		respondServiceError(w, err, errorMessages{
+			NotFound:  "Organization not found",
+			Forbidden: "Insufficient organization role",
+			Internal:  "Failed to get organization",
+		})
+		return
+	}
+
+	respondJSON(w, http.StatusOK, org)
+}
+
+// Update handles updating an organization
+// @Summary Update an organization
+// @Tags organizations
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path int true "Organization ID"
+// @Param request body models.UpdateOrganizationRequest true "Changes"
+// @Success 200 {object} models.Organization
+// @Router /organizations/{id} [put]
+func (h *OrganizationHandler) Update(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value(middleware.UserIDContextKey).(int)
+
+	orgID, ok := parseOrganizationID(w, r)
+	if !ok {
+		return
+	}
+
+	var req models.UpdateOrganizationRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	org, err := h.orgService.Update(r.Context(), orgID, &req, userID)
+	if err != nil {
+		respondServiceError(w, err, errorMessages{
+			NotFound:  "Organization not found",
+			Forbidden: "Insufficient organization role",
+			Internal:  "Failed to update organization",
+		})
+		return
+	}
+
+	respondJSON(w, http.StatusOK, org)
+}
+
+// UpdateSettings handles replacing the org-wide settings
+// @Summary Update organization settings
+// @Description Default project role, default project template, allowed integration types and 2FA requirement
+// @Tags organizations
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path int true "Organization ID"
+// @Param request body models.OrganizationSettings true "Settings"
+// @Success 200 {object} models.Organization
+// @Router /organizations/{id}/settings [put]
+func (h *OrganizationHandler) UpdateSettings(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value(middleware.UserIDContextKey).(int)
+
+	orgID, ok := parseOrganizationID(w, r)
+	if !ok {
+		return
+	}
+
+	var settings models.OrganizationSettings
+	if err := json.NewDecoder(r.Body).Decode(&settings); err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	org, err := h.orgService.UpdateSettings(r.Context(), orgID, &settings, userID)
+	if err != nil {
+		respondServiceError(w, err, errorMessages{
+			NotFound:  "Organization not found",
+			Forbidden: "Insufficient organization role",
+			Internal:  "Failed to update organization settings",
+		})
+		return
+	}
+
+	respondJSON(w, http.StatusOK, org)
+}
+
+// Delete handles deleting an organization
+// @Summary Delete an organization
+// @Description Only owners can delete an organization, and only once it has no projects
+// @Tags organizations
+// @Security BearerAuth
+// @Param id path int true "Organization ID"
+// @Success 204 "No Content"
+// @Router /organizations/{id} [delete]
+func (h *OrganizationHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value(middleware.UserIDContextKey).(int)
+
+	orgID, ok := parseOrganizationID(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.orgService.Delete(r.Context(), orgID, userID); err != nil {
+		respondServiceError(w, err, errorMessages{
+			NotFound:  "Organization not found",
+			Forbidden: "Insufficient organization role",
+			Conflict:  "Organization still has projects",
+			Internal:  "Failed to delete organization",
+		})
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ListProjects handles listing the projects of an organization
+// @Summary List organization projects
+// @Tags organizations
+// @Security BearerAuth
+// @Produce json
+// @Param id path int true "Organization ID"
+// @Success 200 {array} models.Project
+// @Router /organizations/{id}/projects [get]
+func (h *OrganizationHandler) ListProjects(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value(middleware.UserIDContextKey).(int)
+
+	orgID, ok := parseOrganizationID(w, r)
+	if !ok {
+		return
+	}
+
+	projects, err := h.orgService.ListProjects(r.Context(), orgID, userID)
+	if err != nil {
+		respondServiceError(w, err, errorMessages{
+			NotFound:  "Organization not found",
+			Forbidden: "Insufficient organization role",
+			Internal:  "Failed to list projects",
+		})
+		return
+	}
+
+	respondJSON(w, http.StatusOK, projects)
+}
+
+// ListMembers handles listing the members of an organization
+// @Summary List organization members
+// @Tags organizations
+// @Security BearerAuth
+// @Produce json
+// @Param id path int true "Organization ID"
+// @Success 200 {array} models.OrganizationMember
+// @Router /organizations/{id}/members [get]
+func (h *OrganizationHandler) ListMembers(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value(middleware.UserIDContextKey).(int)
+
+	orgID, ok := parseOrganizationID(w, r)
+	if !ok {
+		return
+	}
+
+	members, err := h.orgService.ListMembers(r.Context(), orgID, userID)
+	if err != nil {
+		respondServiceError(w, err, errorMessages{
+			NotFound:  "Organization not found",
+			Forbidden: "Insufficient organization role",
+			Internal:  "Failed to list members",
+		})
+		return
+	}
+
+	respondJSON(w, http.StatusOK, members)
+}
+
+// AddMember handles adding a member to an organization
+// @Summary Add an organization member
+// @Description Org members get default access to every project of the organization
+// @Tags organizations
+// @Security BearerAuth
+// @Accept json
+// @Param id path int true "Organization ID"
+// @Param request body models.AddOrganizationMemberRequest true "Member"
+// @Success 201 "Created"
+// @Router /organizations/{id}/members [post]
+func (h *OrganizationHandler) AddMember(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value(middleware.UserIDContextKey).(int)
+
+	orgID, ok := parseOrganizationID(w, r)
+	if !ok {
+		return
+	}
+
+	var req models.AddOrganizationMemberRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	if err := h.orgService.AddMember(r.Context(), orgID, &req, userID); err != nil {
+		respondServiceError(w, err, errorMessages{
+			NotFound:  "Organization or user not found",
+			Forbidden: "Insufficient organization role",
+			Conflict:  "User is already a member",
+			Internal:  "Failed to add member",
+		})
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+}
+
+// UpdateMemberRole handles changing an organization member's role
+// @Summary Update an organization member's role
+// @Tags organizations
+// @Security BearerAuth
+// @Accept json
+// @Param id path int true "Organization ID"
+// @Param userId path int true "User ID"
+// @Param request body models.UpdateOrganizationMemberRequest true "Role"
+// @Success 204 "No Content"
+// @Router /organizations/{id}/members/{userId} [put]
+func (h *OrganizationHandler) UpdateMemberRole(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value(middleware.UserIDContextKey).(int)
+
+	orgID, memberUserID, ok := parseOrganizationMemberPath(w, r)
+	if !ok {
+		return
+	}
+
+	var req models.UpdateOrganizationMemberRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	if err := h.orgService.UpdateMemberRole(r.Context(), orgID, memberUserID, &req, userID); err != nil {
+		respondServiceError(w, err, errorMessages{
+			NotFound:  "Organization or member not found",
+			Forbidden: "Insufficient organization role",
+			Conflict:  "An organization needs at least one owner",
+			Internal:  "Failed to update member role",
+		})
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// RemoveMember handles removing a member from an organization
+// @Summary Remove an organization member
+// @Tags organizations
+// @Security BearerAuth
+// @Param id path int true "Organization ID"
+// @Param userId path int true "User ID"
+// @Success 204 "No Content"
+// @Router /organizations/{id}/members/{userId} [delete]
+func (h *OrganizationHandler) RemoveMember(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value(middleware.UserIDContextKey).(int)
+
+	orgID, memberUserID, ok := parseOrganizationMemberPath(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.orgService.RemoveMember(r.Context(), orgID, memberUserID, userID); err != nil {
+		respondServiceError(w, err, errorMessages{
+			NotFound:  "Organization or member not found",
+			Forbidden: "Insufficient organization role",
+			Conflict:  "An organization needs at least one owner",
+			Internal:  "Failed to remove member",
+		})
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// parseOrganizationID parses the organization ID from the path
+func parseOrganizationID(w http.ResponseWriter, r *http.Request) (int, bool) {
+	orgID, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid organization ID")
+		return 0, false
+	}
+	return orgID, true
+}
+
+// parseOrganizationMemberPath parses the organization and member user IDs from the path
+func parseOrganizationMemberPath(w http.ResponseWriter, r *http.Request) (int, int, bool) {
+	orgID, ok := parseOrganizationID(w, r)
+	if !ok {
+		return 0, 0, false
+	}
+
+	memberUserID, err := strconv.Atoi(r.PathValue("userId"))
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid user ID")
+		return 0, 0, false
+	}
+
+	return orgID, memberUserID, true
+}
@@ -45,10 +45,18 @@ func (h *ProjectHandler) Create(w http.ResponseWriter, r *http.Request) {
 
 	project, err := h.projectService.Create(r.Context(), &req, userID)
 	if err != nil {
+		if appErr, ok := err.(*pkgerrors.AppError); ok {
+			respondAppError(w, appErr)
+			return
+		}
 		if err == pkgerrors.ErrConflict {
 			respondError(w, http.StatusConflict, "Project key already exists")
 			return
 		}
+		if err == pkgerrors.ErrForbidden {
+			respondError(w, http.StatusForbidden, "Only organization owners and admins can create projects")
+			return
+		}
 		respondError(w, http.StatusInternalServerError, "Failed to create project")
 		return
 	}
@@ -124,6 +132,14 @@ func (h *ProjectHandler) Update(w http.ResponseWriter, r *http.Request) {
 			respondError(w, http.StatusForbidden, "Access denied")
 			return
 		}
+		if err == pkgerrors.ErrConflict {
+			respondError(w, http.StatusConflict, "Project key already exists")
+			return
+		}
+		if appErr, ok := err.(*pkgerrors.AppError); ok {
+			respondAppError(w, appErr)
+			return
+		}
 		respondError(w, http.StatusInternalServerError, "Failed to update project")
 		return
 	}
@@ -158,3 +174,84 @@ func (h *ProjectHandler) Delete(w http.ResponseWriter, r *http.Request) {
 
 	w.WriteHeader(http.StatusNoContent)
 }
+
+// GetEstimationSettings handles getting the estimation configuration of a project
+// @Summary Get estimation settings
+// @Tags projects
+// @Security BearerAuth
+// @Produce json
+// @Param id path int true "Project ID"
+// @Success 200 {object} models.EstimationSettings
+// @Router /projects/{id}/estimation [get]
+func (h *ProjectHandler) GetEstimationSettings(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value(middleware.UserIDContextKey).(int)
+
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid project ID")
+		return
+	}
+
+	settings, err := h.projectService.GetEstimationSettings(r.Context(), id, userID)
+	if err != nil {
+		if err == pkgerrors.ErrNotFound {
+			respondError(w, http.StatusNotFound, "Project not found")
+			return
+		}
+		if err == pkgerrors.ErrForbidden {
+			respondError(w, http.StatusForbidden, "Access denied")
+			return
+		}
+		respondError(w, http.StatusInternalServerError, "Failed to get estimation settings")
+		return
+	}
+
+	respondJSON(w, http.StatusOK, settings)
+}
+
+// UpdateEstimationSettings handles configuring how a project estimates issues
+// @Summary Update estimation settings
+// @Description Sets the estimation type (story_points or time) and the allowed story point values; an empty list allows any non-negative value
+// @Tags projects
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path int true "Project ID"
+// @Param request body models.UpdateEstimationSettingsRequest true "Estimation settings"
+// @Success 200 {object} models.EstimationSettings
+// @Router /projects/{id}/estimation [put]
+func (h *ProjectHandler) UpdateEstimationSettings(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value(middleware.UserIDContextKey).(int)
+
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid project ID")
+		return
+	}
+
+	var req models.UpdateEstimationSettingsRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	settings, err := h.projectService.UpdateEstimationSettings(r.Context(), id, &req, userID)
+	if err != nil {
+		if appErr, ok := err.(*pkgerrors.AppError); ok {
+			respondAppError(w, appErr)
+			return
+		}
+		if err == pkgerrors.ErrNotFound {
+			respondError(w, http.StatusNotFound, "Project not found")
+			return
+		}
+		if err == pkgerrors.ErrForbidden {
+			respondError(w, http.StatusForbidden, "Access denied")
+			return
+		}
+		respondError(w, http.StatusInternalServerError, "Failed to update estimation settings")
+		return
+	}
+
+	respondJSON(w, http.StatusOK, settings)
+}
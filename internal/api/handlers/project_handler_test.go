@@ -27,7 +27,7 @@ func setupProjectHandler(t *testing.T) (*ProjectHandler, *repository.UserReposit
 	projectRepo := repository.NewProjectRepository(db)
 	boardRepo := repository.NewBoardRepository(db)
 	userRepo := repository.NewUserRepository(db)
-	projectService := service.NewProjectService(projectRepo, boardRepo, db)
+	projectService := service.NewProjectService(projectRepo, boardRepo, userRepo, db, nil)
 	projectHandler := NewProjectHandler(projectService)
 
 	cleanup := func() {
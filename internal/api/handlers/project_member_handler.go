@@ -50,6 +50,10 @@ func (h *ProjectMemberHandler) AddMember(w http.ResponseWriter, r *http.Request)
 			respondError(w, http.StatusForbidden, "Access denied")
 			return
 		}
+		if err == pkgerrors.ErrValidation {
+			respondError(w, http.StatusBadRequest, "Service accounts cannot be added to other projects")
+			return
+		}
 		respondError(w, http.StatusInternalServerError, "Failed to add member")
 		return
 	}
@@ -68,7 +72,9 @@ func (h *ProjectMemberHandler) ListMembers(w http.ResponseWriter, r *http.Reques
 		return
 	}
 
-	members, err := h.memberService.ListMembers(r.Context(), projectID, userID)
+	includeBots := r.URL.Query().Get("include_bots") == "true"
+
+	members, err := h.memberService.ListMembers(r.Context(), projectID, userID, includeBots)
 	if err != nil {
 		if err == pkgerrors.ErrForbidden {
 			respondError(w, http.StatusForbidden, "Access denied")
@@ -0,0 +1,197 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/yourusername/issue-tracker/internal/api/middleware"
+	"github.com/yourusername/issue-tracker/internal/models"
+	"github.com/yourusername/issue-tracker/internal/service"
+)
+
+// RevisionHandler handles the revision history of issue descriptions and
+// comments
+type RevisionHandler struct {
+	revisionService *service.RevisionService
+}
+
+// NewRevisionHandler creates a new revision handler
+func NewRevisionHandler(revisionService *service.RevisionService) *RevisionHandler {
+	return &RevisionHandler{
+		revisionService: revisionService,
+	}
+}
+
+// revisionEntity reads the entity type and ID from the path
+func revisionEntity(w http.ResponseWriter, r *http.Request) (models.RevisionEntityType, int, bool) {
+	entityType := models.RevisionEntityType(r.PathValue("entity_type"))
+	if !entityType.IsValid() {
+		respondError(w, http.StatusBadRequest, "Entity type must be issue or comment")
+		return "", 0, false
+	}
+
+	entityID, err := strconv.Atoi(r.PathValue("entity_id"))
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid entity ID")
+		return "", 0, false
+	}
+	return entityType, entityID, true
+}
+
+// List handles listing the revisions of an issue description or comment
+// @Summary List revisions
+// @Description Returns every saved version of an issue description or comment with its author and time, newest first. The highest revision is the current text; redacted revisions have no content
+// @Tags revisions
+// @Security BearerAuth
+// @Produce json
+// @Param entity_type path string true "Entity type (issue or comment)"
+// @Param entity_id path int true "Issue or comment ID"
+// @Success 200 {array} models.ContentRevision
+// @Router /revisions/{entity_type}/{entity_id} [get]
+func (h *RevisionHandler) List(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value(middleware.UserIDContextKey).(int)
+
+	entityType, entityID, ok := revisionEntity(w, r)
+	if !ok {
+		return
+	}
+
+	revisions, err := h.revisionService.List(r.Context(), entityType, entityID, userID)
+	if err != nil {
+		respondServiceError(w, err, errorMessages{
+			NotFound:  "Revision not found",
+			Forbidden: "Permission denied",
+			Internal:  "Failed to list revisions",
+		})
+		return
+	}
+
+	respondJSON(w, http.StatusOK, revisions)
+}
+
+// Diff handles comparing two revisions
+// @Summary Compare revisions
+// @Description Compares two revisions as a unified line diff or as word-level changes. Redacted revisions can't be compared
+// @Tags revisions
+// @Security BearerAuth
+// @Produce json
+// @Param entity_type path string true "Entity type (issue or comment)"
+// @Param entity_id path int true "Issue or comment ID"
+// @Param from query int true "Old revision"
+// @Param to query int true "New revision"
+// @Param mode query string false "unified (default) or words"
+// @Success 200 {object} models.RevisionDiff
+// @Router /revisions/{entity_type}/{entity_id}/diff [get]
+func (h *RevisionHandler) Diff(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value(middleware.UserIDContextKey).(int)
+
+	entityType, entityID, ok := revisionEntity(w, r)
+	if !ok {
+		return
+	}
+
+	from, err := strconv.Atoi(r.URL.Query().Get("from"))
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid from revision")
+		return
+	}
+	to, err := strconv.Atoi(r.URL.Query().Get("to"))
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid to revision")
+		return
+	}
+	mode := models.RevisionDiffMode(r.URL.Query().Get("mode"))
+
+	result, err := h.revisionService.Diff(r.Context(), entityType, entityID, from, to, mode, userID)
+	if err != nil {
+		respondServiceError(w, err, errorMessages{
+			NotFound:  "Revision not found",
+			Forbidden: "Permission denied",
+			Internal:  "Failed to compare revisions",
+		})
+		return
+	}
+
+	respondJSON(w, http.StatusOK, result)
+}
+
+// Restore handles making an earlier revision the current text
+// @Summary Restore revision
+// @Description Makes a revision the current issue description or comment text, saved as a new revision. Issues need write permission; comments can only be restored by their author. Returns the updated issue or comment
+// @Tags revisions
+// @Security BearerAuth
+// @Produce json
+// @Param entity_type path string true "Entity type (issue or comment)"
+// @Param entity_id path int true "Issue or comment ID"
+// @Param revision path int true "Revision to restore"
+// @Success 200 {object} models.Issue
+// @Router /revisions/{entity_type}/{entity_id}/{revision}/restore [post]
+func (h *RevisionHandler) Restore(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value(middleware.UserIDContextKey).(int)
+
+	entityType, entityID, ok := revisionEntity(w, r)
+	if !ok {
+		return
+	}
+
+	number, err := strconv.Atoi(r.PathValue("revision"))
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid revision")
+		return
+	}
+
+	var restored interface{}
+	if entityType == models.RevisionEntityIssue {
+		restored, err = h.revisionService.RestoreIssue(r.Context(), entityID, number, userID)
+	} else {
+		restored, err = h.revisionService.RestoreComment(r.Context(), entityID, number, userID)
+	}
+	if err != nil {
+		respondServiceError(w, err, errorMessages{
+			NotFound:  "Revision not found",
+			Forbidden: "Permission denied",
+			Internal:  "Failed to restore revision",
+		})
+		return
+	}
+
+	respondJSON(w, http.StatusOK, restored)
+}
+
+// Redact handles removing the content of a revision
+// @Summary Redact revision
+// @Description Project admins only: removes the content of an earlier revision, e.g. one that leaked a secret. Author and time are kept. The current revision can't be redacted; edit the text first
+// @Tags revisions
+// @Security BearerAuth
+// @Produce json
+// @Param entity_type path string true "Entity type (issue or comment)"
+// @Param entity_id path int true "Issue or comment ID"
+// @Param revision path int true "Revision to redact"
+// @Success 200 {object} models.ContentRevision
+// @Router /revisions/{entity_type}/{entity_id}/{revision}/redact [post]
+func (h *RevisionHandler) Redact(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value(middleware.UserIDContextKey).(int)
+
+	entityType, entityID, ok := revisionEntity(w, r)
+	if !ok {
+		return
+	}
+
+	number, err := strconv.Atoi(r.PathValue("revision"))
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid revision")
+		return
+	}
+
+	revision, err := h.revisionService.Redact(r.Context(), entityType, entityID, number, userID)
+	if err != nil {
+		respondServiceError(w, err, errorMessages{
+			NotFound:  "Revision not found",
+			Forbidden: "Permission denied",
+			Internal:  "Failed to redact revision",
+		})
+		return
+	}
+
+	respondJSON(w, http.StatusOK, revision)
+}
@@ -89,6 +89,45 @@ func (h *SearchHandler) SearchIssues(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
+	// Due date filters (YYYY-MM-DD, inclusive)
+	dueAfter, err := parseDateQuery(r, "due_after")
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid due_after date, expected YYYY-MM-DD")
+		return
+	}
+	dueBefore, err := parseDateQuery(r, "due_before")
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid due_before date, expected YYYY-MM-DD")
+		return
+	}
+
+	// Overdue filter
+	var overdue *bool
+	if overdueStr := r.URL.Query().Get("overdue"); overdueStr != "" {
+		o := overdueStr == "true"
+		overdue = &o
+	}
+
+	// Vote score filter
+	var minVotes *int
+	if minVotesStr := r.URL.Query().Get("min_votes"); minVotesStr != "" {
+		mv, err := strconv.Atoi(minVotesStr)
+		if err != nil {
+			respondError(w, http.StatusBadRequest, "Invalid min_votes")
+			return
+		}
+		minVotes = &mv
+	}
+
+	// Sort (e.g. due_date, -start_date)
+	sort := r.URL.Query().Get("sort")
+	if sort != "" {
+		if _, ok := models.IssueOrderBy(sort, "i."); !ok {
+			respondError(w, http.StatusBadRequest, "Invalid sort field")
+			return
+		}
+	}
+
 	// Pagination
 	limit := 20
 	if limitStr := r.URL.Query().Get("limit"); limitStr != "" {
@@ -107,15 +146,21 @@ func (h *SearchHandler) SearchIssues(w http.ResponseWriter, r *http.Request) {
 	}
 
 	req := &models.IssueSearchRequest{
-		Query:      query,
-		ProjectID:  projectID,
-		Status:     status,
-		Priority:   priority,
-		AssigneeID: assigneeID,
-		ReporterID: reporterID,
-		LabelIDs:   labelIDs,
-		Limit:      limit,
-		Offset:     offset,
+		Query:        query,
+		ProjectID:    projectID,
+		Status:       status,
+		Priority:     priority,
+		AssigneeID:   assigneeID,
+		ReporterID:   reporterID,
+		LabelIDs:     labelIDs,
+		DueAfter:     dueAfter,
+		DueBefore:    dueBefore,
+		Overdue:      overdue,
+		MinVotes:     minVotes,
+		Sort:         sort,
+		CustomFields: parseCustomFieldQuery(r),
+		Limit:        limit,
+		Offset:       offset,
 	}
 
 	results, err := h.searchService.SearchIssues(r.Context(), req, userID)
@@ -0,0 +1,243 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/yourusername/issue-tracker/internal/api/middleware"
+	"github.com/yourusername/issue-tracker/internal/models"
+	"github.com/yourusername/issue-tracker/internal/service"
+)
+
+// ServiceAccountHandler handles service account HTTP requests
+type ServiceAccountHandler struct {
+	accountService *service.ServiceAccountService
+}
+
+// NewServiceAccountHandler creates a new service account handler
+func NewServiceAccountHandler(accountService *service.ServiceAccountService) *ServiceAccountHandler {
+	return &ServiceAccountHandler{
+		accountService: accountService,
+	}
+}
+
+// Create handles service account creation
+// @Summary Create a service account
+// @Description Create a bot user that belongs to the project and authenticates with API tokens only
+// @Tags service-accounts
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param projectId path int true "Project ID"
+// @Param request body models.CreateServiceAccountRequest true "Service account"
+// @Success 201 {object} models.ServiceAccount
+// @Router /projects/{projectId}/service-accounts [post]
+func (h *ServiceAccountHandler) Create(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value(middleware.UserIDContextKey).(int)
+
+	projectID, err := strconv.Atoi(r.PathValue("projectId"))
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid project ID")
+		return
+	}
+
+	var req models.CreateServiceAccountRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	account, err := h.accountService.Create(r.Context(), projectID, &req, userID)
+	if err != nil {
+		respondServiceError(w, err, errorMessages{
+			NotFound:  "Service account not found",
+			Forbidden: "Admin permission required",
+			Conflict:  "Username already taken",
+			Internal:  "Failed to create service account",
+		})
+		return
+	}
+
+	respondJSON(w, http.StatusCreated, account)
+}
+
+// List handles listing service accounts of a project
+// @Summary List service accounts
+// @Tags service-accounts
+// @Security BearerAuth
+// @Produce json
+// @Param projectId path int true "Project ID"
+// @Success 200 {array} models.ServiceAccount
+// @Router /projects/{projectId}/service-accounts [get]
+func (h *ServiceAccountHandler) List(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value(middleware.UserIDContextKey).(int)
+
+	projectID, err := strconv.Atoi(r.PathValue("projectId"))
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid project ID")
+		return
+	}
+
+	accounts, err := h.accountService.List(r.Context(), projectID, userID)
+	if err != nil {
+		respondServiceError(w, err, errorMessages{
+			NotFound:  "Service account not found",
+			Forbidden: "Admin permission required",
+			Internal:  "Failed to list service accounts",
+		})
+		return
+	}
+
+	respondJSON(w, http.StatusOK, accounts)
+}
+
+// Delete handles deactivating a service account
+// @Summary Delete a service account
+// @Description Revoke all tokens and remove the service account from the project
+// @Tags service-accounts
+// @Security BearerAuth
+// @Param projectId path int true "Project ID"
+// @Param accountId path int true "Service account user ID"
+// @Success 204 "No Content"
+// @Router /projects/{projectId}/service-accounts/{accountId} [delete]
+func (h *ServiceAccountHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value(middleware.UserIDContextKey).(int)
+
+	projectID, accountID, ok := parseServiceAccountPath(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.accountService.Delete(r.Context(), projectID, accountID, userID); err != nil {
+		respondServiceError(w, err, errorMessages{
+			NotFound:  "Service account not found",
+			Forbidden: "Admin permission required",
+			Internal:  "Failed to delete service account",
+		})
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// CreateToken handles issuing a service account token
+// @Summary Create a service account token
+// @Description The plaintext token is only returned in this response
+// @Tags service-accounts
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param projectId path int true "Project ID"
+// @Param accountId path int true "Service account user ID"
+// @Param request body models.CreateServiceAccountTokenRequest true "Token"
+// @Success 201 {object} models.CreateServiceAccountTokenResponse
+// @Router /projects/{projectId}/service-accounts/{accountId}/tokens [post]
+func (h *ServiceAccountHandler) CreateToken(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value(middleware.UserIDContextKey).(int)
+
+	projectID, accountID, ok := parseServiceAccountPath(w, r)
+	if !ok {
+		return
+	}
+
+	var req models.CreateServiceAccountTokenRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	token, err := h.accountService.CreateToken(r.Context(), projectID, accountID, &req, userID)
+	if err != nil {
+		respondServiceError(w, err, errorMessages{
+			NotFound:  "Service account not found",
+			Forbidden: "Admin permission required",
+			Internal:  "Failed to create token",
+		})
+		return
+	}
+
+	respondJSON(w, http.StatusCreated, token)
+}
+
+// ListTokens handles listing service account tokens
+// @Summary List service account tokens
+// @Tags service-accounts
+// @Security BearerAuth
+// @Produce json
+// @Param projectId path int true "Project ID"
+// @Param accountId path int true "Service account user ID"
+// @Success 200 {array} models.ServiceAccountToken
+// @Router /projects/{projectId}/service-accounts/{accountId}/tokens [get]
+func (h *ServiceAccountHandler) ListTokens(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value(middleware.UserIDContextKey).(int)
+
+	projectID, accountID, ok := parseServiceAccountPath(w, r)
+	if !ok {
+		return
+	}
+
+	tokens, err := h.accountService.ListTokens(r.Context(), projectID, accountID, userID)
+	if err != nil {
+		respondServiceError(w, err, errorMessages{
+			NotFound:  "Service account not found",
+			Forbidden: "Admin permission required",
+			Internal:  "Failed to list tokens",
+		})
+		return
+	}
+
+	respondJSON(w, http.StatusOK, tokens)
+}
+
+// RevokeToken handles revoking a service account token
+// @Summary Revoke a service account token
+// @Tags service-accounts
+// @Security BearerAuth
+// @Param projectId path int true "Project ID"
+// @Param accountId path int true "Service account user ID"
+// @Param tokenId path int true "Token ID"
+// @Success 204 "No Content"
+// @Router /projects/{projectId}/service-accounts/{accountId}/tokens/{tokenId} [delete]
+func (h *ServiceAccountHandler) RevokeToken(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value(middleware.UserIDContextKey).(int)
+
+	projectID, accountID, ok := parseServiceAccountPath(w, r)
+	if !ok {
+		return
+	}
+
+	tokenID, err := strconv.Atoi(r.PathValue("tokenId"))
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid token ID")
+		return
+	}
+
+	if err := h.accountService.RevokeToken(r.Context(), projectID, accountID, tokenID, userID); err != nil {
+		respondServiceError(w, err, errorMessages{
+			NotFound:  "Service account or token not found",
+			Forbidden: "Admin permission required",
+			Internal:  "Failed to revoke token",
+		})
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// parseServiceAccountPath parses the project and service account IDs from the path
+func parseServiceAccountPath(w http.ResponseWriter, r *http.Request) (int, int, bool) {
+	projectID, err := strconv.Atoi(r.PathValue("projectId"))
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid project ID")
+		return 0, 0, false
+	}
+
+	accountID, err := strconv.Atoi(r.PathValue("accountId"))
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid service account ID")
+		return 0, 0, false
+	}
+
+	return projectID, accountID, true
+}
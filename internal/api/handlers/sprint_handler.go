@@ -0,0 +1,404 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/yourusername/issue-tracker/internal/api/middleware"
+	"github.com/yourusername/issue-tracker/internal/models"
+	"github.com/yourusername/issue-tracker/internal/service"
+)
+
+// SprintHandler handles sprint HTTP requests
+type SprintHandler struct {
+	sprintService *service.SprintService
+}
+
+// NewSprintHandler creates a new sprint handler
+func NewSprintHandler(sprintService *service.SprintService) *SprintHandler {
+	return &SprintHandler{
+		sprintService: sprintService,
+	}
+}
+
+// Create handles planning a sprint on a board
+// @Summary Create a sprint
+// @Description Plans a future sprint on a board
+// @Tags sprints
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path int true "Board ID"
+// @Param request body models.CreateSprintRequest true "Sprint"
+// @Success 201 {object} models.Sprint
+// @Router /boards/{id}/sprints [post]
+func (h *SprintHandler) Create(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value(middleware.UserIDContextKey).(int)
+
+	boardID, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid board ID")
+		return
+	}
+
+	var req models.CreateSprintRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	sprint, err := h.sprintService.Create(r.Context(), boardID, &req, userID)
+	if err != nil {
+		respondServiceError(w, err, errorMessages{
+			NotFound: "Board not found",
+			Internal: "Failed to create sprint",
+		})
+		return
+	}
+
+	respondJSON(w, http.StatusCreated, sprint)
+}
+
+// List handles listing the sprints of a board
+// @Summary List sprints
+// @Description Lists the sprints of a board: the active sprint, future sprints by start date, then closed sprints
+// @Tags sprints
+// @Security BearerAuth
+// @Produce json
+// @Param id path int true "Board ID"
+// @Param state query string false "future, active or closed"
+// @Success 200 {array} models.Sprint
+// @Router /boards/{id}/sprints [get]
+func (h *SprintHandler) List(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value(middleware.UserIDContextKey).(int)
+
+	boardID, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid board ID")
+		return
+	}
+
+	var state *models.SprintState
+	if stateStr := r.URL.Query().Get("state"); stateStr != "" {
+		s := models.SprintState(stateStr)
+		state = &s
+	}
+
+	sprints, err := h.sprintService.List(r.Context(), boardID, state, userID)
+	if err != nil {
+		respondServiceError(w, err, errorMessages{
+			NotFound: "Board not found",
+			Internal: "Failed to list sprints",
+		})
+		return
+	}
+
+	respondJSON(w, http.StatusOK, sprints)
+}
+
+// Get handles getting a sprint
+// @Summary Get a sprint
+// @Tags sprints
+// @Security BearerAuth
+// @Produce json
+// @Param id path int true "Sprint ID"
+// @Success 200 {object} models.Sprint
+// @Router /sprints/{id} [get]
+func (h *SprintHandler) Get(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value(middleware.UserIDContextKey).(int)
+
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid sprint ID")
+		return
+	}
+
+	sprint, err := h.sprintService.GetByID(r.Context(), id, userID)
+	if err != nil {
+		respondServiceError(w, err, errorMessages{
+			NotFound: "Sprint not found",
+			Internal: "Failed to get sprint",
+		})
+		return
+	}
+
+	respondJSON(w, http.StatusOK, sprint)
+}
+
+// Update handles updating a sprint
+// @Summary Update a sprint
+// @Tags sprints
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path int true "Sprint ID"
+// @Param request body models.UpdateSprintRequest true "Sprint changes"
+// @Success 200 {object} models.Sprint
+// @Router /sprints/{id} [put]
+func (h *SprintHandler) Update(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value(middleware.UserIDContextKey).(int)
+
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid sprint ID")
+		return
+	}
+
+	var req models.UpdateSprintRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	sprint, err := h.sprintService.Update(r.Context(), id, &req, userID)
+	if err != nil {
+		respondServiceError(w, err, errorMessages{
+			NotFound: "Sprint not found",
+			Internal: "Failed to update sprint",
+		})
+		return
+	}
+
+	respondJSON(w, http.StatusOK, sprint)
+}
+
+// Delete handles deleting a future sprint
+// @Summary Delete a sprint
+// @Description Deletes a future sprint; its issues return to the backlog
+// @Tags sprints
+// @Security BearerAuth
+// @Param id path int true "Sprint ID"
+// @Success 204
+// @Router /sprints/{id} [delete]
+func (h *SprintHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value(middleware.UserIDContextKey).(int)
+
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid sprint ID")
+		return
+	}
+
+	if err := h.sprintService.Delete(r.Context(), id, userID); err != nil {
+		respondServiceError(w, err, errorMessages{
+			NotFound: "Sprint not found",
+			Internal: "Failed to delete sprint",
+		})
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ListIssues handles listing the issues of a sprint
+// @Summary List sprint issues
+// @Tags sprints
+// @Security BearerAuth
+// @Produce json
+// @Param id path int true "Sprint ID"
+// @Success 200 {array} models.Issue
+// @Router /sprints/{id}/issues [get]
+func (h *SprintHandler) ListIssues(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value(middleware.UserIDContextKey).(int)
+
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid sprint ID")
+		return
+	}
+
+	issues, err := h.sprintService.ListIssues(r.Context(), id, userID)
+	if err != nil {
+		respondServiceError(w, err, errorMessages{
+			NotFound: "Sprint not found",
+			Internal: "Failed to list sprint issues",
+		})
+		return
+	}
+
+	respondJSON(w, http.StatusOK, issues)
+}
+
+// PlanIssues handles moving issues into a sprint
+// @Summary Plan issues into a sprint
+// @Description Moves issues from the backlog or another sprint into a future or active sprint
+// @Tags sprints
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path int true "Sprint ID"
+// @Param request body models.PlanSprintRequest true "Issues"
+// @Success 200 {object} models.Sprint
+// @Router /sprints/{id}/issues [post]
+func (h *SprintHandler) PlanIssues(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value(middleware.UserIDContextKey).(int)
+
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid sprint ID")
+		return
+	}
+
+	var req models.PlanSprintRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	sprint, err := h.sprintService.PlanIssues(r.Context(), id, &req, userID)
+	if err != nil {
+		respondServiceError(w, err, errorMessages{
+			NotFound: "Sprint not found",
+			Internal: "Failed to plan sprint issues",
+		})
+		return
+	}
+
+	respondJSON(w, http.StatusOK, sprint)
+}
+
+// RemoveIssue handles moving an issue of a sprint back to the backlog
+// @Summary Remove an issue from a sprint
+// @Tags sprints
+// @Security BearerAuth
+// @Param id path int true "Sprint ID"
+// @Param issueId path int true "Issue ID"
+// @Success 204
+// @Router /sprints/{id}/issues/{issueId} [delete]
+func (h *SprintHandler) RemoveIssue(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value(middleware.UserIDContextKey).(int)
+
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid sprint ID")
+		return
+	}
+
+	issueID, err := strconv.Atoi(r.PathValue("issueId"))
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid issue ID")
+		return
+	}
+
+	if err := h.sprintService.RemoveIssue(r.Context(), id, issueID, userID); err != nil {
+		respondServiceError(w, err, errorMessages{
+			NotFound: "Sprint not found",
+			Internal: "Failed to remove issue from sprint",
+		})
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// Start handles starting a sprint
+// @Summary Start a sprint
+// @Description Activates a future sprint; the issues in it become its committed work. A board runs one sprint at a time
+// @Tags sprints
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path int true "Sprint ID"
+// @Param request body models.StartSprintRequest false "Dates"
+// @Success 200 {object} models.Sprint
+// @Router /sprints/{id}/start [post]
+func (h *SprintHandler) Start(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value(middleware.UserIDContextKey).(int)
+
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid sprint ID")
+		return
+	}
+
+	var req models.StartSprintRequest
+	if r.ContentLength != 0 {
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			respondError(w, http.StatusBadRequest, "Invalid request body")
+			return
+		}
+	}
+
+	sprint, err := h.sprintService.Start(r.Context(), id, &req, userID)
+	if err != nil {
+		respondServiceError(w, err, errorMessages{
+			NotFound: "Sprint not found",
+			Conflict: "Another sprint is already active on this board",
+			Internal: "Failed to start sprint",
+		})
+		return
+	}
+
+	respondJSON(w, http.StatusOK, sprint)
+}
+
+// Complete handles completing the active sprint
+// @Summary Complete a sprint
+// @Description Closes the active sprint, moves unfinished issues to the backlog or the next sprint and stores the sprint report
+// @Tags sprints
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path int true "Sprint ID"
+// @Param request body models.CompleteSprintRequest false "Where unfinished issues go"
+// @Success 200 {object} models.Sprint
+// @Router /sprints/{id}/complete [post]
+func (h *SprintHandler) Complete(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value(middleware.UserIDContextKey).(int)
+
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid sprint ID")
+		return
+	}
+
+	var req models.CompleteSprintRequest
+	if r.ContentLength != 0 {
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			respondError(w, http.StatusBadRequest, "Invalid request body")
+			return
+		}
+	}
+
+	sprint, err := h.sprintService.Complete(r.Context(), id, &req, userID)
+	if err != nil {
+		respondServiceError(w, err, errorMessages{
+			NotFound: "Sprint not found",
+			Internal: "Failed to complete sprint",
+		})
+		return
+	}
+
+	respondJSON(w, http.StatusOK, sprint)
+}
+
+// GetReport handles getting the report of a closed sprint
+// @Summary Get a sprint report
+// @Description Committed versus completed work of a closed sprint
+// @Tags sprints
+// @Security BearerAuth
+// @Produce json
+// @Param id path int true "Sprint ID"
+// @Success 200 {object} models.SprintReport
+// @Router /sprints/{id}/report [get]
+func (h *SprintHandler) GetReport(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value(middleware.UserIDContextKey).(int)
+
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid sprint ID")
+		return
+	}
+
+	report, err := h.sprintService.GetReport(r.Context(), id, userID)
+	if err != nil {
+		respondServiceError(w, err, errorMessages{
+			NotFound: "Sprint not found",
+			Internal: "Failed to get sprint report",
+		})
+		return
+	}
+
+	respondJSON(w, http.StatusOK, report)
+}
@@ -0,0 +1,439 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/yourusername/issue-tracker/internal/api/middleware"
+	"github.com/yourusername/issue-tracker/internal/models"
+	"github.com/yourusername/issue-tracker/internal/service"
+)
+
+// TeamHandler handles team HTTP requests
+type TeamHandler struct {
+	teamService *service.TeamService
+}
+
+// NewTeamHandler creates a new team handler
+func NewTeamHandler(teamService *service.TeamService) *TeamHandler {
+	return &TeamHandler{
+		teamService: teamService,
+	}
+}
+
+// Create handles team creation
+// @Summary Create a team
+// @Description The slug is the team's mention handle (@slug)
+// @Tags teams
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path int true "Organization ID"
+// @Param request body models.CreateTeamRequest true "Team"
+// @Success 201 {object} models.Team
+// @Router /organizations/{id}/teams [post]
+func (h *TeamHandler) Create(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value(middleware.UserIDContextKey).(int)
+
+	orgID, ok := parseOrganizationID(w, r)
+	if !ok {
+		return
+	}
+
+	var req models.CreateTeamRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	team, err := h.teamService.Create(r.Context(), orgID, &req, userID)
+	if err != nil {
+		respondServiceError(w, err, errorMessages{
+			NotFound:  "Team not found",
+			Forbidden: "Insufficient organization role",
+			Conflict:  "Team slug already exists",
+			Internal:  "Failed to create team",
+		})
+		return
+	}
+
+	respondJSON(w, http.StatusCreated, team)
+}
+
+// List handles listing the teams of an organization
+// @Summary List teams
+// @Tags teams
+// @Security BearerAuth
+// @Produce json
+// @Param id path int true "Organization ID"
+// @Success 200 {array} models.Team
+// @Router /organizations/{id}/teams [get]
+func (h *TeamHandler) List(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value(middleware.UserIDContextKey).(int)
+
+	orgID, ok := parseOrganizationID(w, r)
+	if !ok {
+		return
+	}
+
+	teams, err := h.teamService.List(r.Context(), orgID, userID)
+	if err != nil {
+		respondServiceError(w, err, errorMessages{
+			NotFound:  "Organization not found",
+			Forbidden: "Insufficient organization role",
+			Internal:  "Failed to list teams",
+		})
+		return
+	}
+
+	respondJSON(w, http.StatusOK, teams)
+}
+
+// GetByID handles getting a team
+// @Summary Get a team
+// @Tags teams
+// @Security BearerAuth
+// @Produce json
+// @Param teamId path int true "Team ID"
+// @Success 200 {object} models.Team
+// @Router /teams/{teamId} [get]
+func (h *TeamHandler) GetByID(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value(middleware.UserIDContextKey).(int)
+
+	teamID, ok := parseTeamID(w, r)
+	if !ok {
+		return
+	}
+
+	team, err := h.teamService.GetByID(r.Context(), teamID, userID)
+	if err != nil {
+		respondServiceError(w, err, errorMessages{
+			NotFound:  "Team not found",
+			Forbidden: "Insufficient organization role",
+			Internal:  "Failed to get team",
+		})
+		return
+	}
+
+	respondJSON(w, http.StatusOK, team)
+}
+
+// Update handles updating a team
+// @Summary Update a team
+// @Tags teams
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param teamId path int true "Team ID"
+// @Param request body models.UpdateTeamRequest true "Changes"
+// @Success 200 {object} models.Team
+// @Router /teams/{teamId} [put]
+func (h *TeamHandler) Update(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value(middleware.UserIDContextKey).(int)
+
+	teamID, ok := parseTeamID(w, r)
+	if !ok {
+		return
+	}
+
+	var req models.UpdateTeamRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	team, err := h.teamService.Update(r.Context(), teamID, &req, userID)
+	if err != nil {
+		respondServiceError(w, err, errorMessages{
+			NotFound:  "Team not found",
+			Forbidden: "Insufficient organization role",
+			Internal:  "Failed to update team",
+		})
+		return
+	}
+
+	respondJSON(w, http.StatusOK, team)
+}
+
+// Delete handles deleting a team
+// @Summary Delete a team
+// @Description Members lose the project access granted through the team and assigned issues are unassigned
+// @Tags teams
+// @Security BearerAuth
+// @Param teamId path int true "Team ID"
+// @Success 204 "No Content"
+// @Router /teams/{teamId} [delete]
+func (h *TeamHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value(middleware.UserIDContextKey).(int)
+
+	teamID, ok := parseTeamID(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.teamService.Delete(r.Context(), teamID, userID); err != nil {
+		respondServiceError(w, err, errorMessages{
+			NotFound:  "Team not found",
+			Forbidden: "Insufficient organization role",
+			Internal:  "Failed to delete team",
+		})
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ListMembers handles listing the members of a team
+// @Summary List team members
+// @Tags teams
+// @Security BearerAuth
+// @Produce json
+// @Param teamId path int true "Team ID"
+// @Success 200 {array} models.TeamMember
+// @Router /teams/{teamId}/members [get]
+func (h *TeamHandler) ListMembers(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value(middleware.UserIDContextKey).(int)
+
+	teamID, ok := parseTeamID(w, r)
+	if !ok {
+		return
+	}
+
+	members, err := h.teamService.ListMembers(r.Context(), teamID, userID)
+	if err != nil {
+		respondServiceError(w, err, errorMessages{
+			NotFound:  "Team not found",
+			Forbidden: "Insufficient organization role",
+			Internal:  "Failed to list team members",
+		})
+		return
+	}
+
+	respondJSON(w, http.StatusOK, members)
+}
+
+// AddMember handles adding a member to a team
+// @Summary Add a team member
+// @Description The user must be a member of the team's organization and gets the team's project roles immediately
+// @Tags teams
+// @Security BearerAuth
+// @Accept json
+// @Param teamId path int true "Team ID"
+// @Param request body models.AddTeamMemberRequest true "Member"
+// @Success 201 "Created"
+// @Router /teams/{teamId}/members [post]
+func (h *TeamHandler) AddMember(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value(middleware.UserIDContextKey).(int)
+
+	teamID, ok := parseTeamID(w, r)
+	if !ok {
+		return
+	}
+
+	var req models.AddTeamMemberRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	if err := h.teamService.AddMember(r.Context(), teamID, &req, userID); err != nil {
+		respondServiceError(w, err, errorMessages{
+			NotFound:  "Team or user not found",
+			Forbidden: "Insufficient organization role",
+			Conflict:  "User is already a member",
+			Internal:  "Failed to add team member",
+		})
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+}
+
+// RemoveMember handles removing a member from a team
+// @Summary Remove a team member
+// @Tags teams
+// @Security BearerAuth
+// @Param teamId path int true "Team ID"
+// @Param userId path int true "User ID"
+// @Success 204 "No Content"
+// @Router /teams/{teamId}/members/{userId} [delete]
+func (h *TeamHandler) RemoveMember(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value(middleware.UserIDContextKey).(int)
+
+	teamID, ok := parseTeamID(w, r)
+	if !ok {
+		return
+	}
+
+	memberUserID, err := strconv.Atoi(r.PathValue("userId"))
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid user ID")
+		return
+	}
+
+	if err := h.teamService.RemoveMember(r.Context(), teamID, memberUserID, userID); err != nil {
+		respondServiceError(w, err, errorMessages{
+			NotFound:  "Team or member not found",
+			Forbidden: "Insufficient organization role",
+			Internal:  "Failed to remove team member",
+		})
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ListProjectTeams handles listing the teams of a project
+// @Summary List project teams
+// @Tags teams
+// @Security BearerAuth
+// @Produce json
+// @Param projectId path int true "Project ID"
+// @Success 200 {array} models.ProjectTeam
+// @Router /projects/{projectId}/teams [get]
+func (h *TeamHandler) ListProjectTeams(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value(middleware.UserIDContextKey).(int)
+
+	projectID, err := strconv.Atoi(r.PathValue("projectId"))
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid project ID")
+		return
+	}
+
+	teams, err := h.teamService.ListProjectTeams(r.Context(), projectID, userID)
+	if err != nil {
+		respondServiceError(w, err, errorMessages{
+			NotFound: "Project not found",
+			Internal: "Failed to list project teams",
+		})
+		return
+	}
+
+	respondJSON(w, http.StatusOK, teams)
+}
+
+// AddProjectTeam handles granting a team a project role
+// @Summary Add a team to a project
+// @Description Every team member gets the role; a member's effective role is the highest of their direct and team grants
+// @Tags teams
+// @Security BearerAuth
+// @Accept json
+// @Param projectId path int true "Project ID"
+// @Param request body models.AddProjectTeamRequest true "Team and role"
+// @Success 201 "Created"
+// @Router /projects/{projectId}/teams [post]
+func (h *TeamHandler) AddProjectTeam(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value(middleware.UserIDContextKey).(int)
+
+	projectID, err := strconv.Atoi(r.PathValue("projectId"))
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid project ID")
+		return
+	}
+
+	var req models.AddProjectTeamRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	if err := h.teamService.AddProjectTeam(r.Context(), projectID, &req, userID); err != nil {
+		respondServiceError(w, err, errorMessages{
+			NotFound: "Project or team not found",
+			Conflict: "Team already has access to this project",
+			Internal: "Failed to add team to project",
+		})
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+}
+
+// UpdateProjectTeamRole handles changing a team's project role
+// @Summary Update a project team's role
+// @Tags teams
+// @Security BearerAuth
+// @Accept json
+// @Param projectId path int true "Project ID"
+// @Param teamId path int true "Team ID"
+// @Param request body models.UpdateProjectTeamRequest true "Role"
+// @Success 204 "No Content"
+// @Router /projects/{projectId}/teams/{teamId} [put]
+func (h *TeamHandler) UpdateProjectTeamRole(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value(middleware.UserIDContextKey).(int)
+
+	projectID, teamID, ok := parseProjectTeamPath(w, r)
+	if !ok {
+		return
+	}
+
+	var req models.UpdateProjectTeamRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	if err := h.teamService.UpdateProjectTeamRole(r.Context(), projectID, teamID, &req, userID); err != nil {
+		respondServiceError(w, err, errorMessages{
+			NotFound: "Project or team not found",
+			Internal: "Failed to update project team role",
+		})
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// RemoveProjectTeam handles revoking a team's project role
+// @Summary Remove a team from a project
+// @Tags teams
+// @Security BearerAuth
+// @Param projectId path int true "Project ID"
+// @Param teamId path int true "Team ID"
+// @Success 204 "No Content"
+// @Router /projects/{projectId}/teams/{teamId} [delete]
+func (h *TeamHandler) RemoveProjectTeam(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value(middleware.UserIDContextKey).(int)
+
+	projectID, teamID, ok := parseProjectTeamPath(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.teamService.RemoveProjectTeam(r.Context(), projectID, teamID, userID); err != nil {
+		respondServiceError(w, err, errorMessages{
+			NotFound: "Project or team not found",
+			Internal: "Failed to remove team from project",
+		})
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// parseTeamID parses the team ID from the path
+func parseTeamID(w http.ResponseWriter, r *http.Request) (int, bool) {
+	teamID, err := strconv.Atoi(r.PathValue("teamId"))
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid team ID")
+		return 0, false
+	}
+	return teamID, true
+}
+
+// parseProjectTeamPath parses the project and team IDs from the path
+func parseProjectTeamPath(w http.ResponseWriter, r *http.Request) (int, int, bool) {
+	projectID, err := strconv.Atoi(r.PathValue("projectId"))
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid project ID")
+		return 0, 0, false
+	}
+
+	teamID, ok := parseTeamID(w, r)
+	if !ok {
+		return 0, 0, false
+	}
+
+	return projectID, teamID, true
+}
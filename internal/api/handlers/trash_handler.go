@@ -0,0 +1,115 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/yourusername/issue-tracker/internal/api/middleware"
+	"github.com/yourusername/issue-tracker/internal/service"
+)
+
+// TrashHandler handles trash HTTP requests
+type TrashHandler struct {
+	trashService *service.TrashService
+}
+
+// NewTrashHandler creates a new trash handler
+func NewTrashHandler(trashService *service.TrashService) *TrashHandler {
+	return &TrashHandler{
+		trashService: trashService,
+	}
+}
+
+// List handles listing the trash of a project
+// @Summary List deleted issues
+// @Description Lists the deleted issues of the project, most recently deleted first, with the time the retention job purges them
+// @Tags trash
+// @Security BearerAuth
+// @Produce json
+// @Param id path int true "Project ID"
+// @Success 200 {array} models.TrashedIssue
+// @Router /projects/{id}/trash [get]
+func (h *TrashHandler) List(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value(middleware.UserIDContextKey).(int)
+
+	projectID, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid project ID")
+		return
+	}
+
+	issues, err := h.trashService.List(r.Context(), projectID, userID)
+	if err != nil {
+		respondServiceError(w, err, errorMessages{
+			NotFound: "Project not found",
+			Internal: "Failed to list deleted issues",
+		})
+		return
+	}
+
+	respondJSON(w, http.StatusOK, issues)
+}
+
+// Restore handles restoring a deleted issue
+// @Summary Restore a deleted issue
+// @Description Restores the issue with the subtasks deleted with it, keeping its labels and board position
+// @Tags trash
+// @Security BearerAuth
+// @Produce json
+// @Param id path int true "Issue ID"
+// @Success 200 {object} models.Issue
+// @Router /issues/{id}/restore [post]
+func (h *TrashHandler) Restore(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value(middleware.UserIDContextKey).(int)
+
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid issue ID")
+		return
+	}
+
+	issue, err := h.trashService.Restore(r.Context(), id, userID)
+	if err != nil {
+		respondServiceError(w, err, errorMessages{
+			NotFound: "Deleted issue not found",
+			Internal: "Failed to restore issue",
+		})
+		return
+	}
+
+	respondJSON(w, http.StatusOK, issue)
+}
+
+// Purge handles permanently deleting an issue from the trash
+// @Summary Permanently delete an issue
+// @Description Deletes a deleted issue, its subtasks, comments and attachment files for good
+// @Tags trash
+// @Security BearerAuth
+// @Param id path int true "Project ID"
+// @Param issueId path int true "Issue ID"
+// @Success 204
+// @Router /projects/{id}/trash/{issueId} [delete]
+func (h *TrashHandler) Purge(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value(middleware.UserIDContextKey).(int)
+
+	projectID, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid project ID")
+		return
+	}
+	issueID, err := strconv.Atoi(r.PathValue("issueId"))
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid issue ID")
+		return
+	}
+
+	if err := h.trashService.Purge(r.Context(), projectID, issueID, userID); err != nil {
+		respondServiceError(w, err, errorMessages{
+			NotFound: "Deleted issue not found",
+			Internal: "Failed to delete issue permanently",
+		})
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,123 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/yourusername/issue-tracker/internal/api/middleware"
+	"github.com/yourusername/issue-tracker/internal/models"
+	"github.com/yourusername/issue-tracker/internal/service"
+)
+
+// TwoFactorHandler handles two-factor authentication requests of the current user
+type TwoFactorHandler struct {
+	twoFactorService *service.TwoFactorService
+}
+
+// NewTwoFactorHandler creates a new two-factor handler
+func NewTwoFactorHandler(twoFactorService *service.TwoFactorService) *TwoFactorHandler {
+	return &TwoFactorHandler{
+		twoFactorService: twoFactorService,
+	}
+}
+
+// GetStatus handles retrieving the two-factor state of the current user
+// @Summary Get two-factor status
+// @Tags account
+// @Security BearerAuth
+// @Produce json
+// @Success 200 {object} models.TwoFactorStatus
+// @Router /users/me/2fa [get]
+func (h *TwoFactorHandler) GetStatus(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value(middleware.UserIDContextKey).(int)
+
+	status, err := h.twoFactorService.Status(r.Context(), userID)
+	if err != nil {
+		respondServiceError(w, err, errorMessages{Internal: "Failed to get two-factor status"})
+		return
+	}
+
+	respondJSON(w, http.StatusOK, status)
+}
+
+// Setup handles generating a new TOTP secret
+// @Summary Set up two-factor authentication
+// @Description Generates a secret to enroll in an authenticator app; confirm it with a code to enable two-factor authentication
+// @Tags account
+// @Security BearerAuth
+// @Produce json
+// @Success 200 {object} models.TwoFactorSetupResponse
+// @Router /users/me/2fa/setup [post]
+func (h *TwoFactorHandler) Setup(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value(middleware.UserIDContextKey).(int)
+
+	setup, err := h.twoFactorService.Setup(r.Context(), userID)
+	if err != nil {
+		respondServiceError(w, err, errorMessages{
+			Forbidden: "Operation not allowed for this account",
+			Conflict:  "Two-factor authentication is already enabled",
+			Internal:  "Failed to set up two-factor authentication",
+		})
+		return
+	}
+
+	respondJSON(w, http.StatusOK, setup)
+}
+
+// Enable handles confirming the pending TOTP secret
+// @Summary Enable two-factor authentication
+// @Tags account
+// @Security BearerAuth
+// @Accept json
+// @Param request body models.TwoFactorCodeRequest true "Code from the authenticator app"
+// @Success 204
+// @Router /users/me/2fa/enable [post]
+func (h *TwoFactorHandler) Enable(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value(middleware.UserIDContextKey).(int)
+
+	var req models.TwoFactorCodeRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	if err := h.twoFactorService.Enable(r.Context(), userID, req.Code); err != nil {
+		respondServiceError(w, err, errorMessages{
+			NotFound: "Two-factor authentication has not been set up",
+			Conflict: "Two-factor authentication is already enabled",
+			Internal: "Failed to enable two-factor authentication",
+		})
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// Disable handles turning off two-factor authentication
+// @Summary Disable two-factor authentication
+// @Description Not allowed while an organization the user belongs to requires two-factor authentication
+// @Tags account
+// @Security BearerAuth
+// @Accept json
+// @Param request body models.TwoFactorCodeRequest true "Code from the authenticator app"
+// @Success 204
+// @Router /users/me/2fa [delete]
+func (h *TwoFactorHandler) Disable(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value(middleware.UserIDContextKey).(int)
+
+	var req models.TwoFactorCodeRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	if err := h.twoFactorService.Disable(r.Context(), userID, req.Code); err != nil {
+		respondServiceError(w, err, errorMessages{
+			NotFound: "Two-factor authentication is not enabled",
+			Internal: "Failed to disable two-factor authentication",
+		})
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
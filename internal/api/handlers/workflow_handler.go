@@ -0,0 +1,222 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/yourusername/issue-tracker/internal/api/middleware"
+	"github.com/yourusername/issue-tracker/internal/models"
+	"github.com/yourusername/issue-tracker/internal/service"
+	pkgerrors "github.com/yourusername/issue-tracker/pkg/errors"
+)
+
+// WorkflowHandler handles workflow HTTP requests
+type WorkflowHandler struct {
+	workflowService *service.WorkflowService
+}
+
+// NewWorkflowHandler creates a new workflow handler
+func NewWorkflowHandler(workflowService *service.WorkflowService) *WorkflowHandler {
+	return &WorkflowHandler{
+		workflowService: workflowService,
+	}
+}
+
+// Create handles workflow creation
+// @Summary Create a workflow
+// @Description Statuses are identified by key; transitions reference them by key (from_status omitted = from any status)
+// @Tags workflows
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param projectId path int true "Project ID"
+// @Param request body models.WorkflowRequest true "Workflow"
+// @Success 201 {object} models.Workflow
+// @Router /projects/{projectId}/workflows [post]
+func (h *WorkflowHandler) Create(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value(middleware.UserIDContextKey).(int)
+
+	projectID, err := strconv.Atoi(r.PathValue("projectId"))
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid project ID")
+		return
+	}
+
+	var req models.WorkflowRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	workflow, err := h.workflowService.Create(r.Context(), projectID, &req, userID)
+	if err != nil {
+		respondServiceError(w, err, errorMessages{
+			NotFound: "Project not found",
+			Conflict: "Issue type already uses another workflow",
+			Internal: "Failed to create workflow",
+		})
+		return
+	}
+
+	respondJSON(w, http.StatusCreated, workflow)
+}
+
+// List handles listing the workflows of a project
+// @Summary List workflows
+// @Description Projects without workflows return the built-in default workflow
+// @Tags workflows
+// @Security BearerAuth
+// @Produce json
+// @Param projectId path int true "Project ID"
+// @Success 200 {array} models.Workflow
+// @Router /projects/{projectId}/workflows [get]
+func (h *WorkflowHandler) List(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value(middleware.UserIDContextKey).(int)
+
+	projectID, err := strconv.Atoi(r.PathValue("projectId"))
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid project ID")
+		return
+	}
+
+	workflows, err := h.workflowService.List(r.Context(), projectID, userID)
+	if err != nil {
+		respondServiceError(w, err, errorMessages{
+			NotFound: "Project not found",
+			Internal: "Failed to list workflows",
+		})
+		return
+	}
+
+	respondJSON(w, http.StatusOK, workflows)
+}
+
+// GetByID handles getting a workflow
+// @Summary Get a workflow
+// @Tags workflows
+// @Security BearerAuth
+// @Produce json
+// @Param id path int true "Workflow ID"
+// @Success 200 {object} models.Workflow
+// @Router /workflows/{id} [get]
+func (h *WorkflowHandler) GetByID(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value(middleware.UserIDContextKey).(int)
+
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid workflow ID")
+		return
+	}
+
+	workflow, err := h.workflowService.GetByID(r.Context(), id, userID)
+	if err != nil {
+		respondServiceError(w, err, errorMessages{
+			NotFound: "Workflow not found",
+			Internal: "Failed to get workflow",
+		})
+		return
+	}
+
+	respondJSON(w, http.StatusOK, workflow)
+}
+
+// Update handles replacing a workflow
+// @Summary Replace a workflow
+// @Description Replaces the statuses, transitions and issue types of a workflow
+// @Tags workflows
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path int true "Workflow ID"
+// @Param request body models.WorkflowRequest true "Workflow"
+// @Success 200 {object} models.Workflow
+// @Router /workflows/{id} [put]
+func (h *WorkflowHandler) Update(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value(middleware.UserIDContextKey).(int)
+
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid workflow ID")
+		return
+	}
+
+	var req models.WorkflowRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	workflow, err := h.workflowService.Update(r.Context(), id, &req, userID)
+	if err != nil {
+		respondServiceError(w, err, errorMessages{
+			NotFound: "Workflow not found",
+			Conflict: "Issue type already uses another workflow",
+			Internal: "Failed to update workflow",
+		})
+		return
+	}
+
+	respondJSON(w, http.StatusOK, workflow)
+}
+
+// Delete handles deleting a workflow
+// @Summary Delete a workflow
+// @Tags workflows
+// @Security BearerAuth
+// @Param id path int true "Workflow ID"
+// @Success 204 "No Content"
+// @Router /workflows/{id} [delete]
+func (h *WorkflowHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value(middleware.UserIDContextKey).(int)
+
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid workflow ID")
+		return
+	}
+
+	if err := h.workflowService.Delete(r.Context(), id, userID); err != nil {
+		respondServiceError(w, err, errorMessages{
+			NotFound: "Workflow not found",
+			Internal: "Failed to delete workflow",
+		})
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// GetTransitions handles listing the transitions available on an issue
+// @Summary List available issue transitions
+// @Description Transitions from the issue's current status the current user can perform, with their required fields
+// @Tags workflows
+// @Security BearerAuth
+// @Produce json
+// @Param id path int true "Issue ID"
+// @Success 200 {array} models.WorkflowTransition
+// @Router /issues/{id}/transitions [get]
+func (h *WorkflowHandler) GetTransitions(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value(middleware.UserIDContextKey).(int)
+
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid issue ID")
+		return
+	}
+
+	transitions, err := h.workflowService.GetTransitions(r.Context(), id, userID)
+	if err != nil {
+		if err == pkgerrors.ErrNotFound {
+			respondError(w, http.StatusNotFound, "Issue not found")
+			return
+		}
+		respondServiceError(w, err, errorMessages{
+			NotFound: "Issue not found",
+			Internal: "Failed to get transitions",
+		})
+		return
+	}
+
+	respondJSON(w, http.StatusOK, transitions)
+}
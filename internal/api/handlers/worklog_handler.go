@@ -0,0 +1,307 @@
+package handlers
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/yourusername/issue-tracker/internal/api/middleware"
+	"github.com/yourusername/issue-tracker/internal/models"
+	"github.com/yourusername/issue-tracker/internal/service"
+	pkgerrors "github.com/yourusername/issue-tracker/pkg/errors"
+)
+
+// timesheetDateLayout is the date format of timesheet query parameters
+const timesheetDateLayout = "2006-01-02"
+
+// WorklogHandler handles time tracking HTTP requests
+type WorklogHandler struct {
+	worklogService *service.WorklogService
+}
+
+// NewWorklogHandler creates a new worklog handler
+func NewWorklogHandler(worklogService *service.WorklogService) *WorklogHandler {
+	return &WorklogHandler{
+		worklogService: worklogService,
+	}
+}
+
+// Create handles logging work on an issue
+// @Summary Log work
+// @Description Logs time spent on an issue; the remaining estimate is reduced by the time spent unless remaining_estimate_minutes is given
+// @Tags worklogs
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param issueId path int true "Issue ID"
+// @Param request body models.CreateWorklogRequest true "Worklog"
+// @Success 201 {object} models.Worklog
+// @Router /issues/{issueId}/worklogs [post]
+func (h *WorklogHandler) Create(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value(middleware.UserIDContextKey).(int)
+
+	issueID, err := strconv.Atoi(r.PathValue("issueId"))
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid issue ID")
+		return
+	}
+
+	var req models.CreateWorklogRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	worklog, err := h.worklogService.Create(r.Context(), issueID, &req, userID)
+	if err != nil {
+		respondServiceError(w, err, errorMessages{
+			NotFound: "Issue not found",
+			Internal: "Failed to log work",
+		})
+		return
+	}
+
+	respondJSON(w, http.StatusCreated, worklog)
+}
+
+// List handles listing the worklogs of an issue
+// @Summary List worklogs
+// @Tags worklogs
+// @Security BearerAuth
+// @Produce json
+// @Param issueId path int true "Issue ID"
+// @Success 200 {array} models.Worklog
+// @Router /issues/{issueId}/worklogs [get]
+func (h *WorklogHandler) List(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value(middleware.UserIDContextKey).(int)
+
+	issueID, err := strconv.Atoi(r.PathValue("issueId"))
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid issue ID")
+		return
+	}
+
+	worklogs, err := h.worklogService.ListByIssueID(r.Context(), issueID, userID)
+	if err != nil {
+		respondServiceError(w, err, errorMessages{
+			NotFound: "Issue not found",
+			Internal: "Failed to list worklogs",
+		})
+		return
+	}
+
+	respondJSON(w, http.StatusOK, worklogs)
+}
+
+// GetTimeTracking handles getting the estimates and logged time of an issue
+// @Summary Get time tracking
+// @Description Estimates and logged time of an issue, with totals over its subtasks and, for epics, the epic's issues
+// @Tags worklogs
+// @Security BearerAuth
+// @Produce json
+// @Param issueId path int true "Issue ID"
+// @Success 200 {object} models.TimeTracking
+// @Router /issues/{issueId}/time-tracking [get]
+func (h *WorklogHandler) GetTimeTracking(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value(middleware.UserIDContextKey).(int)
+
+	issueID, err := strconv.Atoi(r.PathValue("issueId"))
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid issue ID")
+		return
+	}
+
+	tracking, err := h.worklogService.GetTimeTracking(r.Context(), issueID, userID)
+	if err != nil {
+		if err == pkgerrors.ErrNotFound {
+			respondError(w, http.StatusNotFound, "Issue not found")
+			return
+		}
+		respondServiceError(w, err, errorMessages{
+			NotFound: "Issue not found",
+			Internal: "Failed to get time tracking",
+		})
+		return
+	}
+
+	respondJSON(w, http.StatusOK, tracking)
+}
+
+// Update handles updating a worklog
+// @Summary Update a worklog
+// @Description Only the author or a project admin can change a worklog
+// @Tags worklogs
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path int true "Worklog ID"
+// @Param request body models.UpdateWorklogRequest true "Worklog changes"
+// @Success 200 {object} models.Worklog
+// @Router /worklogs/{id} [put]
+func (h *WorklogHandler) Update(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value(middleware.UserIDContextKey).(int)
+
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid worklog ID")
+		return
+	}
+
+	var req models.UpdateWorklogRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	worklog, err := h.worklogService.Update(r.Context(), id, &req, userID)
+	if err != nil {
+		respondServiceError(w, err, errorMessages{
+			NotFound: "Worklog not found",
+			Internal: "Failed to update worklog",
+		})
+		return
+	}
+
+	respondJSON(w, http.StatusOK, worklog)
+}
+
+// Delete handles deleting a worklog
+// @Summary Delete a worklog
+// @Description Only the author or a project admin can delete a worklog
+// @Tags worklogs
+// @Security BearerAuth
+// @Param id path int true "Worklog ID"
+// @Success 204
+// @Router /worklogs/{id} [delete]
+func (h *WorklogHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value(middleware.UserIDContextKey).(int)
+
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "Invalid worklog ID")
+		return
+	}
+
+	if err := h.worklogService.Delete(r.Context(), id, userID); err != nil {
+		respondServiceError(w, err, errorMessages{
+			NotFound: "Worklog not found",
+			Internal: "Failed to delete worklog",
+		})
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// Timesheet handles the timesheet report
+// @Summary Timesheet report
+// @Description Time logged between two dates (inclusive) on projects the user can access, optionally for one user or project. Defaults to the last 30 days
+// @Tags worklogs
+// @Security BearerAuth
+// @Produce json,text/csv
+// @Param from query string false "Start date (YYYY-MM-DD)"
+// @Param to query string false "End date, inclusive (YYYY-MM-DD)"
+// @Param user_id query int false "User ID"
+// @Param project_id query int false "Project ID"
+// @Param format query string false "json (default) or csv"
+// @Success 200 {object} models.Timesheet
+// @Router /timesheets [get]
+func (h *WorklogHandler) Timesheet(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value(middleware.UserIDContextKey).(int)
+	query := r.URL.Query()
+
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+	filter := &models.TimesheetFilter{
+		From: today.AddDate(0, 0, -29),
+		To:   today.AddDate(0, 0, 1),
+	}
+
+	if fromStr := query.Get("from"); fromStr != "" {
+		from, err := time.Parse(timesheetDateLayout, fromStr)
+		if err != nil {
+			respondError(w, http.StatusBadRequest, "Invalid from date (expected YYYY-MM-DD)")
+			return
+		}
+		filter.From = from
+	}
+	if toStr := query.Get("to"); toStr != "" {
+		to, err := time.Parse(timesheetDateLayout, toStr)
+		if err != nil {
+			respondError(w, http.StatusBadRequest, "Invalid to date (expected YYYY-MM-DD)")
+			return
+		}
+		filter.To = to.AddDate(0, 0, 1)
+	}
+	if userStr := query.Get("user_id"); userStr != "" {
+		filterUserID, err := strconv.Atoi(userStr)
+		if err != nil {
+			respondError(w, http.StatusBadRequest, "Invalid user ID")
+			return
+		}
+		filter.UserID = &filterUserID
+	}
+	if projectStr := query.Get("project_id"); projectStr != "" {
+		projectID, err := strconv.Atoi(projectStr)
+		if err != nil {
+			respondError(w, http.StatusBadRequest, "Invalid project ID")
+			return
+		}
+		filter.ProjectID = &projectID
+	}
+
+	timesheet, err := h.worklogService.Timesheet(r.Context(), filter, userID)
+	if err != nil {
+		if err == pkgerrors.ErrNotFound {
+			respondError(w, http.StatusNotFound, "Project not found")
+			return
+		}
+		respondServiceError(w, err, errorMessages{
+			Internal: "Failed to build timesheet",
+		})
+		return
+	}
+
+	if query.Get("format") == "csv" {
+		writeTimesheetCSV(w, timesheet)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, timesheet)
+}
+
+// writeTimesheetCSV writes a timesheet as a CSV download, one row per worklog
+func writeTimesheetCSV(w http.ResponseWriter, timesheet *models.Timesheet) {
+	filename := fmt.Sprintf("timesheet-%s-%s.csv",
+		timesheet.From.Format(timesheetDateLayout), timesheet.To.AddDate(0, 0, -1).Format(timesheetDateLayout))
+
+	w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"%s\"", filename))
+	w.WriteHeader(http.StatusOK)
+
+	writer := csv.NewWriter(w)
+	_ = writer.Write([]string{"date", "started_at", "user", "project", "issue", "title", "minutes", "hours", "description"})
+	for _, entry := range timesheet.Entries {
+		description := ""
+		if entry.Description != nil {
+			description = *entry.Description
+		}
+		_ = writer.Write([]string{
+			entry.StartedAt.UTC().Format(timesheetDateLayout),
+			entry.StartedAt.UTC().Format(time.RFC3339),
+			entry.Username,
+			entry.ProjectKey,
+			fmt.Sprintf("%s-%d", entry.ProjectKey, entry.IssueNumber),
+			entry.IssueTitle,
+			strconv.Itoa(entry.TimeSpentMinutes),
+			strconv.FormatFloat(float64(entry.TimeSpentMinutes)/60, 'f', 2, 64),
+			description,
+		})
+	}
+	_ = writer.Write([]string{"total", "", "", "", "", "",
+		strconv.Itoa(timesheet.TotalTimeSpentMinutes),
+		strconv.FormatFloat(float64(timesheet.TotalTimeSpentMinutes)/60, 'f', 2, 64), ""})
+	writer.Flush()
+}
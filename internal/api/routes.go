@@ -1,6 +1,7 @@
 package api
 
 import (
+	"context"
 	"database/sql"
 	"log"
 	"net/http"
@@ -15,6 +16,7 @@ import (
 	"github.com/yourusername/issue-tracker/internal/service"
 	"github.com/yourusername/issue-tracker/pkg/cache"
 	"github.com/yourusername/issue-tracker/pkg/email"
+	"github.com/yourusername/issue-tracker/pkg/ldap"
 	"github.com/yourusername/issue-tracker/pkg/markdown"
 	"github.com/yourusername/issue-tracker/pkg/ratelimit"
 	"github.com/yourusername/issue-tracker/pkg/storage"
@@ -38,6 +40,18 @@ type Config struct {
 	SMTPUsername         string
 	SMTPPassword         string
 	SMTPFrom             string
+	LDAP                 ldap.Config
+
+	// Let LDAP logins take over local accounts with the same email
+	LDAPLinkExistingAccounts bool
+
+	// Due date reminders (disabled when ReminderInterval is zero)
+	ReminderInterval   time.Duration
+	ReminderDaysBefore int
+
+	// Trash retention (deleted issues are kept until purged by hand when zero)
+	TrashRetention     time.Duration
+	TrashPurgeInterval time.Duration
 }
 
 // NewRouter creates a new HTTP router with all routes
@@ -52,6 +66,7 @@ func NewRouter(config Config) http.Handler {
 	userRepo := repository.NewUserRepository(config.DB)
 	projectRepo := repository.NewProjectRepository(config.DB)
 	boardRepo := repository.NewBoardRepository(config.DB)
+	sprintRepo := repository.NewSprintRepository(config.DB)
 	issueRepo := repository.NewIssueRepository(config.DB)
 	commentRepo := repository.NewCommentRepository(config.DB)
 	labelRepo := repository.NewLabelRepository(config.DB)
@@ -67,9 +82,23 @@ func NewRouter(config Config) http.Handler {
 	referenceRepo := repository.NewIssueReferenceRepository(config.DB)
 	watcherRepo := repository.NewIssueWatcherRepository(config.DB)
 	tasklistRepo := repository.NewTasklistRepository(config.DB)
+	worklogRepo := repository.NewWorklogRepository(config.DB)
+	issueLinkRepo := repository.NewIssueLinkRepository(config.DB)
+	issueCloneRepo := repository.NewIssueCloneRepository(config.DB)
+	issueBulkRepo := repository.NewIssueBulkRepository(config.DB)
+	revisionRepo := repository.NewRevisionRepository(config.DB)
+	issueVoteRepo := repository.NewIssueVoteRepository(config.DB)
+	customFieldRepo := repository.NewCustomFieldRepository(config.DB)
+	reminderRepo := repository.NewIssueReminderRepository(config.DB)
 	webhookRepo := repository.NewWebhookRepository(config.DB)
 	integrationRepo := repository.NewIntegrationRepository(config.DB)
 	templateRepo := repository.NewTemplateRepository(config.DB)
+	serviceAccountRepo := repository.NewServiceAccountRepository(config.DB)
+	accountRepo := repository.NewAccountRepository(config.DB)
+	organizationRepo := repository.NewOrganizationRepository(config.DB)
+	teamRepo := repository.NewTeamRepository(config.DB)
+	workflowRepo := repository.NewWorkflowRepository(config.DB)
+	twoFactorRepo := repository.NewTwoFactorRepository(config.DB)
 
 	// Initialize JWT manager
 	jwtManager := auth.NewJWTManager(
@@ -93,29 +122,84 @@ func NewRouter(config Config) http.Handler {
 
 	// Initialize services
 	authService := service.NewAuthService(userRepo, jwtManager)
+	authService.SetServiceAccountRepo(serviceAccountRepo)
+	authService.SetTwoFactorRepo(twoFactorRepo)
+	if config.LDAP.URL != "" {
+		ldapClient := ldap.NewClient(config.LDAP)
+		authService.SetAuthenticators(
+			service.NewPasswordAuthenticator(userRepo),
+			service.NewLDAPAuthenticator(ldapClient, userRepo, config.LDAPLinkExistingAccounts),
+		)
+		log.Printf("LDAP authentication enabled: %s", config.LDAP.URL)
+	}
 	authorizationService := service.NewAuthorizationService(projectRepo, memberRepo)
-	projectService := service.NewProjectService(projectRepo, boardRepo, config.DB, config.Cache)
+	projectService := service.NewProjectService(projectRepo, boardRepo, userRepo, config.DB, config.Cache)
 	projectService.SetTemplateRepo(templateRepo)
 	projectService.SetLabelRepo(labelRepo)
+	projectService.SetOrganizationRepo(organizationRepo)
 	mentionService := service.NewMentionService(mentionRepo, userRepo, notificationRepo, config.DB)
+	mentionService.SetTeamRepo(teamRepo)
 	referenceService := service.NewIssueReferenceService(referenceRepo, issueRepo, config.DB)
+	referenceService.SetProjectRepository(projectRepo)
 	webhookService := service.NewWebhookService(webhookRepo, authorizationService)
+	webhookService.SetUserRepo(userRepo)
+	webhookService.SetProjectRepo(projectRepo)
 	integrationService := service.NewIntegrationService(integrationRepo, authorizationService)
+	integrationService.SetOrganizationRepo(organizationRepo)
 	issueService := service.NewIssueService(issueRepo, watcherRepo, authorizationService, config.DB, config.Cache, markdownRenderer, mentionService, referenceService, webhookService, integrationService)
+	workflowService := service.NewWorkflowService(workflowRepo, issueRepo, authorizationService)
+	issueService.SetWorkflowService(workflowService)
+	issueService.SetBoardRepository(boardRepo)
+	issueService.SetProjectRepository(projectRepo)
+	issueService.SetIssueLinkRepository(issueLinkRepo)
 	commentService := service.NewCommentService(commentRepo, issueRepo, authorizationService, config.DB, markdownRenderer, mentionService, referenceService, webhookService)
+	revisionService := service.NewRevisionService(revisionRepo, issueRepo, commentRepo, authorizationService, issueService, commentService)
+	issueService.SetRevisionService(revisionService)
+	commentService.SetRevisionService(revisionService)
 	labelService := service.NewLabelService(labelRepo, projectRepo, issueRepo, authorizationService, config.DB, config.Cache)
-	boardService := service.NewBoardService(boardRepo, projectRepo, authorizationService, config.DB)
+	boardService := service.NewBoardService(boardRepo, projectRepo, issueRepo, labelRepo, userRepo, authorizationService, config.DB)
+	boardService.SetWorkflowService(workflowService)
+	sprintService := service.NewSprintService(sprintRepo, boardRepo, issueRepo, authorizationService, config.Cache, webhookService)
 	memberService := service.NewProjectMemberService(memberRepo, projectRepo, userRepo, config.DB)
 	activityService := service.NewActivityService(activityRepo, projectRepo, issueRepo, config.DB)
+	customFieldService := service.NewCustomFieldService(customFieldRepo, userRepo, authorizationService, activityService)
+	issueService.SetCustomFieldService(customFieldService)
+	issueBulkService := service.NewIssueBulkService(issueBulkRepo, issueRepo, labelRepo, issueService, labelService, authorizationService, integrationService)
 	milestoneService := service.NewMilestoneService(milestoneRepo, projectRepo, authorizationService, config.Cache)
 	notificationService := service.NewNotificationService(notificationRepo, userRepo, emailClient)
+
+	// Send due date reminders in the background
+	if config.ReminderInterval > 0 {
+		reminderScheduler := service.NewReminderScheduler(reminderRepo, notificationService, config.ReminderInterval, config.ReminderDaysBefore)
+		go reminderScheduler.Run(context.Background())
+	}
+	issueMoveService := service.NewIssueMoveService(issueRepo, projectRepo, labelRepo, milestoneRepo, issueService, authorizationService, activityService, config.Cache, webhookService)
+	issuePinService := service.NewIssuePinService(issueRepo, issueService, authorizationService, activityService, config.Cache)
+	issueVoteService := service.NewIssueVoteService(issueVoteRepo, issueRepo, authorizationService, activityService, config.Cache)
+	issueCloneService := service.NewIssueCloneService(issueCloneRepo, issueRepo, projectRepo, attachmentRepo, watcherRepo, issueService, authorizationService, activityService, localStorage, config.Cache, webhookService)
+	trashService := service.NewTrashService(issueRepo, issueService, authorizationService, localStorage, config.Cache, webhookService, integrationService, config.TrashRetention)
+
+	// Purge expired issues from the trash in the background
+	if config.TrashRetention > 0 && config.TrashPurgeInterval > 0 {
+		trashPurger := service.NewTrashPurger(trashService, config.TrashPurgeInterval)
+		go trashPurger.Run(context.Background())
+	}
 	statisticsService := service.NewStatisticsService(statisticsRepo, projectRepo, memberRepo, config.Cache)
 	searchService := service.NewSearchService(searchRepo, projectRepo, memberRepo, config.Cache)
 	attachmentService := service.NewAttachmentService(attachmentRepo, issueRepo, authorizationService, localStorage, config.StorageMaxFileSize)
 	reactionService := service.NewReactionService(reactionRepo, issueRepo, commentRepo, authorizationService, config.DB)
 	watcherService := service.NewWatcherService(watcherRepo, issueRepo, notificationRepo, authorizationService, config.DB)
 	tasklistService := service.NewTasklistService(tasklistRepo, issueRepo, authorizationService, activityService)
+	worklogService := service.NewWorklogService(worklogRepo, issueRepo, authorizationService, activityService)
+	issueLinkService := service.NewIssueLinkService(issueLinkRepo, issueRepo, watcherRepo, issueService, authorizationService)
+	dependencyGraphService := service.NewDependencyGraphService(issueLinkRepo, projectRepo, authorizationService)
 	templateService := service.NewTemplateService(templateRepo)
+	serviceAccountService := service.NewServiceAccountService(serviceAccountRepo, projectRepo, authorizationService)
+	accountService := service.NewAccountService(accountRepo, userRepo, attachmentRepo, localStorage)
+	organizationService := service.NewOrganizationService(organizationRepo, projectRepo, userRepo)
+	organizationService.SetTwoFactorRepo(twoFactorRepo)
+	twoFactorService := service.NewTwoFactorService(twoFactorRepo, userRepo, organizationRepo)
+	teamService := service.NewTeamService(teamRepo, organizationRepo, organizationService, projectRepo, authorizationService)
 
 	// Initialize handlers
 	authHandler := handlers.NewAuthHandler(authService)
@@ -124,6 +208,7 @@ func NewRouter(config Config) http.Handler {
 	commentHandler := handlers.NewCommentHandler(commentService)
 	labelHandler := handlers.NewLabelHandler(labelService)
 	boardHandler := handlers.NewBoardHandler(boardService)
+	sprintHandler := handlers.NewSprintHandler(sprintService)
 	memberHandler := handlers.NewProjectMemberHandler(memberService)
 	activityHandler := handlers.NewActivityHandler(activityService)
 	milestoneHandler := handlers.NewMilestoneHandler(milestoneService)
@@ -132,11 +217,28 @@ func NewRouter(config Config) http.Handler {
 	searchHandler := handlers.NewSearchHandler(searchService)
 	attachmentHandler := handlers.NewAttachmentHandler(attachmentService)
 	reactionHandler := handlers.NewReactionHandler(reactionService)
+	revisionHandler := handlers.NewRevisionHandler(revisionService)
 	watcherHandler := handlers.NewWatcherHandler(watcherService)
 	tasklistHandler := handlers.NewTasklistHandler(tasklistService)
+	worklogHandler := handlers.NewWorklogHandler(worklogService)
+	issueLinkHandler := handlers.NewIssueLinkHandler(issueLinkService)
+	dependencyGraphHandler := handlers.NewDependencyGraphHandler(dependencyGraphService)
+	customFieldHandler := handlers.NewCustomFieldHandler(customFieldService)
+	trashHandler := handlers.NewTrashHandler(trashService)
+	issueMoveHandler := handlers.NewIssueMoveHandler(issueMoveService)
+	issuePinHandler := handlers.NewIssuePinHandler(issuePinService)
+	issueVoteHandler := handlers.NewIssueVoteHandler(issueVoteService)
+	issueCloneHandler := handlers.NewIssueCloneHandler(issueCloneService)
+	issueBulkHandler := handlers.NewIssueBulkHandler(issueBulkService)
 	webhookHandler := handlers.NewWebhookHandler(webhookService)
 	integrationHandler := handlers.NewIntegrationHandler(integrationService)
 	templateHandler := handlers.NewTemplateHandler(templateService)
+	serviceAccountHandler := handlers.NewServiceAccountHandler(serviceAccountService)
+	accountHandler := handlers.NewAccountHandler(accountService)
+	twoFactorHandler := handlers.NewTwoFactorHandler(twoFactorService)
+	organizationHandler := handlers.NewOrganizationHandler(organizationService)
+	teamHandler := handlers.NewTeamHandler(teamService)
+	workflowHandler := handlers.NewWorkflowHandler(workflowService)
 
 	// Create router
 	mux := http.NewServeMux()
@@ -152,16 +254,55 @@ func NewRouter(config Config) http.Handler {
 	protectedMux.HandleFunc("GET /api/v1/auth/me", authHandler.GetMe)
 	protectedMux.HandleFunc("GET /api/v1/users/search", authHandler.SearchUsers)
 
+	// Account routes (personal data export and account deletion)
+	protectedMux.HandleFunc("POST /api/v1/users/me/export", accountHandler.RequestExport)
+	protectedMux.HandleFunc("GET /api/v1/users/me/exports/{id}", accountHandler.GetExport)
+	protectedMux.HandleFunc("GET /api/v1/users/me/exports/{id}/download", accountHandler.DownloadExport)
+	protectedMux.HandleFunc("DELETE /api/v1/users/me", accountHandler.DeleteAccount)
+
+	// Two-factor authentication routes
+	protectedMux.HandleFunc("GET /api/v1/users/me/2fa", twoFactorHandler.GetStatus)
+	protectedMux.HandleFunc("POST /api/v1/users/me/2fa/setup", twoFactorHandler.Setup)
+	protectedMux.HandleFunc("POST /api/v1/users/me/2fa/enable", twoFactorHandler.Enable)
+	protectedMux.HandleFunc("DELETE /api/v1/users/me/2fa", twoFactorHandler.Disable)
+
+	// Organization routes
+	protectedMux.HandleFunc("POST /api/v1/organizations", organizationHandler.Create)
+	protectedMux.HandleFunc("GET /api/v1/organizations", organizationHandler.List)
+	protectedMux.HandleFunc("GET /api/v1/organizations/{id}", organizationHandler.GetByID)
+	protectedMux.HandleFunc("PUT /api/v1/organizations/{id}", organizationHandler.Update)
+	protectedMux.HandleFunc("DELETE /api/v1/organizations/{id}", organizationHandler.Delete)
+	protectedMux.HandleFunc("PUT /api/v1/organizations/{id}/settings", organizationHandler.UpdateSettings)
+	protectedMux.HandleFunc("GET /api/v1/organizations/{id}/projects", organizationHandler.ListProjects)
+	protectedMux.HandleFunc("GET /api/v1/organizations/{id}/members", organizationHandler.ListMembers)
+	protectedMux.HandleFunc("POST /api/v1/organizations/{id}/members", organizationHandler.AddMember)
+	protectedMux.HandleFunc("PUT /api/v1/organizations/{id}/members/{userId}", organizationHandler.UpdateMemberRole)
+	protectedMux.HandleFunc("DELETE /api/v1/organizations/{id}/members/{userId}", organizationHandler.RemoveMember)
+
+	// Team routes (groups of organization members)
+	protectedMux.HandleFunc("POST /api/v1/organizations/{id}/teams", teamHandler.Create)
+	protectedMux.HandleFunc("GET /api/v1/organizations/{id}/teams", teamHandler.List)
+	protectedMux.HandleFunc("GET /api/v1/teams/{teamId}", teamHandler.GetByID)
+	protectedMux.HandleFunc("PUT /api/v1/teams/{teamId}", teamHandler.Update)
+	protectedMux.HandleFunc("DELETE /api/v1/teams/{teamId}", teamHandler.Delete)
+	protectedMux.HandleFunc("GET /api/v1/teams/{teamId}/members", teamHandler.ListMembers)
+	protectedMux.HandleFunc("POST /api/v1/teams/{teamId}/members", teamHandler.AddMember)
+	protectedMux.HandleFunc("DELETE /api/v1/teams/{teamId}/members/{userId}", teamHandler.RemoveMember)
+
 	// Project routes
 	protectedMux.HandleFunc("POST /api/v1/projects", projectHandler.Create)
 	protectedMux.HandleFunc("GET /api/v1/projects", projectHandler.List)
 	protectedMux.HandleFunc("GET /api/v1/projects/{id}", projectHandler.GetByID)
 	protectedMux.HandleFunc("PUT /api/v1/projects/{id}", projectHandler.Update)
 	protectedMux.HandleFunc("DELETE /api/v1/projects/{id}", projectHandler.Delete)
+	protectedMux.HandleFunc("GET /api/v1/projects/{id}/estimation", projectHandler.GetEstimationSettings)
+	protectedMux.HandleFunc("PUT /api/v1/projects/{id}/estimation", projectHandler.UpdateEstimationSettings)
 
 	// Issue routes
 	protectedMux.HandleFunc("POST /api/v1/projects/{projectId}/issues", issueHandler.Create)
 	protectedMux.HandleFunc("GET /api/v1/projects/{projectId}/issues", issueHandler.List)
+	protectedMux.HandleFunc("POST /api/v1/projects/{projectId}/issues/bulk", issueBulkHandler.Apply)
+	protectedMux.HandleFunc("GET /api/v1/bulk-jobs/{id}", issueBulkHandler.GetJob)
 	protectedMux.HandleFunc("GET /api/v1/projects/{projectId}/issue-by-number/{issueNumber}", issueHandler.GetByNumber)
 	protectedMux.HandleFunc("GET /api/v1/projects/{projectId}/epics", issueHandler.GetEpics)
 	protectedMux.HandleFunc("GET /api/v1/issues/{id}", issueHandler.GetByID)
@@ -169,10 +310,31 @@ func NewRouter(config Config) http.Handler {
 	protectedMux.HandleFunc("PUT /api/v1/issues/{id}", issueHandler.Update)
 	protectedMux.HandleFunc("DELETE /api/v1/issues/{id}", issueHandler.Delete)
 	protectedMux.HandleFunc("PUT /api/v1/issues/{id}/move", issueHandler.MoveToColumn)
+	protectedMux.HandleFunc("POST /api/v1/issues/{id}/move-project", issueMoveHandler.MoveToProject)
+	protectedMux.HandleFunc("POST /api/v1/issues/{id}/pin", issuePinHandler.Pin)
+	protectedMux.HandleFunc("DELETE /api/v1/issues/{id}/pin", issuePinHandler.Unpin)
+
+	// Vote and customer request routes
+	protectedMux.HandleFunc("GET /api/v1/issues/{id}/votes", issueVoteHandler.GetVotes)
+	protectedMux.HandleFunc("PUT /api/v1/issues/{id}/vote", issueVoteHandler.Vote)
+	protectedMux.HandleFunc("DELETE /api/v1/issues/{id}/vote", issueVoteHandler.Unvote)
+	protectedMux.HandleFunc("GET /api/v1/issues/{id}/customer-requests", issueVoteHandler.ListCustomerRequests)
+	protectedMux.HandleFunc("POST /api/v1/issues/{id}/customer-requests", issueVoteHandler.AddCustomerRequest)
+	protectedMux.HandleFunc("DELETE /api/v1/issues/{id}/customer-requests/{requestId}", issueVoteHandler.DeleteCustomerRequest)
+	protectedMux.HandleFunc("POST /api/v1/issues/{id}/clone", issueCloneHandler.Clone)
+	protectedMux.HandleFunc("GET /api/v1/clone-jobs/{id}", issueCloneHandler.GetJob)
 	protectedMux.HandleFunc("GET /api/v1/issues/{id}/subtasks", issueHandler.GetSubtasks)
 	protectedMux.HandleFunc("GET /api/v1/issues/{id}/subtasks/progress", issueHandler.GetSubtaskProgress)
 	protectedMux.HandleFunc("GET /api/v1/issues/{id}/epic-issues", issueHandler.GetEpicIssues)
 	protectedMux.HandleFunc("GET /api/v1/issues/{id}/epic-progress", issueHandler.GetEpicProgress)
+	protectedMux.HandleFunc("GET /api/v1/issues/{id}/transitions", workflowHandler.GetTransitions)
+
+	// Workflow routes (custom statuses and transitions)
+	protectedMux.HandleFunc("POST /api/v1/projects/{projectId}/workflows", workflowHandler.Create)
+	protectedMux.HandleFunc("GET /api/v1/projects/{projectId}/workflows", workflowHandler.List)
+	protectedMux.HandleFunc("GET /api/v1/workflows/{id}", workflowHandler.GetByID)
+	protectedMux.HandleFunc("PUT /api/v1/workflows/{id}", workflowHandler.Update)
+	protectedMux.HandleFunc("DELETE /api/v1/workflows/{id}", workflowHandler.Delete)
 
 	// Comment routes
 	protectedMux.HandleFunc("POST /api/v1/issues/{issueId}/comments", commentHandler.Create)
@@ -197,6 +359,27 @@ func NewRouter(config Config) http.Handler {
 	protectedMux.HandleFunc("POST /api/v1/projects/{projectId}/board/columns", boardHandler.Create)
 	protectedMux.HandleFunc("PUT /api/v1/board/columns/{id}", boardHandler.Update)
 	protectedMux.HandleFunc("DELETE /api/v1/board/columns/{id}", boardHandler.Delete)
+	protectedMux.HandleFunc("GET /api/v1/board/columns/{id}/wip-overrides", boardHandler.ListWIPOverrides)
+	protectedMux.HandleFunc("POST /api/v1/projects/{projectId}/boards", boardHandler.CreateBoard)
+	protectedMux.HandleFunc("GET /api/v1/projects/{projectId}/boards", boardHandler.ListBoards)
+	protectedMux.HandleFunc("GET /api/v1/boards/{id}", boardHandler.GetBoard)
+	protectedMux.HandleFunc("PUT /api/v1/boards/{id}", boardHandler.UpdateBoard)
+	protectedMux.HandleFunc("DELETE /api/v1/boards/{id}", boardHandler.DeleteBoard)
+	protectedMux.HandleFunc("POST /api/v1/boards/{id}/columns", boardHandler.CreateBoardColumn)
+	protectedMux.HandleFunc("GET /api/v1/boards/{id}/view", boardHandler.GetBoardView)
+
+	// Sprint routes
+	protectedMux.HandleFunc("POST /api/v1/boards/{id}/sprints", sprintHandler.Create)
+	protectedMux.HandleFunc("GET /api/v1/boards/{id}/sprints", sprintHandler.List)
+	protectedMux.HandleFunc("GET /api/v1/sprints/{id}", sprintHandler.Get)
+	protectedMux.HandleFunc("PUT /api/v1/sprints/{id}", sprintHandler.Update)
+	protectedMux.HandleFunc("DELETE /api/v1/sprints/{id}", sprintHandler.Delete)
+	protectedMux.HandleFunc("GET /api/v1/sprints/{id}/issues", sprintHandler.ListIssues)
+	protectedMux.HandleFunc("POST /api/v1/sprints/{id}/issues", sprintHandler.PlanIssues)
+	protectedMux.HandleFunc("DELETE /api/v1/sprints/{id}/issues/{issueId}", sprintHandler.RemoveIssue)
+	protectedMux.HandleFunc("POST /api/v1/sprints/{id}/start", sprintHandler.Start)
+	protectedMux.HandleFunc("POST /api/v1/sprints/{id}/complete", sprintHandler.Complete)
+	protectedMux.HandleFunc("GET /api/v1/sprints/{id}/report", sprintHandler.GetReport)
 
 	// Project Member routes
 	protectedMux.HandleFunc("GET /api/v1/projects/{projectId}/members", memberHandler.ListMembers)
@@ -205,6 +388,20 @@ func NewRouter(config Config) http.Handler {
 	protectedMux.HandleFunc("DELETE /api/v1/projects/{projectId}/members/{userId}", memberHandler.RemoveMember)
 	protectedMux.HandleFunc("GET /api/v1/users/me/memberships", memberHandler.GetUserMemberships)
 
+	// Project Team routes
+	protectedMux.HandleFunc("GET /api/v1/projects/{projectId}/teams", teamHandler.ListProjectTeams)
+	protectedMux.HandleFunc("POST /api/v1/projects/{projectId}/teams", teamHandler.AddProjectTeam)
+	protectedMux.HandleFunc("PUT /api/v1/projects/{projectId}/teams/{teamId}", teamHandler.UpdateProjectTeamRole)
+	protectedMux.HandleFunc("DELETE /api/v1/projects/{projectId}/teams/{teamId}", teamHandler.RemoveProjectTeam)
+
+	// Service account routes (bot users authenticated with API tokens)
+	protectedMux.HandleFunc("POST /api/v1/projects/{projectId}/service-accounts", serviceAccountHandler.Create)
+	protectedMux.HandleFunc("GET /api/v1/projects/{projectId}/service-accounts", serviceAccountHandler.List)
+	protectedMux.HandleFunc("DELETE /api/v1/projects/{projectId}/service-accounts/{accountId}", serviceAccountHandler.Delete)
+	protectedMux.HandleFunc("POST /api/v1/projects/{projectId}/service-accounts/{accountId}/tokens", serviceAccountHandler.CreateToken)
+	protectedMux.HandleFunc("GET /api/v1/projects/{projectId}/service-accounts/{accountId}/tokens", serviceAccountHandler.ListTokens)
+	protectedMux.HandleFunc("DELETE /api/v1/projects/{projectId}/service-accounts/{accountId}/tokens/{tokenId}", serviceAccountHandler.RevokeToken)
+
 	// Activity routes
 	protectedMux.HandleFunc("GET /api/v1/projects/{projectId}/activities", activityHandler.ListByProject)
 	protectedMux.HandleFunc("GET /api/v1/issues/{issueId}/activities", activityHandler.ListByIssue)
@@ -246,6 +443,12 @@ func NewRouter(config Config) http.Handler {
 	protectedMux.HandleFunc("GET /api/v1/reactions/{entity_type}/{entity_id}/summary", reactionHandler.GetReactionSummary)
 	protectedMux.HandleFunc("DELETE /api/v1/reactions/{entity_type}/{entity_id}/{emoji}", reactionHandler.RemoveReaction)
 
+	// Revision routes
+	protectedMux.HandleFunc("GET /api/v1/revisions/{entity_type}/{entity_id}", revisionHandler.List)
+	protectedMux.HandleFunc("GET /api/v1/revisions/{entity_type}/{entity_id}/diff", revisionHandler.Diff)
+	protectedMux.HandleFunc("POST /api/v1/revisions/{entity_type}/{entity_id}/{revision}/restore", revisionHandler.Restore)
+	protectedMux.HandleFunc("POST /api/v1/revisions/{entity_type}/{entity_id}/{revision}/redact", revisionHandler.Redact)
+
 	// Watcher routes
 	protectedMux.HandleFunc("POST /api/v1/projects/{projectId}/issues/{issueNumber}/watch", watcherHandler.WatchIssue)
 	protectedMux.HandleFunc("DELETE /api/v1/projects/{projectId}/issues/{issueNumber}/watch", watcherHandler.UnwatchIssue)
@@ -263,6 +466,31 @@ func NewRouter(config Config) http.Handler {
 	protectedMux.HandleFunc("DELETE /api/v1/tasklist/{id}", tasklistHandler.Delete)
 	protectedMux.HandleFunc("PATCH /api/v1/tasklist/{id}/toggle", tasklistHandler.Toggle)
 
+	// Time tracking routes
+	protectedMux.HandleFunc("POST /api/v1/issues/{issueId}/worklogs", worklogHandler.Create)
+	protectedMux.HandleFunc("GET /api/v1/issues/{issueId}/worklogs", worklogHandler.List)
+	protectedMux.HandleFunc("GET /api/v1/issues/{issueId}/time-tracking", worklogHandler.GetTimeTracking)
+	protectedMux.HandleFunc("PUT /api/v1/worklogs/{id}", worklogHandler.Update)
+	protectedMux.HandleFunc("DELETE /api/v1/worklogs/{id}", worklogHandler.Delete)
+	protectedMux.HandleFunc("GET /api/v1/timesheets", worklogHandler.Timesheet)
+
+	// Issue link routes
+	protectedMux.HandleFunc("POST /api/v1/issues/{issueId}/links", issueLinkHandler.Create)
+	protectedMux.HandleFunc("GET /api/v1/issues/{issueId}/links", issueLinkHandler.List)
+	protectedMux.HandleFunc("DELETE /api/v1/issue-links/{id}", issueLinkHandler.Delete)
+	protectedMux.HandleFunc("GET /api/v1/projects/{id}/dependency-graph", dependencyGraphHandler.Get)
+
+	// Custom field routes
+	protectedMux.HandleFunc("POST /api/v1/projects/{id}/custom-fields", customFieldHandler.Create)
+	protectedMux.HandleFunc("GET /api/v1/projects/{id}/custom-fields", customFieldHandler.List)
+	protectedMux.HandleFunc("PUT /api/v1/custom-fields/{id}", customFieldHandler.Update)
+	protectedMux.HandleFunc("DELETE /api/v1/custom-fields/{id}", customFieldHandler.Delete)
+
+	// Trash routes
+	protectedMux.HandleFunc("GET /api/v1/projects/{id}/trash", trashHandler.List)
+	protectedMux.HandleFunc("POST /api/v1/issues/{id}/restore", trashHandler.Restore)
+	protectedMux.HandleFunc("DELETE /api/v1/projects/{id}/trash/{issueId}", trashHandler.Purge)
+
 	// Webhook routes
 	protectedMux.HandleFunc("POST /api/v1/projects/{projectId}/webhooks", webhookHandler.Create)
 	protectedMux.HandleFunc("GET /api/v1/projects/{projectId}/webhooks", webhookHandler.List)
@@ -295,6 +523,12 @@ func NewRouter(config Config) http.Handler {
 	// Note: /api/v1/auth/ routes like /register, /login, /refresh, /token-exchange are public
 	// Only /api/v1/auth/me needs authentication
 	mux.Handle("GET /api/v1/auth/me", middleware.Authenticate(authService)(protectedMux))
+	mux.Handle("/api/v1/organizations", middleware.Authenticate(authService)(protectedMux))
+	mux.Handle("/api/v1/organizations/", middleware.Authenticate(authService)(protectedMux))
+	mux.Handle("/api/v1/teams", middleware.Authenticate(authService)(protectedMux))
+	mux.Handle("/api/v1/teams/", middleware.Authenticate(authService)(protectedMux))
+	mux.Handle("/api/v1/workflows", middleware.Authenticate(authService)(protectedMux))
+	mux.Handle("/api/v1/workflows/", middleware.Authenticate(authService)(protectedMux))
 	mux.Handle("/api/v1/projects", middleware.Authenticate(authService)(protectedMux))
 	mux.Handle("/api/v1/projects/", middleware.Authenticate(authService)(protectedMux))
 	mux.Handle("/api/v1/issues", middleware.Authenticate(authService)(protectedMux))
@@ -305,6 +539,10 @@ func NewRouter(config Config) http.Handler {
 	mux.Handle("/api/v1/labels/", middleware.Authenticate(authService)(protectedMux))
 	mux.Handle("/api/v1/board", middleware.Authenticate(authService)(protectedMux))
 	mux.Handle("/api/v1/board/", middleware.Authenticate(authService)(protectedMux))
+	mux.Handle("/api/v1/boards", middleware.Authenticate(authService)(protectedMux))
+	mux.Handle("/api/v1/boards/", middleware.Authenticate(authService)(protectedMux))
+	mux.Handle("/api/v1/sprints", middleware.Authenticate(authService)(protectedMux))
+	mux.Handle("/api/v1/sprints/", middleware.Authenticate(authService)(protectedMux))
 	mux.Handle("/api/v1/milestones", middleware.Authenticate(authService)(protectedMux))
 	mux.Handle("/api/v1/milestones/", middleware.Authenticate(authService)(protectedMux))
 	mux.Handle("/api/v1/notifications", middleware.Authenticate(authService)(protectedMux))
@@ -317,8 +555,16 @@ func NewRouter(config Config) http.Handler {
 	mux.Handle("/api/v1/attachments/", middleware.Authenticate(authService)(protectedMux))
 	mux.Handle("/api/v1/reactions", middleware.Authenticate(authService)(protectedMux))
 	mux.Handle("/api/v1/reactions/", middleware.Authenticate(authService)(protectedMux))
+	mux.Handle("/api/v1/revisions/", middleware.Authenticate(authService)(protectedMux))
 	mux.Handle("/api/v1/tasklist", middleware.Authenticate(authService)(protectedMux))
 	mux.Handle("/api/v1/tasklist/", middleware.Authenticate(authService)(protectedMux))
+	mux.Handle("/api/v1/worklogs", middleware.Authenticate(authService)(protectedMux))
+	mux.Handle("/api/v1/worklogs/", middleware.Authenticate(authService)(protectedMux))
+	mux.Handle("/api/v1/timesheets", middleware.Authenticate(authService)(protectedMux))
+	mux.Handle("/api/v1/issue-links/", middleware.Authenticate(authService)(protectedMux))
+	mux.Handle("/api/v1/custom-fields/", middleware.Authenticate(authService)(protectedMux))
+	mux.Handle("/api/v1/clone-jobs/", middleware.Authenticate(authService)(protectedMux))
+	mux.Handle("/api/v1/bulk-jobs/", middleware.Authenticate(authService)(protectedMux))
 	mux.Handle("/api/v1/webhooks", middleware.Authenticate(authService)(protectedMux))
 	mux.Handle("/api/v1/webhooks/", middleware.Authenticate(authService)(protectedMux))
 	mux.Handle("/api/v1/webhook-events", middleware.Authenticate(authService)(protectedMux))
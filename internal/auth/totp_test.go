@@ -0,0 +1,77 @@
+package auth
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+// rfc6238Secret is the SHA-1 test key of RFC 6238, base32 encoded
+const rfc6238Secret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+func TestTOTPCode(t *testing.T) {
+	tests := []struct {
+		unix int64
+		want string
+	}{
+		{59, "287082"},
+		{1111111109, "081804"},
+		{1234567890, "005924"},
+		{2000000000, "279037"},
+	}
+
+	for _, tt := range tests {
+		got, err := TOTPCode(rfc6238Secret, time.Unix(tt.unix, 0))
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if got != tt.want {
+			t.Errorf("At %d expected code %s, got %s", tt.unix, tt.want, got)
+		}
+	}
+}
+
+func TestValidateTOTP(t *testing.T) {
+	now := time.Unix(1111111109, 0)
+
+	t.Run("should accept the current code", func(t *testing.T) {
+		if !ValidateTOTP(rfc6238Secret, "081804", now) {
+			t.Error("Expected the current code to be valid")
+		}
+	})
+
+	t.Run("should accept a code from the adjacent period", func(t *testing.T) {
+		if !ValidateTOTP(rfc6238Secret, "081804", now.Add(30*time.Second)) {
+			t.Error("Expected the previous period's code to be valid")
+		}
+	})
+
+	t.Run("should reject an old code", func(t *testing.T) {
+		if ValidateTOTP(rfc6238Secret, "081804", now.Add(2*time.Minute)) {
+			t.Error("Expected an old code to be rejected")
+		}
+	})
+
+	t.Run("should reject malformed codes", func(t *testing.T) {
+		for _, code := range []string{"", "81804", "0818040", "abcdef"} {
+			if ValidateTOTP(rfc6238Secret, code, now) {
+				t.Errorf("Expected %q to be rejected", code)
+			}
+		}
+	})
+}
+
+func TestGenerateTOTPSecret(t *testing.T) {
+	secret, err := GenerateTOTPSecret()
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(secret) != 32 {
+		t.Errorf("Expected a 32 character secret, got %q", secret)
+	}
+
+	uri := TOTPURI("Issue Tracker", "alice@example.com", secret)
+	if !strings.HasPrefix(uri, "otpauth://totp/Issue%20Tracker:alice@example.com?") || !strings.Contains(uri, "secret="+secret) {
+		t.Errorf("Unexpected URI %s", uri)
+	}
+}
@@ -0,0 +1,49 @@
+package models
+
+import (
+	"strings"
+	"time"
+)
+
+// DeletedUserUsername is the username of the placeholder account that
+// authored content is reassigned to when a user deletes their account
+// The placeholder is identified by users.is_placeholder; the name is reserved
+// so no real account can be mistaken for it
+const DeletedUserUsername = "deleted-user"
+
+// IsReservedUsername reports whether a username is reserved for the system
+func IsReservedUsername(username string) bool {
+	return strings.EqualFold(strings.TrimSpace(username), DeletedUserUsername)
+}
+
+// UserExportStatus represents the state of a personal data export
+type UserExportStatus string
+
+const (
+	ExportStatusPending     UserExportStatus = "pending"
+	ExportStatusProcessing  UserExportStatus = "processing"
+	ExportStatusCompleted   UserExportStatus = "completed"
+	ExportStatusFailed      UserExportStatus = "failed"
+	ExportStatusDownloading UserExportStatus = "downloading" // A download is in progress
+	ExportStatusDownloaded  UserExportStatus = "downloaded"
+)
+
+// UserExport represents a personal data export job
+// The ZIP archive can be downloaded once and is removed from storage afterwards;
+// an interrupted download can be retried
+type UserExport struct {
+	ID           int              `json:"id"`
+	UserID       int              `json:"user_id"`
+	Status       UserExportStatus `json:"status"`
+	StorageKey   *string          `json:"-"`
+	FileSize     *int64           `json:"file_size,omitempty"`
+	ErrorMessage *string          `json:"error_message,omitempty"`
+	CreatedAt    time.Time        `json:"created_at"`
+	CompletedAt  *time.Time       `json:"completed_at,omitempty"`
+	DownloadedAt *time.Time       `json:"downloaded_at,omitempty"`
+}
+
+// DeleteAccountRequest represents the request to delete the current account
+type DeleteAccountRequest struct {
+	Confirm string `json:"confirm" validate:"required"` // Must match the username
+}
@@ -0,0 +1,106 @@
+package models
+
+import "time"
+
+// Swimlane groups the cards of a board into horizontal lanes
+type Swimlane string
+
+const (
+	SwimlaneNone     Swimlane = "none"
+	SwimlaneAssignee Swimlane = "assignee"
+	SwimlaneEpic     Swimlane = "epic"
+	SwimlanePriority Swimlane = "priority"
+	SwimlaneLabel    Swimlane = "label" // Issues with several labels appear in each of their lanes
+)
+
+// Board represents a kanban board of a project with its own columns
+type Board struct {
+	ID          int         `json:"id"`
+	ProjectID   int         `json:"project_id"`
+	Name        string      `json:"name"`
+	Description *string     `json:"description,omitempty"`
+	IsDefault   bool        `json:"is_default"` // Board whose columns follow status changes
+	Filter      BoardFilter `json:"filter"`
+	Swimlane    Swimlane    `json:"swimlane"`
+	CreatedBy   *int        `json:"created_by,omitempty"`
+	CreatedAt   time.Time   `json:"created_at"`
+	UpdatedAt   time.Time   `json:"updated_at"`
+
+	Columns []*BoardColumn `json:"columns,omitempty"`
+}
+
+// BoardFilter is the saved filter selecting the issues shown on a board
+type BoardFilter struct {
+	IssueTypes     []IssueType    `json:"issue_types,omitempty"`
+	LabelIDs       []int          `json:"label_ids,omitempty"`
+	AssigneeID     *int           `json:"assignee_id,omitempty"`
+	AssigneeTeamID *int           `json:"assignee_team_id,omitempty"`
+	MilestoneID    *int           `json:"milestone_id,omitempty"`
+	EpicID         *int           `json:"epic_id,omitempty"`
+	Priority       *IssuePriority `json:"priority,omitempty"`
+	Search         string         `json:"search,omitempty"`
+}
+
+// IssueFilter returns the issue filter of the board's saved filter
+func (f BoardFilter) IssueFilter(projectID int) *IssueFilter {
+	return &IssueFilter{
+		ProjectID:      projectID,
+		IssueTypes:     f.IssueTypes,
+		LabelIDs:       f.LabelIDs,
+		AssigneeID:     f.AssigneeID,
+		AssigneeTeamID: f.AssigneeTeamID,
+		MilestoneID:    f.MilestoneID,
+		EpicID:         f.EpicID,
+		Priority:       f.Priority,
+		Search:         f.Search,
+	}
+}
+
+// CreateBoardRequest represents the request to create a board
+// Columns are copied from the default board unless listed
+type CreateBoardRequest struct {
+	Name        string                      `json:"name" validate:"required,min=1,max=100"`
+	Description *string                     `json:"description,omitempty"`
+	Filter      *BoardFilter                `json:"filter,omitempty"`
+	Swimlane    Swimlane                    `json:"swimlane,omitempty"` // Default: none
+	Columns     []*CreateBoardColumnRequest `json:"columns,omitempty"`
+}
+
+// UpdateBoardRequest represents the request to update a board
+type UpdateBoardRequest struct {
+	Name        *string      `json:"name,omitempty"`
+	Description *string      `json:"description,omitempty"`
+	Filter      *BoardFilter `json:"filter,omitempty"` // Replaces the saved filter
+	Swimlane    *Swimlane    `json:"swimlane,omitempty"`
+	IsDefault   *bool        `json:"is_default,omitempty"` // Only true is accepted: make this the default board
+}
+
+// BoardView is a fully materialized board: its columns and its cards
+// grouped by swimlane and column
+type BoardView struct {
+	Board   *Board         `json:"board"`
+	Columns []*BoardColumn `json:"columns"`
+	Lanes   []*BoardLane   `json:"lanes"`
+}
+
+// BoardLane is a swimlane of a board view
+type BoardLane struct {
+	Key   string       `json:"key"` // e.g. "assignee:12", "priority:high", "none" for cards without a value
+	Name  string       `json:"name"`
+	Cells []*BoardCell `json:"cells"` // One cell per column, in column order
+}
+
+// BoardCell holds the cards of a lane in one column
+type BoardCell struct {
+	ColumnID int      `json:"column_id"`
+	Issues   []*Issue `json:"issues"`
+}
+
+// IsValidSwimlane checks if the swimlane grouping is valid
+func IsValidSwimlane(swimlane Swimlane) bool {
+	switch swimlane {
+	case SwimlaneNone, SwimlaneAssignee, SwimlaneEpic, SwimlanePriority, SwimlaneLabel:
+		return true
+	}
+	return false
+}
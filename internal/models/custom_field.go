@@ -0,0 +1,97 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// CustomFieldType is the value type of a custom field
+type CustomFieldType string
+
+const (
+	CustomFieldText         CustomFieldType = "text"
+	CustomFieldNumber       CustomFieldType = "number"
+	CustomFieldDate         CustomFieldType = "date" // YYYY-MM-DD
+	CustomFieldSingleSelect CustomFieldType = "single_select"
+	CustomFieldMultiSelect  CustomFieldType = "multi_select"
+	CustomFieldUser         CustomFieldType = "user" // User ID
+	CustomFieldURL          CustomFieldType = "url"
+)
+
+// IsValid reports whether t is a supported custom field type
+func (t CustomFieldType) IsValid() bool {
+	switch t {
+	case CustomFieldText, CustomFieldNumber, CustomFieldDate, CustomFieldSingleSelect,
+		CustomFieldMultiSelect, CustomFieldUser, CustomFieldURL:
+		return true
+	}
+	return false
+}
+
+// HasOptions reports whether values of the type are chosen from options
+func (t CustomFieldType) HasOptions() bool {
+	return t == CustomFieldSingleSelect || t == CustomFieldMultiSelect
+}
+
+// CustomField is an admin-defined issue field of a project
+type CustomField struct {
+	ID         int             `json:"id"`
+	ProjectID  int             `json:"project_id"`
+	Key        string          `json:"key"` // Used in issue JSON and filters, e.g. "customer"
+	Name       string          `json:"name"`
+	FieldType  CustomFieldType `json:"field_type"`
+	Options    []string        `json:"options"`     // Choices of select fields
+	IssueTypes []IssueType     `json:"issue_types"` // Empty applies to every issue type
+	Required   bool            `json:"required"`
+	Position   int             `json:"position"`
+	CreatedAt  time.Time       `json:"created_at"`
+	UpdatedAt  time.Time       `json:"updated_at"`
+}
+
+// AppliesTo reports whether the field is used by an issue type
+func (f *CustomField) AppliesTo(issueType IssueType) bool {
+	if len(f.IssueTypes) == 0 {
+		return true
+	}
+	for _, t := range f.IssueTypes {
+		if t == issueType {
+			return true
+		}
+	}
+	return false
+}
+
+// HasOption reports whether value is one of the field's options
+func (f *CustomField) HasOption(value string) bool {
+	for _, option := range f.Options {
+		if option == value {
+			return true
+		}
+	}
+	return false
+}
+
+// CreateCustomFieldRequest represents the request to create a custom field
+type CreateCustomFieldRequest struct {
+	Key        string          `json:"key" validate:"required,max=50"` // Lowercase letters, digits and underscores
+	Name       string          `json:"name" validate:"required,max=100"`
+	FieldType  CustomFieldType `json:"field_type" validate:"required"`
+	Options    []string        `json:"options,omitempty"` // Required for select fields
+	IssueTypes []IssueType     `json:"issue_types,omitempty"`
+	Required   bool            `json:"required"`
+	Position   *int            `json:"position,omitempty"`
+}
+
+// UpdateCustomFieldRequest represents the request to update a custom field;
+// the key and type can't change
+type UpdateCustomFieldRequest struct {
+	Name       *string      `json:"name,omitempty" validate:"omitempty,max=100"`
+	Options    *[]string    `json:"options,omitempty"`
+	IssueTypes *[]IssueType `json:"issue_types,omitempty"`
+	Required   *bool        `json:"required,omitempty"`
+	Position   *int         `json:"position,omitempty"`
+}
+
+// CustomFieldValues are the custom field values of an issue, keyed by field
+// key; null clears a value on update
+type CustomFieldValues map[string]json.RawMessage
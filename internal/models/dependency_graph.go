@@ -0,0 +1,57 @@
+package models
+
+import "time"
+
+// Dependency graph output formats
+const (
+	GraphFormatJSON    = "json"
+	GraphFormatDOT     = "dot"
+	GraphFormatMermaid = "mermaid"
+)
+
+// DependencyGraphFilter narrows a project's dependency graph
+type DependencyGraphFilter struct {
+	ProjectID   int
+	EpicID      *int
+	MilestoneID *int
+}
+
+// DependencyNode is an issue in a dependency graph
+type DependencyNode struct {
+	IssueID          int            `json:"issue_id"`
+	Key              string         `json:"key"` // e.g. PROJ-12
+	Title            string         `json:"title"`
+	Status           IssueStatus    `json:"status"`
+	StatusCategory   StatusCategory `json:"status_category"`
+	StoryPoints      *float64       `json:"story_points,omitempty"`
+	EstimateMinutes  *int           `json:"original_estimate_minutes,omitempty"`
+	RemainingMinutes *int           `json:"remaining_estimate_minutes,omitempty"`
+	DueDate          *time.Time     `json:"due_date,omitempty"`
+	Weight           float64        `json:"weight"`   // Remaining work: points or minutes, 0 once done
+	External         bool           `json:"external"` // Linked from outside the filtered issues
+	Critical         bool           `json:"critical"` // On the critical path
+}
+
+// DependencyEdge is a blocking link: From blocks To
+type DependencyEdge struct {
+	LinkID   int  `json:"link_id"`
+	From     int  `json:"from"`
+	To       int  `json:"to"`
+	Critical bool `json:"critical"`
+}
+
+// DependencyGraph is the blocking graph of a project's issues
+type DependencyGraph struct {
+	ProjectID      int               `json:"project_id"`
+	EstimationType EstimationType    `json:"estimation_type"`
+	Nodes          []*DependencyNode `json:"nodes"`
+	Edges          []*DependencyEdge `json:"edges"`
+
+	// CriticalPath is the longest blocking chain by remaining work, from
+	// the first blocker to the last blocked issue
+	CriticalPath       []int   `json:"critical_path"`
+	CriticalPathWeight float64 `json:"critical_path_weight"`
+	// CriticalPathDueDate is the earliest due date on the critical path,
+	// the deadline the whole chain has to meet
+	CriticalPathDueDate *time.Time `json:"critical_path_due_date,omitempty"`
+}
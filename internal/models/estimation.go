@@ -0,0 +1,74 @@
+package models
+
+// EstimationType is how a project estimates its issues
+type EstimationType string
+
+const (
+	EstimationTypeStoryPoints EstimationType = "story_points"
+	EstimationTypeTime        EstimationType = "time" // Original estimate in minutes
+)
+
+// DefaultStoryPoints is the Fibonacci set new projects allow
+var DefaultStoryPoints = []float64{0, 1, 2, 3, 5, 8, 13, 21}
+
+// EstimationSettings is the estimation configuration of a project
+type EstimationSettings struct {
+	ProjectID          int            `json:"project_id"`
+	EstimationType     EstimationType `json:"estimation_type"`
+	AllowedStoryPoints []float64      `json:"allowed_story_points"` // Empty allows any non-negative value
+}
+
+// AllowsStoryPoints reports whether points is an allowed story point value
+func (s *EstimationSettings) AllowsStoryPoints(points float64) bool {
+	if points < 0 {
+		return false
+	}
+	if len(s.AllowedStoryPoints) == 0 {
+		return true
+	}
+	for _, allowed := range s.AllowedStoryPoints {
+		if allowed == points {
+			return true
+		}
+	}
+	return false
+}
+
+// UpdateEstimationSettingsRequest represents the request to configure estimation
+type UpdateEstimationSettingsRequest struct {
+	EstimationType     *EstimationType `json:"estimation_type,omitempty" validate:"omitempty,oneof=story_points time"`
+	AllowedStoryPoints *[]float64      `json:"allowed_story_points,omitempty"`
+}
+
+// IssueProgress summarizes the completion of a group of issues, such as the
+// subtasks of an issue or the issues of an epic
+type IssueProgress struct {
+	Total                    int            `json:"total"`
+	Completed                int            `json:"completed"`
+	TotalStoryPoints         float64        `json:"total_story_points"`
+	CompletedStoryPoints     float64        `json:"completed_story_points"`
+	TotalEstimateMinutes     int            `json:"total_estimate_minutes"`
+	CompletedEstimateMinutes int            `json:"completed_estimate_minutes"`
+	EstimationType           EstimationType `json:"estimation_type"`
+	Progress                 int            `json:"progress"` // Percentage (0-100), weighted by estimates
+}
+
+// Calculate sets Progress from the totals
+func (p *IssueProgress) Calculate() {
+	p.Progress = WeightedProgress(p.EstimationType, p.Total, p.Completed,
+		p.TotalStoryPoints, p.CompletedStoryPoints, p.TotalEstimateMinutes, p.CompletedEstimateMinutes)
+}
+
+// WeightedProgress returns a completion percentage weighted by the project's
+// estimates, falling back to issue counts when nothing is estimated
+func WeightedProgress(estimationType EstimationType, total, completed int, totalPoints, completedPoints float64, totalMinutes, completedMinutes int) int {
+	switch {
+	case estimationType == EstimationTypeStoryPoints && totalPoints > 0:
+		return int(completedPoints * 100 / totalPoints)
+	case estimationType == EstimationTypeTime && totalMinutes > 0:
+		return completedMinutes * 100 / totalMinutes
+	case total > 0:
+		return completed * 100 / total
+	}
+	return 0
+}
@@ -1,6 +1,9 @@
 package models
 
-import "time"
+import (
+	"strings"
+	"time"
+)
 
 // IssueStatus represents the status of an issue
 type IssueStatus string
@@ -35,92 +38,226 @@ const (
 
 // Issue represents an issue in the system
 type Issue struct {
-	ID              int           `json:"id"`
-	ProjectID       int           `json:"project_id"`
-	IssueNumber     int           `json:"issue_number"`
-	Title           string        `json:"title"`
-	Description     *string       `json:"description,omitempty"`
-	DescriptionHTML *string       `json:"description_html,omitempty"` // Rendered HTML from markdown
-	Status          IssueStatus   `json:"status"`
-	ColumnID        *int          `json:"column_id,omitempty"`
-	ColumnPosition  *int          `json:"column_position,omitempty"`
-	Priority        IssuePriority `json:"priority"`
-	IssueType       IssueType     `json:"issue_type"`
-	ParentIssueID   *int          `json:"parent_issue_id,omitempty"` // For subtasks
-	EpicID          *int          `json:"epic_id,omitempty"`         // For grouping under epic
-	AssigneeID      *int          `json:"assignee_id,omitempty"`
-	ReporterID      int           `json:"reporter_id"`
-	MilestoneID     *int          `json:"milestone_id,omitempty"`
-	Version         int           `json:"version"`
-	CreatedAt       time.Time     `json:"created_at"`
-	UpdatedAt       time.Time     `json:"updated_at"`
-	DeletedAt       *time.Time    `json:"deleted_at,omitempty"`
-	IsPinned        bool          `json:"is_pinned"`
-	PinnedAt        *time.Time    `json:"pinned_at,omitempty"`
-	PinnedByUserID  *int          `json:"pinned_by_user_id,omitempty"`
+	ID               int            `json:"id"`
+	ProjectID        int            `json:"project_id"`
+	IssueNumber      int            `json:"issue_number"`
+	Title            string         `json:"title"`
+	Description      *string        `json:"description,omitempty"`
+	DescriptionHTML  *string        `json:"description_html,omitempty"` // Rendered HTML from markdown
+	Status           IssueStatus    `json:"status"`
+	StatusCategory   StatusCategory `json:"status_category"`
+	Resolution       *string        `json:"resolution,omitempty"`
+	ColumnID         *int           `json:"column_id,omitempty"`
+	ColumnPosition   *int           `json:"column_position,omitempty"`
+	Priority         IssuePriority  `json:"priority"`
+	IssueType        IssueType      `json:"issue_type"`
+	ParentIssueID    *int           `json:"parent_issue_id,omitempty"` // For subtasks
+	EpicID           *int           `json:"epic_id,omitempty"`         // For grouping under epic
+	AssigneeID       *int           `json:"assignee_id,omitempty"`     // Primary assignee
+	AssigneeIDs      []int          `json:"assignee_ids,omitempty"`    // All assignees, the primary one first
+	AssigneeTeamID   *int           `json:"assignee_team_id,omitempty"`
+	ReporterID       int            `json:"reporter_id"`
+	MilestoneID      *int           `json:"milestone_id,omitempty"`
+	SprintID         *int           `json:"sprint_id,omitempty"`
+	StoryPoints      *float64       `json:"story_points,omitempty"`
+	EstimateMinutes  *int           `json:"original_estimate_minutes,omitempty"`  // Original time estimate
+	RemainingMinutes *int           `json:"remaining_estimate_minutes,omitempty"` // Time estimated to finish
+	StartDate        *time.Time     `json:"start_date,omitempty"`
+	DueDate          *time.Time     `json:"due_date,omitempty"`
+	Overdue          bool           `json:"overdue"` // Past its due date and not done
+	Version          int            `json:"version"`
+	CreatedAt        time.Time      `json:"created_at"`
+	UpdatedAt        time.Time      `json:"updated_at"`
+	DeletedAt        *time.Time     `json:"deleted_at,omitempty"`
+	DeletedByUserID  *int           `json:"deleted_by_user_id,omitempty"`
+	IsPinned         bool           `json:"is_pinned"`
+	PinnedAt         *time.Time     `json:"pinned_at,omitempty"`
+	PinnedByUserID   *int           `json:"pinned_by_user_id,omitempty"`
+	Upvotes          int            `json:"upvotes"`
+	Downvotes        int            `json:"downvotes"`
+	VoteScore        int            `json:"vote_score"`             // Upvotes minus downvotes
+	CustomerRequests int            `json:"customer_request_count"` // Customers that requested the issue
 
 	// Related entities (for joins)
-	Assignee    *User    `json:"assignee,omitempty"`
-	Reporter    *User    `json:"reporter,omitempty"`
-	Project     *Project `json:"project,omitempty"`
-	Labels      []*Label `json:"labels,omitempty"`
-	ParentIssue *Issue   `json:"parent_issue,omitempty"` // Parent issue for subtasks
-	Epic        *Issue   `json:"epic,omitempty"`         // Epic this issue belongs to
-	Subtasks    []*Issue `json:"subtasks,omitempty"`     // Subtasks of this issue
-	EpicIssues  []*Issue `json:"epic_issues,omitempty"`  // Issues under this epic
+	Assignee    *User        `json:"assignee,omitempty"`
+	Assignees   []*User      `json:"assignees,omitempty"` // All assignees, the primary one first
+	Reporter    *User        `json:"reporter,omitempty"`
+	Project     *Project     `json:"project,omitempty"`
+	Labels      []*Label     `json:"labels,omitempty"`
+	ParentIssue *Issue       `json:"parent_issue,omitempty"` // Parent issue for subtasks
+	Epic        *Issue       `json:"epic,omitempty"`         // Epic this issue belongs to
+	Subtasks    []*Issue     `json:"subtasks,omitempty"`     // Subtasks of this issue
+	EpicIssues  []*Issue     `json:"epic_issues,omitempty"`  // Issues under this epic
+	Links       []*IssueLink `json:"links,omitempty"`        // Typed links to other issues
+
+	CustomFields CustomFieldValues `json:"custom_fields,omitempty"` // Values by field key
+
+	// Set on responses when the issue entered a column past its soft WIP limit
+	WIPWarning *string `json:"wip_warning,omitempty"`
+	// Set on responses when the issue was closed while other issues still block it
+	BlockerWarning *string `json:"blocker_warning,omitempty"`
 }
 
+// TrashedIssue is a deleted issue listed in its project's trash
+type TrashedIssue struct {
+	*Issue
+	PurgeAt *time.Time `json:"purge_at,omitempty"` // When the retention job deletes it permanently
+}
+
+// MaxIssueAssignees is the largest number of assignees of one issue
+const MaxIssueAssignees = 10
+
+// MaxPinnedIssues is the largest number of pinned issues of one project
+const MaxPinnedIssues = 5
+
 // CreateIssueRequest represents the request to create a new issue
 type CreateIssueRequest struct {
-	Title         string         `json:"title" validate:"required,min=1,max=500"`
-	Description   *string        `json:"description,omitempty"`
-	Priority      *IssuePriority `json:"priority,omitempty"`
-	IssueType     *IssueType     `json:"issue_type,omitempty"` // Default: task
-	ParentIssueID *int           `json:"parent_issue_id,omitempty"`
-	EpicID        *int           `json:"epic_id,omitempty"`
-	AssigneeID    *int           `json:"assignee_id,omitempty"`
-	ColumnID      *int           `json:"column_id,omitempty"`
-	MilestoneID   *int           `json:"milestone_id,omitempty"`
-	LabelIDs      []int          `json:"label_ids,omitempty"`
+	Title          string         `json:"title" validate:"required,min=1,max=500"`
+	Description    *string        `json:"description,omitempty"`
+	Priority       *IssuePriority `json:"priority,omitempty"`
+	IssueType      *IssueType     `json:"issue_type,omitempty"` // Default: task
+	ParentIssueID  *int           `json:"parent_issue_id,omitempty"`
+	EpicID         *int           `json:"epic_id,omitempty"`
+	AssigneeID     *int           `json:"assignee_id,omitempty"`  // Primary assignee; defaults to the first of assignee_ids
+	AssigneeIDs    []int          `json:"assignee_ids,omitempty"` // All assignees, including assignee_id
+	AssigneeTeamID *int           `json:"assignee_team_id,omitempty"`
+	ColumnID       *int           `json:"column_id,omitempty"`
+	MilestoneID    *int           `json:"milestone_id,omitempty"`
+	LabelIDs       []int          `json:"label_ids,omitempty"`
+
+	StoryPoints     *float64 `json:"story_points,omitempty"`              // Must be one of the project's allowed values
+	EstimateMinutes *int     `json:"original_estimate_minutes,omitempty"` // Original time estimate
+
+	StartDate *time.Time `json:"start_date,omitempty"`
+	DueDate   *time.Time `json:"due_date,omitempty"`
+
+	CustomFields CustomFieldValues `json:"custom_fields,omitempty"` // Values by field key; required fields must be set
+
+	WIPOverrideReason *string `json:"wip_override_reason,omitempty"` // Admins only: exceed the column's WIP limit
 }
 
 // UpdateIssueRequest represents the request to update an issue
 type UpdateIssueRequest struct {
-	Title       *string        `json:"title,omitempty"`
-	Description *string        `json:"description,omitempty"`
-	Status      *IssueStatus   `json:"status,omitempty"`
-	Priority    *IssuePriority `json:"priority,omitempty"`
-	IssueType   *IssueType     `json:"issue_type,omitempty"`
-	EpicID      *int           `json:"epic_id,omitempty"`
-	AssigneeID  *int           `json:"assignee_id,omitempty"`
-	MilestoneID *int           `json:"milestone_id,omitempty"`
-	Version     *int           `json:"version,omitempty"` // For optimistic locking
+	Title             *string        `json:"title,omitempty"`
+	Description       *string        `json:"description,omitempty"`
+	Status            *IssueStatus   `json:"status,omitempty"`
+	Resolution        *string        `json:"resolution,omitempty"` // Required by some workflow transitions
+	Priority          *IssuePriority `json:"priority,omitempty"`
+	IssueType         *IssueType     `json:"issue_type,omitempty"`
+	EpicID            *int           `json:"epic_id,omitempty"`
+	AssigneeID        *int           `json:"assignee_id,omitempty"`  // Replaces the primary assignee
+	AssigneeIDs       []int          `json:"assignee_ids,omitempty"` // Replaces all assignees; the primary stays while listed
+	AssigneeTeamID    *int           `json:"assignee_team_id,omitempty"`
+	MilestoneID       *int           `json:"milestone_id,omitempty"`
+	ClearAssignee     bool           `json:"clear_assignee,omitempty"`      // Unassign all assignees
+	ClearAssigneeTeam bool           `json:"clear_assignee_team,omitempty"` // Unassign the team
+	ClearMilestone    bool           `json:"clear_milestone,omitempty"`     // Remove the issue from its milestone
+	Version           *int           `json:"version,omitempty"`             // For optimistic locking
+
+	StoryPoints      *float64 `json:"story_points,omitempty"`
+	EstimateMinutes  *int     `json:"original_estimate_minutes,omitempty"`
+	RemainingMinutes *int     `json:"remaining_estimate_minutes,omitempty"`
+	ClearEstimate    bool     `json:"clear_estimate,omitempty"` // Remove the story points and time estimates
+
+	StartDate      *time.Time `json:"start_date,omitempty"`
+	DueDate        *time.Time `json:"due_date,omitempty"`
+	ClearStartDate bool       `json:"clear_start_date,omitempty"`
+	ClearDueDate   bool       `json:"clear_due_date,omitempty"`
+
+	CustomFields CustomFieldValues `json:"custom_fields,omitempty"` // Changed values by field key; null clears a value
+
+	WIPOverrideReason *string `json:"wip_override_reason,omitempty"` // Admins only: exceed the WIP limit of the status's column
+}
+
+// MoveIssueProjectRequest represents the request to move an issue and its
+// subtasks to another project
+// Labels, milestones and board columns of the old project are replaced by
+// their mapped counterparts in the target project, or dropped when unmapped
+type MoveIssueProjectRequest struct {
+	TargetProjectID  int         `json:"target_project_id" validate:"required"`
+	LabelMapping     map[int]int `json:"label_mapping,omitempty"`     // Old label ID -> target label ID
+	MilestoneMapping map[int]int `json:"milestone_mapping,omitempty"` // Old milestone ID -> target milestone ID
+	ColumnMapping    map[int]int `json:"column_mapping,omitempty"`    // Old column ID -> target column ID
 }
 
 // MoveIssueRequest represents the request to move an issue to a different column
 type MoveIssueRequest struct {
-	ColumnID int          `json:"column_id" validate:"required"`
-	Position *int         `json:"position,omitempty"`
-	Version  int          `json:"version" validate:"required"` // For optimistic locking
-	Status   *IssueStatus `json:"status,omitempty"`            // Optional: auto-update status based on column
+	ColumnID   int          `json:"column_id" validate:"required"`
+	Position   *int         `json:"position,omitempty"`
+	Version    int          `json:"version" validate:"required"` // For optimistic locking
+	Status     *IssueStatus `json:"status,omitempty"`            // Optional: auto-update status based on column
+	Resolution *string      `json:"resolution,omitempty"`
+
+	WIPOverrideReason *string `json:"wip_override_reason,omitempty"` // Admins only: exceed the column's WIP limit
 }
 
 // IssueFilter represents filters for listing issues
 type IssueFilter struct {
-	ProjectID     int
-	Status        *IssueStatus
-	Priority      *IssuePriority
-	IssueType     *IssueType
-	ParentIssueID *int  // Filter by parent issue (for subtasks)
-	EpicID        *int  // Filter by epic
-	HasParent     *bool // true = subtasks only, false = top-level only
-	AssigneeID    *int
-	ReporterID    *int
-	MilestoneID   *int
-	LabelIDs      []int
-	Search        string
-	Limit         int
-	Offset        int
+	ProjectID      int
+	Status         *IssueStatus
+	StatusCategory *StatusCategory
+	Priority       *IssuePriority
+	IssueType      *IssueType
+	IssueTypes     []IssueType // Any of the types
+	ParentIssueID  *int        // Filter by parent issue (for subtasks)
+	EpicID         *int        // Filter by epic
+	HasParent      *bool       // true = subtasks only, false = top-level only
+	AssigneeID     *int
+	AssigneeTeamID *int
+	ReporterID     *int
+	MilestoneID    *int
+	SprintID       *int
+	InBacklog      bool              // Issues in no sprint
+	Pinned         *bool             // true = pinned issues only, false = unpinned only
+	MinVotes       *int              // Vote score of at least this
+	MinCustomers   *int              // Requested by at least this many customers
+	DueAfter       *time.Time        // Due on or after this date
+	DueBefore      *time.Time        // Due on or before this date
+	Overdue        *bool             // true = past due and not done, false = everything else
+	Sort           string            // One of IssueSortFields, "-" prefix for descending; default newest first
+	CustomFields   map[string]string // Field key to value; multi select fields match any chosen option
+	LabelIDs       []int
+	Search         string
+	Limit          int
+	Offset         int
+}
+
+// IssueSortFields maps the sort fields accepted by issue lists and search
+// to their columns
+var IssueSortFields = map[string]string{
+	"created_at":        "created_at",
+	"updated_at":        "updated_at",
+	"start_date":        "start_date",
+	"due_date":          "due_date",
+	"votes":             "vote_score",
+	"customer_requests": "customer_request_count",
+}
+
+// IssueOrderBy returns the ORDER BY expression for a sort such as
+// "due_date" or "-due_date", with columns qualified by prefix (e.g. "i.");
+// ok is false for unknown fields. Issues without the value sort last
+func IssueOrderBy(sort string, prefix string) (orderBy string, ok bool) {
+	direction := "ASC"
+	if strings.HasPrefix(sort, "-") {
+		direction = "DESC"
+		sort = sort[1:]
+	}
+
+	column, ok := IssueSortFields[sort]
+	if !ok {
+		return "", false
+	}
+	return prefix + column + " " + direction + " NULLS LAST, " + prefix + "id DESC", true
+}
+
+// DateOnly truncates a time to its calendar date, in UTC
+func DateOnly(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// IsOverdue reports whether an unfinished issue is past its due date
+func (i *Issue) IsOverdue(now time.Time) bool {
+	return i.DueDate != nil && i.StatusCategory != StatusCategoryDone && DateOnly(*i.DueDate).Before(DateOnly(now))
 }
 
 // Label represents a label that can be attached to issues
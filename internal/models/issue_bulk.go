@@ -0,0 +1,127 @@
+package models
+
+import "time"
+
+// MaxBulkIssues is the largest number of issues one bulk request may change
+const MaxBulkIssues = 500
+
+// BulkIssueRequest represents one change applied to many issues of a project
+// The issues are selected by issue_ids or by filter. A delete request may
+// not contain other changes, and status and column_id are mutually exclusive
+type BulkIssueRequest struct {
+	IssueIDs []int            `json:"issue_ids,omitempty"`
+	Filter   *BulkIssueFilter `json:"filter,omitempty"`   // Selects the issues instead of issue_ids
+	Versions map[int]int      `json:"versions,omitempty"` // Issue ID -> version the client last saw; changed issues are reported as conflicts
+
+	Status         *IssueStatus   `json:"status,omitempty"`
+	Resolution     *string        `json:"resolution,omitempty"` // Required by some workflow transitions
+	Priority       *IssuePriority `json:"priority,omitempty"`
+	AssigneeID     *int           `json:"assignee_id,omitempty"`
+	ClearAssignee  bool           `json:"clear_assignee,omitempty"`
+	MilestoneID    *int           `json:"milestone_id,omitempty"`
+	ClearMilestone bool           `json:"clear_milestone,omitempty"`
+	AddLabelIDs    []int          `json:"add_label_ids,omitempty"`
+	RemoveLabelIDs []int          `json:"remove_label_ids,omitempty"`
+	ColumnID       *int           `json:"column_id,omitempty"`
+	Delete         bool           `json:"delete,omitempty"` // Admins only: move the issues to the trash
+}
+
+// BulkIssueFilter selects the issues of a bulk request like the issue list
+// filters do
+type BulkIssueFilter struct {
+	Status         *IssueStatus    `json:"status,omitempty"`
+	StatusCategory *StatusCategory `json:"status_category,omitempty"`
+	Priority       *IssuePriority  `json:"priority,omitempty"`
+	IssueType      *IssueType      `json:"issue_type,omitempty"`
+	AssigneeID     *int            `json:"assignee_id,omitempty"`
+	MilestoneID    *int            `json:"milestone_id,omitempty"`
+	SprintID       *int            `json:"sprint_id,omitempty"`
+	LabelIDs       []int           `json:"label_ids,omitempty"`
+	Overdue        *bool           `json:"overdue,omitempty"`
+	Search         string          `json:"search,omitempty"`
+}
+
+// IssueFilter converts the filter into an issue list filter of a project
+func (f *BulkIssueFilter) IssueFilter(projectID int) *IssueFilter {
+	return &IssueFilter{
+		ProjectID:      projectID,
+		Status:         f.Status,
+		StatusCategory: f.StatusCategory,
+		Priority:       f.Priority,
+		IssueType:      f.IssueType,
+		AssigneeID:     f.AssigneeID,
+		MilestoneID:    f.MilestoneID,
+		SprintID:       f.SprintID,
+		LabelIDs:       f.LabelIDs,
+		Overdue:        f.Overdue,
+		Search:         f.Search,
+		Limit:          MaxBulkIssues + 1,
+	}
+}
+
+// BulkIssueOutcome is the outcome of a bulk change for one issue
+type BulkIssueOutcome string
+
+const (
+	BulkOutcomeUpdated  BulkIssueOutcome = "updated"
+	BulkOutcomeDeleted  BulkIssueOutcome = "deleted"
+	BulkOutcomeConflict BulkIssueOutcome = "conflict" // The issue changed since the version the client sent
+	BulkOutcomeFailed   BulkIssueOutcome = "failed"
+)
+
+// BulkIssueItemResult reports what a bulk change did to one issue
+type BulkIssueItemResult struct {
+	IssueID     int              `json:"issue_id"`
+	IssueNumber int              `json:"issue_number,omitempty"`
+	Outcome     BulkIssueOutcome `json:"outcome"`
+	Version     int              `json:"version,omitempty"` // Current version of updated and conflicting issues
+	Error       string           `json:"error,omitempty"`
+}
+
+// BulkIssueResult reports the outcome of a bulk change per issue
+type BulkIssueResult struct {
+	ProjectID int                    `json:"project_id"`
+	Changes   []string               `json:"changes"` // Changed fields, e.g. status, priority, labels
+	Total     int                    `json:"total"`
+	Succeeded int                    `json:"succeeded"`
+	Conflicts int                    `json:"conflicts"`
+	Failed    int                    `json:"failed"`
+	Results   []*BulkIssueItemResult `json:"results"`
+}
+
+// Add records the outcome of one issue
+func (r *BulkIssueResult) Add(item *BulkIssueItemResult) {
+	r.Results = append(r.Results, item)
+	switch item.Outcome {
+	case BulkOutcomeUpdated, BulkOutcomeDeleted:
+		r.Succeeded++
+	case BulkOutcomeConflict:
+		r.Conflicts++
+	default:
+		r.Failed++
+	}
+}
+
+// IssueBulkJobStatus represents the state of a background bulk change
+type IssueBulkJobStatus string
+
+const (
+	BulkJobStatusPending    IssueBulkJobStatus = "pending"
+	BulkJobStatusProcessing IssueBulkJobStatus = "processing"
+	BulkJobStatusCompleted  IssueBulkJobStatus = "completed"
+)
+
+// IssueBulkJob represents a bulk change of many issues running in the
+// background
+// Failures of single issues are reported in the result, not as a failed job
+type IssueBulkJob struct {
+	ID              int                `json:"id"`
+	ProjectID       int                `json:"project_id"`
+	UserID          int                `json:"user_id"`
+	Status          IssueBulkJobStatus `json:"status"`
+	TotalIssues     int                `json:"total_issues"`
+	ProcessedIssues int                `json:"processed_issues"`
+	Result          *BulkIssueResult   `json:"result,omitempty"` // Set once the job completed
+	CreatedAt       time.Time          `json:"created_at"`
+	CompletedAt     *time.Time         `json:"completed_at,omitempty"`
+}
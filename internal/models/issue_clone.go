@@ -0,0 +1,53 @@
+package models
+
+import "time"
+
+// CloneIssueRequest represents the request to clone an issue
+// Clones start in the initial status of their workflow and keep the
+// milestone, epic and team only within the same project
+type CloneIssueRequest struct {
+	TargetProjectID     *int    `json:"target_project_id,omitempty"` // Defaults to the project of the source issue
+	Title               *string `json:"title,omitempty"`             // Title of the cloned issue, defaults to the source title
+	IncludeSubtasks     bool    `json:"include_subtasks"`
+	IncludeEpicChildren bool    `json:"include_epic_children"` // Epics only: clone the issues of the epic
+	IncludeLabels       bool    `json:"include_labels"`        // Across projects labels are matched by name
+	IncludeTasklist     bool    `json:"include_tasklist"`      // Items are copied unchecked
+	IncludeAttachments  bool    `json:"include_attachments"`
+	LinkToSource        bool    `json:"link_to_source"` // Link every clone to its source with a clones link
+}
+
+// IssueCloneJobStatus represents the state of a background clone
+type IssueCloneJobStatus string
+
+const (
+	CloneJobStatusPending    IssueCloneJobStatus = "pending"
+	CloneJobStatusProcessing IssueCloneJobStatus = "processing"
+	CloneJobStatusCompleted  IssueCloneJobStatus = "completed"
+	CloneJobStatusFailed     IssueCloneJobStatus = "failed"
+)
+
+// IssueCloneJob represents a clone of a large issue tree running in the
+// background
+// The clone is transactional: a failed job leaves no cloned issues behind
+type IssueCloneJob struct {
+	ID              int                 `json:"id"`
+	SourceIssueID   *int                `json:"source_issue_id,omitempty"`
+	TargetProjectID int                 `json:"target_project_id"`
+	UserID          int                 `json:"user_id"`
+	Status          IssueCloneJobStatus `json:"status"`
+	TotalIssues     int                 `json:"total_issues"`
+	ClonedIssues    int                 `json:"cloned_issues"`
+	ClonedIssueID   *int                `json:"cloned_issue_id,omitempty"` // Clone of the source issue, set on completion
+	ErrorMessage    *string             `json:"error_message,omitempty"`
+	CreatedAt       time.Time           `json:"created_at"`
+	CompletedAt     *time.Time          `json:"completed_at,omitempty"`
+}
+
+// IssueClone is one issue of a clone: the copy to create and its source
+// Parent and epic IDs that point to other cloned issues are source IDs and
+// are replaced by the IDs of their clones on insert
+type IssueClone struct {
+	SourceID    int
+	Issue       *Issue
+	Attachments []*Attachment // Copies of the source files to attach
+}
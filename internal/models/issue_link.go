@@ -0,0 +1,83 @@
+package models
+
+import "time"
+
+// IssueLinkType is the stored, outward type of a link between two issues
+type IssueLinkType string
+
+const (
+	LinkTypeBlocks     IssueLinkType = "blocks"     // Source blocks target
+	LinkTypeDuplicates IssueLinkType = "duplicates" // Source duplicates target
+	LinkTypeRelates    IssueLinkType = "relates"    // Symmetric
+	LinkTypeClones     IssueLinkType = "clones"     // Source clones target
+)
+
+// Link directions, seen from the issue a link is displayed on
+const (
+	LinkDirectionOutward = "outward"
+	LinkDirectionInward  = "inward"
+)
+
+// ResolutionDuplicate is the resolution of issues closed as duplicates
+const ResolutionDuplicate = "duplicate"
+
+// issueLinkRelations maps each relation name accepted by the API to its
+// stored type and whether the requesting issue is the link's target
+var issueLinkRelations = map[string]struct {
+	linkType IssueLinkType
+	inward   bool
+}{
+	"blocks":           {LinkTypeBlocks, false},
+	"is_blocked_by":    {LinkTypeBlocks, true},
+	"duplicates":       {LinkTypeDuplicates, false},
+	"is_duplicated_by": {LinkTypeDuplicates, true},
+	"relates_to":       {LinkTypeRelates, false},
+	"clones":           {LinkTypeClones, false},
+	"is_cloned_by":     {LinkTypeClones, true},
+}
+
+// ParseIssueLinkRelation resolves a relation name such as "is_blocked_by"
+// into its stored link type and direction
+func ParseIssueLinkRelation(relation string) (linkType IssueLinkType, inward bool, ok bool) {
+	r, ok := issueLinkRelations[relation]
+	return r.linkType, r.inward, ok
+}
+
+// IssueLinkRelation returns the relation name of a link seen from one side
+func IssueLinkRelation(linkType IssueLinkType, direction string) string {
+	if direction == LinkDirectionInward {
+		switch linkType {
+		case LinkTypeBlocks:
+			return "is_blocked_by"
+		case LinkTypeDuplicates:
+			return "is_duplicated_by"
+		case LinkTypeClones:
+			return "is_cloned_by"
+		}
+	}
+	if linkType == LinkTypeRelates {
+		return "relates_to"
+	}
+	return string(linkType)
+}
+
+// IssueLink is a typed link between two issues
+type IssueLink struct {
+	ID            int           `json:"id"`
+	SourceIssueID int           `json:"source_issue_id"`
+	TargetIssueID int           `json:"target_issue_id"`
+	LinkType      IssueLinkType `json:"link_type"`
+	CreatedBy     *int          `json:"created_by,omitempty"`
+	CreatedAt     time.Time     `json:"created_at"`
+
+	// Set when listed for one issue: how the other issue relates to it
+	Direction string `json:"direction,omitempty"` // outward or inward
+	Relation  string `json:"relation,omitempty"`  // e.g. blocks, is_blocked_by, relates_to
+	Issue     *Issue `json:"issue,omitempty"`     // The other issue
+}
+
+// CreateIssueLinkRequest represents the request to link two issues
+type CreateIssueLinkRequest struct {
+	Relation      string `json:"relation" validate:"required"` // blocks, is_blocked_by, duplicates, is_duplicated_by, relates_to, clones, is_cloned_by
+	TargetIssueID int    `json:"target_issue_id" validate:"required"`
+}
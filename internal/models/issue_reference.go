@@ -47,3 +47,35 @@ func ParseIssueReferences(text string) []int {
 
 	return issueNumbers
 }
+
+// IssueKeyReference is a PROJ-123 style reference to an issue
+type IssueKeyReference struct {
+	ProjectKey  string
+	IssueNumber int
+}
+
+// issueKeyReferenceRegex matches PROJ-123 (project key, dash, issue number)
+var issueKeyReferenceRegex = regexp.MustCompile(`\b([A-Z][A-Z0-9]{1,9})-(\d{1,9})\b`)
+
+// ParseIssueKeyReferences extracts all PROJ-123 references from text
+// Returns unique references in order of appearance
+func ParseIssueKeyReferences(text string) []IssueKeyReference {
+	matches := issueKeyReferenceRegex.FindAllStringSubmatch(text, -1)
+
+	seen := make(map[IssueKeyReference]bool)
+	var references []IssueKeyReference
+	for _, match := range matches {
+		issueNumber, err := strconv.Atoi(match[2])
+		if err != nil || issueNumber <= 0 {
+			continue
+		}
+
+		reference := IssueKeyReference{ProjectKey: match[1], IssueNumber: issueNumber}
+		if !seen[reference] {
+			seen[reference] = true
+			references = append(references, reference)
+		}
+	}
+
+	return references
+}
@@ -0,0 +1,24 @@
+package models
+
+import "time"
+
+// ReminderKind is the kind of due date reminder
+type ReminderKind string
+
+const (
+	ReminderDueSoon ReminderKind = "due_soon" // Sent shortly before the due date
+	ReminderOverdue ReminderKind = "overdue"  // Sent once the due date has passed
+)
+
+// IssueReminder is a due date reminder for one recipient of an issue: its
+// assignee or a watcher
+type IssueReminder struct {
+	IssueID     int
+	UserID      int
+	Kind        ReminderKind
+	DueDate     time.Time
+	IssueKey    string // e.g. PROJ-12
+	IssueTitle  string
+	ProjectName string
+	Email       string
+}
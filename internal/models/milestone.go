@@ -24,9 +24,14 @@ type Milestone struct {
 	UpdatedAt   time.Time       `json:"updated_at"`
 
 	// Computed fields
-	TotalIssues  int `json:"total_issues,omitempty"`
-	ClosedIssues int `json:"closed_issues,omitempty"`
-	Progress     int `json:"progress,omitempty"` // Percentage (0-100)
+	TotalIssues              int            `json:"total_issues,omitempty"`
+	ClosedIssues             int            `json:"closed_issues,omitempty"`
+	TotalStoryPoints         float64        `json:"total_story_points,omitempty"`
+	CompletedStoryPoints     float64        `json:"completed_story_points,omitempty"`
+	TotalEstimateMinutes     int            `json:"total_estimate_minutes,omitempty"`
+	CompletedEstimateMinutes int            `json:"completed_estimate_minutes,omitempty"`
+	EstimationType           EstimationType `json:"estimation_type,omitempty"`
+	Progress                 int            `json:"progress,omitempty"` // Percentage (0-100), weighted by estimates
 }
 
 // CreateMilestoneRequest represents the request to create a milestone
@@ -23,6 +23,8 @@ const (
 	NotificationActionAssigned NotificationAction = "assigned"
 	NotificationActionMentioned NotificationAction = "mentioned"
 	NotificationActionCommented NotificationAction = "commented"
+	NotificationActionDueSoon   NotificationAction = "due_soon"
+	NotificationActionOverdue   NotificationAction = "overdue"
 )
 
 // Notification represents a user notification
@@ -0,0 +1,78 @@
+package models
+
+import "time"
+
+// OrganizationRole represents the role of a user in an organization
+type OrganizationRole string
+
+const (
+	OrgRoleOwner  OrganizationRole = "owner"
+	OrgRoleAdmin  OrganizationRole = "admin"
+	OrgRoleMember OrganizationRole = "member"
+)
+
+// Organization represents a workspace that owns projects
+type Organization struct {
+	ID        int                  `json:"id"`
+	Name      string               `json:"name"`
+	Slug      string               `json:"slug"`
+	Settings  OrganizationSettings `json:"settings"`
+	Role      OrganizationRole     `json:"role,omitempty"` // Role of the requesting user
+	CreatedAt time.Time            `json:"created_at"`
+	UpdatedAt time.Time            `json:"updated_at"`
+}
+
+// OrganizationSettings holds org-wide defaults for its projects
+type OrganizationSettings struct {
+	// Role org members get on projects they are not direct members of
+	DefaultProjectRole       ProjectRole `json:"default_project_role"`
+	DefaultProjectTemplateID *int        `json:"default_project_template_id,omitempty"`
+	// Integration types projects may use; nil allows all
+	AllowedIntegrations []string `json:"allowed_integrations,omitempty"`
+	Require2FA          bool     `json:"require_2fa"`
+}
+
+// OrganizationMember represents a member of an organization
+type OrganizationMember struct {
+	OrganizationID int              `json:"organization_id"`
+	UserID         int              `json:"user_id"`
+	Role           OrganizationRole `json:"role"`
+	User           *User            `json:"user,omitempty"`
+	JoinedAt       time.Time        `json:"joined_at"`
+	InvitedBy      *int             `json:"invited_by,omitempty"`
+}
+
+// CreateOrganizationRequest represents the request to create an organization
+type CreateOrganizationRequest struct {
+	Name string `json:"name" validate:"required,min=1,max=255"`
+	Slug string `json:"slug" validate:"required,min=2,max=50"`
+}
+
+// UpdateOrganizationRequest represents the request to update an organization
+type UpdateOrganizationRequest struct {
+	Name *string `json:"name,omitempty"`
+}
+
+// AddOrganizationMemberRequest represents the request to add a member to an organization
+type AddOrganizationMemberRequest struct {
+	UserID int              `json:"user_id" validate:"required"`
+	Role   OrganizationRole `json:"role" validate:"required,oneof=owner admin member"`
+}
+
+// UpdateOrganizationMemberRequest represents the request to change an organization member's role
+type UpdateOrganizationMemberRequest struct {
+	Role OrganizationRole `json:"role" validate:"required,oneof=owner admin member"`
+}
+
+// AllowsIntegration reports whether projects may use the integration type
+func (s *OrganizationSettings) AllowsIntegration(integrationType string) bool {
+	if s.AllowedIntegrations == nil {
+		return true
+	}
+	for _, allowed := range s.AllowedIntegrations {
+		if allowed == integrationType {
+			return true
+		}
+	}
+	return false
+}
@@ -4,39 +4,44 @@ import "time"
 
 // Project represents a project in the system
 type Project struct {
-	ID          int       `json:"id"`
-	Name        string    `json:"name"`
-	Key         string    `json:"key"` // e.g., "PROJ" for PROJ-1, PROJ-2
-	Description *string   `json:"description,omitempty"`
-	OwnerID     int       `json:"owner_id"`
-	Owner       *User     `json:"owner,omitempty"`
-	CreatedAt   time.Time `json:"created_at"`
-	UpdatedAt   time.Time `json:"updated_at"`
+	ID             int       `json:"id"`
+	Name           string    `json:"name"`
+	Key            string    `json:"key"` // e.g., "PROJ" for PROJ-1, PROJ-2; unique per organization
+	Description    *string   `json:"description,omitempty"`
+	OwnerID        int       `json:"owner_id"`
+	Owner          *User     `json:"owner,omitempty"`
+	OrganizationID *int      `json:"organization_id,omitempty"`
+	PreviousKeys   []string  `json:"previous_keys,omitempty"` // Former keys, newest first; they still resolve
+	CreatedAt      time.Time `json:"created_at"`
+	UpdatedAt      time.Time `json:"updated_at"`
 }
 
 // CreateProjectRequest represents the request to create a new project
 type CreateProjectRequest struct {
-	Name        string  `json:"name" validate:"required,min=1,max=255"`
-	Key         string  `json:"key" validate:"required,min=2,max=10,uppercase"`
-	Description *string `json:"description,omitempty"`
-	TemplateID  *int    `json:"template_id,omitempty"`
+	Name           string  `json:"name" validate:"required,min=1,max=255"`
+	Key            string  `json:"key" validate:"required,min=2,max=10,uppercase"`
+	Description    *string `json:"description,omitempty"`
+	TemplateID     *int    `json:"template_id,omitempty"`     // Defaults to the organization's template
+	OrganizationID *int    `json:"organization_id,omitempty"` // Omit for a personal project
 }
 
 // UpdateProjectRequest represents the request to update a project
 type UpdateProjectRequest struct {
 	Name        *string `json:"name,omitempty"`
 	Description *string `json:"description,omitempty"`
+	Key         *string `json:"key,omitempty"` // The old key is kept as an alias
 }
 
 // ProjectMember represents a member of a project
 type ProjectMember struct {
-	ProjectID  int       `json:"project_id"`
-	UserID     int       `json:"user_id"`
-	Role       string    `json:"role"` // owner, admin, member, viewer
-	User       *User     `json:"user,omitempty"`
-	Project    *Project  `json:"project,omitempty"`
-	JoinedAt   time.Time `json:"joined_at"`
-	InvitedBy  *int      `json:"invited_by,omitempty"`
+	ProjectID int       `json:"project_id"`
+	UserID    int       `json:"user_id"`
+	Role      string    `json:"role"` // owner, admin, member, viewer
+	User      *User     `json:"user,omitempty"`
+	Project   *Project  `json:"project,omitempty"`
+	JoinedAt  time.Time `json:"joined_at"`
+	InvitedBy *int      `json:"invited_by,omitempty"`
+	Source    string    `json:"source,omitempty"` // direct, team or organization
 }
 
 // ProjectRole represents the role of a user in a project
@@ -49,25 +54,64 @@ const (
 	RoleViewer ProjectRole = "viewer"
 )
 
+// WIPMode controls how a board column's WIP limit is enforced
+type WIPMode string
+
+const (
+	WIPModeSoft WIPMode = "soft" // Allow the move, warn and flag the column
+	WIPModeHard WIPMode = "hard" // Reject the move unless an admin overrides it
+)
+
 // BoardColumn represents a column in the kanban board
 type BoardColumn struct {
-	ID        int       `json:"id"`
-	ProjectID int       `json:"project_id"`
-	Name      string    `json:"name"`
-	Position  int       `json:"position"`
-	CreatedAt time.Time `json:"created_at"`
+	ID         int           `json:"id"`
+	ProjectID  int           `json:"project_id"`
+	BoardID    int           `json:"board_id"`
+	Name       string        `json:"name"`
+	Position   int           `json:"position"`
+	Statuses   []IssueStatus `json:"statuses"`            // Statuses shown in the column; the first is set when a card is moved here
+	WIPLimit   *int          `json:"wip_limit,omitempty"` // Maximum number of issues in the column
+	WIPMode    WIPMode       `json:"wip_mode"`
+	IssueCount int           `json:"issue_count"` // Current number of issues in the column
+	OverLimit  bool          `json:"over_limit"`  // IssueCount exceeds WIPLimit
+	CreatedAt  time.Time     `json:"created_at"`
 }
 
 // CreateBoardColumnRequest represents the request to create a new board column
 type CreateBoardColumnRequest struct {
-	Name     string `json:"name" validate:"required,min=1,max=100"`
-	Position int    `json:"position" validate:"required,min=0"`
+	Name     string        `json:"name" validate:"required,min=1,max=100"`
+	Position int           `json:"position" validate:"required,min=0"`
+	Statuses []IssueStatus `json:"statuses,omitempty"`
+	WIPLimit *int          `json:"wip_limit,omitempty"`
+	WIPMode  WIPMode       `json:"wip_mode,omitempty"` // Default: soft
 }
 
 // UpdateBoardColumnRequest represents the request to update a board column
 type UpdateBoardColumnRequest struct {
-	Name     *string `json:"name,omitempty"`
-	Position *int    `json:"position,omitempty"`
+	Name     *string        `json:"name,omitempty"`
+	Position *int           `json:"position,omitempty"`
+	Statuses *[]IssueStatus `json:"statuses,omitempty"`  // Replaces the mapped statuses
+	WIPLimit *int           `json:"wip_limit,omitempty"` // 0 removes the limit
+	WIPMode  *WIPMode       `json:"wip_mode,omitempty"`
+}
+
+// WIPOverride records an admin moving an issue into a column past its WIP limit
+type WIPOverride struct {
+	ID         int       `json:"id"`
+	ColumnID   int       `json:"column_id"`
+	IssueID    int       `json:"issue_id"`
+	UserID     *int      `json:"user_id,omitempty"`
+	Reason     string    `json:"reason"`
+	IssueCount int       `json:"issue_count"` // Issues in the column before the override
+	WIPLimit   int       `json:"wip_limit"`
+	CreatedAt  time.Time `json:"created_at"`
+
+	User *User `json:"user,omitempty"`
+}
+
+// IsValidWIPMode checks if the WIP mode is valid
+func IsValidWIPMode(mode WIPMode) bool {
+	return mode == WIPModeSoft || mode == WIPModeHard
 }
 
 // AddMemberRequest represents the request to add a member to a project
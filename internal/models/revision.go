@@ -0,0 +1,67 @@
+package models
+
+import "time"
+
+// RevisionEntityType is the kind of text a revision belongs to
+type RevisionEntityType string
+
+const (
+	RevisionEntityIssue   RevisionEntityType = "issue" // Issue description
+	RevisionEntityComment RevisionEntityType = "comment"
+)
+
+// IsValid checks if the entity type is valid
+func (t RevisionEntityType) IsValid() bool {
+	return t == RevisionEntityIssue || t == RevisionEntityComment
+}
+
+// ContentRevision represents one saved version of an issue description or a
+// comment. Revisions are numbered from 1 per entity; the highest number is
+// the current text
+type ContentRevision struct {
+	ID         int                `json:"id"`
+	EntityType RevisionEntityType `json:"entity_type"`
+	EntityID   int                `json:"entity_id"`
+	Revision   int                `json:"revision"`
+	Content    *string            `json:"content"`             // nil once redacted
+	AuthorID   *int               `json:"author_id,omitempty"` // nil for text written before revisions were recorded
+	CreatedAt  time.Time          `json:"created_at"`
+	Redacted   bool               `json:"redacted"`
+	RedactedAt *time.Time         `json:"redacted_at,omitempty"`
+	RedactedBy *int               `json:"redacted_by,omitempty"`
+
+	// Related entities
+	Author *User `json:"author,omitempty"`
+}
+
+// RevisionDiffMode selects how two revisions are compared
+type RevisionDiffMode string
+
+const (
+	RevisionDiffUnified RevisionDiffMode = "unified" // Line based unified diff
+	RevisionDiffWords   RevisionDiffMode = "words"   // Word level changes
+)
+
+// IsValid checks if the diff mode is valid
+func (m RevisionDiffMode) IsValid() bool {
+	return m == RevisionDiffUnified || m == RevisionDiffWords
+}
+
+// RevisionDiffChange is a run of text that is unchanged, inserted or deleted
+// between two revisions
+type RevisionDiffChange struct {
+	Type string `json:"type"` // equal, insert or delete
+	Text string `json:"text"`
+}
+
+// RevisionDiff represents the difference between two revisions of a text
+// Unified is set in unified mode and Changes in words mode
+type RevisionDiff struct {
+	EntityType RevisionEntityType    `json:"entity_type"`
+	EntityID   int                   `json:"entity_id"`
+	From       int                   `json:"from"`
+	To         int                   `json:"to"`
+	Mode       RevisionDiffMode      `json:"mode"`
+	Unified    *string               `json:"unified,omitempty"`
+	Changes    []*RevisionDiffChange `json:"changes,omitempty"`
+}
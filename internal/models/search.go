@@ -4,14 +4,20 @@ import "time"
 
 // IssueSearchRequest represents search criteria for issues
 type IssueSearchRequest struct {
-	Query      string   `json:"query"`       // Search in title and description
-	ProjectID  *int     `json:"project_id"`  // Filter by single project (for backward compatibility)
-	ProjectIDs []int    `json:"project_ids"` // Filter by multiple projects (for permission filtering)
-	Status     []string `json:"status"`      // Filter by status (open, closed, etc.)
-	Priority   []string `json:"priority"`    // Filter by priority (critical, high, medium, low)
-	AssigneeID *int     `json:"assignee_id"` // Filter by assignee
-	ReporterID *int     `json:"reporter_id"` // Filter by reporter
-	LabelIDs   []int    `json:"label_ids"`   // Filter by labels
+	Query        string            `json:"query"`         // Search in title and description
+	ProjectID    *int              `json:"project_id"`    // Filter by single project (for backward compatibility)
+	ProjectIDs   []int             `json:"project_ids"`   // Filter by multiple projects (for permission filtering)
+	Status       []string          `json:"status"`        // Filter by status (open, closed, etc.)
+	Priority     []string          `json:"priority"`      // Filter by priority (critical, high, medium, low)
+	AssigneeID   *int              `json:"assignee_id"`   // Filter by assignee, primary or not
+	ReporterID   *int              `json:"reporter_id"`   // Filter by reporter
+	LabelIDs     []int             `json:"label_ids"`     // Filter by labels
+	DueAfter     *time.Time        `json:"due_after"`     // Due on or after this date
+	DueBefore    *time.Time        `json:"due_before"`    // Due on or before this date
+	Overdue      *bool             `json:"overdue"`       // true = past due and not done
+	MinVotes     *int              `json:"min_votes"`     // Vote score of at least this
+	Sort         string            `json:"sort"`          // One of IssueSortFields, "-" prefix for descending; default recently updated
+	CustomFields map[string]string `json:"custom_fields"` // Field key to value, matched in each project
 	// Pagination
 	Limit  int `json:"limit"`
 	Offset int `json:"offset"`
@@ -34,8 +40,16 @@ type IssueSearchResult struct {
 	Description string     `json:"description"`
 	Status      string     `json:"status"`
 	Priority    string     `json:"priority"`
-	AssigneeID  *int       `json:"assignee_id"`
+	AssigneeID  *int       `json:"assignee_id"`  // Primary assignee
+	AssigneeIDs []int      `json:"assignee_ids"` // All assignees, the primary one first
 	ReporterID  int        `json:"reporter_id"`
+	StartDate   *time.Time `json:"start_date,omitempty"`
+	DueDate     *time.Time `json:"due_date,omitempty"`
+	Overdue     bool       `json:"overdue"`
+	Upvotes     int        `json:"upvotes"`
+	Downvotes   int        `json:"downvotes"`
+	VoteScore   int        `json:"vote_score"`
+	Customers   int        `json:"customer_request_count"` // Customers that requested the issue
 	CreatedAt   time.Time  `json:"created_at"`
 	UpdatedAt   time.Time  `json:"updated_at"`
 }
@@ -0,0 +1,48 @@
+package models
+
+import "time"
+
+// ServiceAccountTokenPrefix marks API tokens issued to service accounts
+const ServiceAccountTokenPrefix = "flowbot_"
+
+// ServiceAccount represents a bot user that belongs to a project
+type ServiceAccount struct {
+	User
+	ProjectID int         `json:"project_id"`
+	Role      ProjectRole `json:"role"`
+	CreatedBy *int        `json:"created_by,omitempty"`
+}
+
+// ServiceAccountToken represents an API token issued to a service account
+// Only a hash of the token is stored; the plaintext is returned once on creation
+type ServiceAccountToken struct {
+	ID          int        `json:"id"`
+	UserID      int        `json:"user_id"`
+	Name        string     `json:"name"`
+	TokenHash   string     `json:"-"`
+	TokenPrefix string     `json:"token_prefix"`
+	CreatedBy   *int       `json:"created_by,omitempty"`
+	ExpiresAt   *time.Time `json:"expires_at,omitempty"`
+	LastUsedAt  *time.Time `json:"last_used_at,omitempty"`
+	RevokedAt   *time.Time `json:"revoked_at,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
+}
+
+// CreateServiceAccountRequest represents the request to create a service account
+type CreateServiceAccountRequest struct {
+	Username string      `json:"username" validate:"required,min=3,max=100"`
+	Name     *string     `json:"name,omitempty"`
+	Role     ProjectRole `json:"role" validate:"required,oneof=admin member viewer"`
+}
+
+// CreateServiceAccountTokenRequest represents the request to issue a service account token
+type CreateServiceAccountTokenRequest struct {
+	Name          string `json:"name" validate:"required,min=1,max=100"`
+	ExpiresInDays *int   `json:"expires_in_days,omitempty"` // Omit for a non-expiring token
+}
+
+// CreateServiceAccountTokenResponse contains the plaintext token, shown only once
+type CreateServiceAccountTokenResponse struct {
+	ServiceAccountToken
+	Token string `json:"token"`
+}
@@ -0,0 +1,93 @@
+package models
+
+import "time"
+
+// SprintState represents the state of a sprint
+type SprintState string
+
+const (
+	SprintStateFuture SprintState = "future"
+	SprintStateActive SprintState = "active"
+	SprintStateClosed SprintState = "closed"
+)
+
+// Where unfinished issues go when a sprint completes
+const (
+	SprintMoveToBacklog    = "backlog"
+	SprintMoveToNextSprint = "next_sprint"
+)
+
+// Sprint represents an iteration planned on a board
+type Sprint struct {
+	ID          int           `json:"id"`
+	BoardID     int           `json:"board_id"`
+	ProjectID   int           `json:"project_id"`
+	Name        string        `json:"name"`
+	Goal        *string       `json:"goal,omitempty"`
+	StartDate   *time.Time    `json:"start_date,omitempty"`
+	EndDate     *time.Time    `json:"end_date,omitempty"`
+	State       SprintState   `json:"state"`
+	StartedAt   *time.Time    `json:"started_at,omitempty"`
+	CompletedAt *time.Time    `json:"completed_at,omitempty"`
+	CreatedBy   *int          `json:"created_by,omitempty"`
+	CreatedAt   time.Time     `json:"created_at"`
+	UpdatedAt   time.Time     `json:"updated_at"`
+	Report      *SprintReport `json:"report,omitempty"` // Closed sprints only
+
+	// Issues in the sprint when it started
+	CommittedIssueIDs []int `json:"committed_issue_ids"`
+
+	// Computed fields
+	TotalIssues     int `json:"total_issues"`
+	CompletedIssues int `json:"completed_issues"`
+}
+
+// SprintReport is the snapshot of committed versus completed work stored
+// when a sprint completes
+type SprintReport struct {
+	CommittedIssues    int    `json:"committed_issues"`  // In the sprint when it started
+	AddedIssues        int    `json:"added_issues"`      // Planned after the start
+	RemovedIssues      int    `json:"removed_issues"`    // Committed but taken out before completion
+	CompletedIssues    int    `json:"completed_issues"`  // Done at completion
+	IncompleteIssues   int    `json:"incomplete_issues"` // Not done at completion
+	CompletedIssueIDs  []int  `json:"completed_issue_ids"`
+	IncompleteIssueIDs []int  `json:"incomplete_issue_ids"`
+	AddedIssueIDs      []int  `json:"added_issue_ids"`
+	RemovedIssueIDs    []int  `json:"removed_issue_ids"`
+	MovedTo            string `json:"moved_to"`                 // backlog or next_sprint
+	NextSprintID       *int   `json:"next_sprint_id,omitempty"` // Sprint that received the unfinished issues
+}
+
+// CreateSprintRequest represents the request to create a sprint
+type CreateSprintRequest struct {
+	Name      string     `json:"name" validate:"required,min=1,max=100"`
+	Goal      *string    `json:"goal,omitempty"`
+	StartDate *time.Time `json:"start_date,omitempty"`
+	EndDate   *time.Time `json:"end_date,omitempty"`
+}
+
+// UpdateSprintRequest represents the request to update a sprint
+type UpdateSprintRequest struct {
+	Name      *string    `json:"name,omitempty"`
+	Goal      *string    `json:"goal,omitempty"`
+	StartDate *time.Time `json:"start_date,omitempty"`
+	EndDate   *time.Time `json:"end_date,omitempty"`
+}
+
+// PlanSprintRequest represents the request to plan issues into a sprint
+type PlanSprintRequest struct {
+	IssueIDs []int `json:"issue_ids" validate:"required,min=1"`
+}
+
+// StartSprintRequest represents the request to start a sprint
+// Dates default to the planned dates, and the start date to today
+type StartSprintRequest struct {
+	StartDate *time.Time `json:"start_date,omitempty"`
+	EndDate   *time.Time `json:"end_date,omitempty"`
+}
+
+// CompleteSprintRequest represents the request to complete a sprint
+type CompleteSprintRequest struct {
+	MoveTo       string `json:"move_to"`                  // backlog (default) or next_sprint
+	NextSprintID *int   `json:"next_sprint_id,omitempty"` // Default: the board's next future sprint
+}
@@ -9,6 +9,12 @@ type ProjectStatistics struct {
 	OpenIssues   int `json:"open_issues"`
 	ClosedIssues int `json:"closed_issues"`
 
+	// Estimate totals
+	TotalStoryPoints         float64 `json:"total_story_points"`
+	CompletedStoryPoints     float64 `json:"completed_story_points"`
+	TotalEstimateMinutes     int     `json:"total_estimate_minutes"`
+	CompletedEstimateMinutes int     `json:"completed_estimate_minutes"`
+
 	// Issue counts by priority
 	CriticalIssues int `json:"critical_issues"`
 	HighIssues     int `json:"high_issues"`
@@ -37,8 +43,13 @@ type IssueStatistics struct {
 	IssuesCreatedLast30Days int `json:"issues_created_last_30_days"`
 	IssuesClosedLast30Days  int `json:"issues_closed_last_30_days"`
 
+	// Demand
+	TotalUpvotes          int `json:"total_upvotes"`
+	TotalDownvotes        int `json:"total_downvotes"`
+	TotalCustomerRequests int `json:"total_customer_requests"`
+
 	// Distribution
-	IssuesByAssignee map[string]int `json:"issues_by_assignee,omitempty"`
+	IssuesByAssignee map[string]int `json:"issues_by_assignee,omitempty"` // Open issues per assignee username; shared issues count for each assignee
 }
 
 // UserActivityStatistics represents user activity statistics
@@ -0,0 +1,66 @@
+package models
+
+import "time"
+
+// Team represents a named group of organization members
+// Teams are mentioned by their slug (@backend) and can be granted project
+// roles or assigned issues as a unit
+type Team struct {
+	ID             int       `json:"id"`
+	OrganizationID int       `json:"organization_id"`
+	Name           string    `json:"name"`
+	Slug           string    `json:"slug"`
+	Description    *string   `json:"description,omitempty"`
+	CreatedBy      *int      `json:"created_by,omitempty"`
+	MemberCount    int       `json:"member_count"`
+	CreatedAt      time.Time `json:"created_at"`
+	UpdatedAt      time.Time `json:"updated_at"`
+}
+
+// TeamMember represents a member of a team
+type TeamMember struct {
+	TeamID  int       `json:"team_id"`
+	UserID  int       `json:"user_id"`
+	User    *User     `json:"user,omitempty"`
+	AddedAt time.Time `json:"added_at"`
+	AddedBy *int      `json:"added_by,omitempty"`
+}
+
+// ProjectTeam represents a team granted a role in a project
+type ProjectTeam struct {
+	ProjectID int         `json:"project_id"`
+	TeamID    int         `json:"team_id"`
+	Role      ProjectRole `json:"role"`
+	Team      *Team       `json:"team,omitempty"`
+	AddedAt   time.Time   `json:"added_at"`
+	AddedBy   *int        `json:"added_by,omitempty"`
+}
+
+// CreateTeamRequest represents the request to create a team
+type CreateTeamRequest struct {
+	Name        string  `json:"name" validate:"required,min=1,max=255"`
+	Slug        string  `json:"slug" validate:"required,min=3,max=30"`
+	Description *string `json:"description,omitempty"`
+}
+
+// UpdateTeamRequest represents the request to update a team
+type UpdateTeamRequest struct {
+	Name        *string `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+}
+
+// AddTeamMemberRequest represents the request to add a member to a team
+type AddTeamMemberRequest struct {
+	UserID int `json:"user_id" validate:"required"`
+}
+
+// AddProjectTeamRequest represents the request to grant a team a project role
+type AddProjectTeamRequest struct {
+	TeamID int         `json:"team_id" validate:"required"`
+	Role   ProjectRole `json:"role" validate:"required,oneof=admin member viewer"`
+}
+
+// UpdateProjectTeamRequest represents the request to change a team's project role
+type UpdateProjectTeamRequest struct {
+	Role ProjectRole `json:"role" validate:"required,oneof=admin member viewer"`
+}
@@ -7,16 +7,17 @@ import (
 
 // ProjectTemplateConfig 프로젝트 템플릿 설정
 type ProjectTemplateConfig struct {
-	Columns    []ColumnConfig `json:"columns"`
-	Labels     []LabelConfig  `json:"labels"`
+	Columns    []ColumnConfig    `json:"columns"`
+	Labels     []LabelConfig     `json:"labels"`
 	Milestones []MilestoneConfig `json:"milestones,omitempty"`
 }
 
 // ColumnConfig 컬럼 설정
 type ColumnConfig struct {
-	Name     string `json:"name"`
-	Position int    `json:"position"`
-	WIPLimit *int   `json:"wip_limit,omitempty"`
+	Name     string        `json:"name"`
+	Position int           `json:"position"`
+	WIPLimit *int          `json:"wip_limit,omitempty"`
+	Statuses []IssueStatus `json:"statuses,omitempty"` // 컬럼에 매핑된 상태
 }
 
 // LabelConfig 라벨 설정
@@ -34,15 +35,15 @@ type MilestoneConfig struct {
 
 // ProjectTemplate 프로젝트 템플릿
 type ProjectTemplate struct {
-	ID          int                    `json:"id"`
-	Name        string                 `json:"name"`
-	Description string                 `json:"description,omitempty"`
-	IsSystem    bool                   `json:"is_system"`
-	CreatedBy   *int                   `json:"created_by,omitempty"`
-	Config      ProjectTemplateConfig  `json:"config"`
-	ConfigRaw   json.RawMessage        `json:"-"` // DB에서 읽을 때 사용
-	CreatedAt   time.Time              `json:"created_at"`
-	UpdatedAt   time.Time              `json:"updated_at"`
+	ID          int                   `json:"id"`
+	Name        string                `json:"name"`
+	Description string                `json:"description,omitempty"`
+	IsSystem    bool                  `json:"is_system"`
+	CreatedBy   *int                  `json:"created_by,omitempty"`
+	Config      ProjectTemplateConfig `json:"config"`
+	ConfigRaw   json.RawMessage       `json:"-"` // DB에서 읽을 때 사용
+	CreatedAt   time.Time             `json:"created_at"`
+	UpdatedAt   time.Time             `json:"updated_at"`
 }
 
 // IssueTemplate 이슈 템플릿
@@ -0,0 +1,36 @@
+package models
+
+import "time"
+
+// TwoFactor represents a user's TOTP two-factor authentication
+// The secret is pending until the first code is confirmed
+type TwoFactor struct {
+	UserID    int        `json:"user_id"`
+	Secret    string     `json:"-"`
+	EnabledAt *time.Time `json:"enabled_at,omitempty"`
+	CreatedAt time.Time  `json:"created_at"`
+}
+
+// Enabled reports whether setup was confirmed
+func (t *TwoFactor) Enabled() bool {
+	return t.EnabledAt != nil
+}
+
+// TwoFactorStatus represents the two-factor state of the current user
+type TwoFactorStatus struct {
+	Enabled   bool       `json:"enabled"`
+	EnabledAt *time.Time `json:"enabled_at,omitempty"`
+	// Whether an organization the user belongs to requires two-factor authentication
+	Required bool `json:"required"`
+}
+
+// TwoFactorSetupResponse holds the secret to enroll in an authenticator app
+type TwoFactorSetupResponse struct {
+	Secret string `json:"secret"`
+	URI    string `json:"uri"` // otpauth:// URI for QR codes
+}
+
+// TwoFactorCodeRequest represents a request confirmed with a one-time code
+type TwoFactorCodeRequest struct {
+	Code string `json:"code" validate:"required"`
+}
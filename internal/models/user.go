@@ -12,6 +12,8 @@ type User struct {
 	AvatarURL        *string   `json:"avatar_url,omitempty"`
 	ExternalID       *string   `json:"external_id,omitempty"`
 	ExternalProvider *string   `json:"external_provider,omitempty"`
+	IsBot            bool      `json:"is_bot"`                   // Service account, authenticates with API tokens only
+	BotProjectID     *int      `json:"bot_project_id,omitempty"` // Project a service account belongs to
 	CreatedAt        time.Time `json:"created_at"`
 	UpdatedAt        time.Time `json:"updated_at"`
 }
@@ -24,9 +26,11 @@ type CreateUserRequest struct {
 }
 
 // LoginRequest represents the login request
+// For directory (LDAP) logins Email may also hold the directory username
 type LoginRequest struct {
-	Email    string `json:"email" validate:"required,email"`
+	Email    string `json:"email" validate:"required"`
 	Password string `json:"password" validate:"required"`
+	OTPCode  string `json:"otp_code,omitempty"` // Required when two-factor authentication is enabled
 }
 
 // TokenPair represents access and refresh tokens
@@ -44,7 +48,7 @@ type LoginResponse struct {
 
 // TokenExchangeRequest represents the request to exchange an external token for Flow tokens
 type TokenExchangeRequest struct {
-	Provider   string  `json:"provider" validate:"required"`   // e.g., "jmember"
+	Provider   string  `json:"provider" validate:"required"`    // e.g., "jmember"
 	ExternalID string  `json:"external_id" validate:"required"` // User ID from external system
 	Email      string  `json:"email" validate:"required,email"`
 	Username   string  `json:"username" validate:"required"`
@@ -0,0 +1,50 @@
+package models
+
+import "time"
+
+// Vote values
+const (
+	VoteUp   = 1
+	VoteDown = -1
+)
+
+// IssueVote represents a user's up or down vote on an issue
+type IssueVote struct {
+	IssueID   int       `json:"issue_id"`
+	UserID    int       `json:"user_id"`
+	Value     int       `json:"value"` // 1 = up, -1 = down
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// VoteIssueRequest represents the request to vote on an issue
+type VoteIssueRequest struct {
+	Value int `json:"value"` // 1 to vote up, -1 to vote down
+}
+
+// IssueVoteSummary represents the vote totals of an issue
+type IssueVoteSummary struct {
+	IssueID   int `json:"issue_id"`
+	Upvotes   int `json:"upvotes"`
+	Downvotes int `json:"downvotes"`
+	Score     int `json:"score"`     // Upvotes minus downvotes
+	UserVote  int `json:"user_vote"` // The requesting user's vote, 0 when they haven't voted
+}
+
+// CustomerRequest records that a customer asked for an issue
+// Customers are named freely since they don't need an account
+type CustomerRequest struct {
+	ID               int       `json:"id"`
+	IssueID          int       `json:"issue_id"`
+	OrganizationName string    `json:"organization_name"`
+	Note             *string   `json:"note,omitempty"`
+	CreatedBy        *int      `json:"created_by,omitempty"`
+	CreatedAt        time.Time `json:"created_at"`
+}
+
+// CreateCustomerRequestRequest represents the request to record a customer
+// request on an issue
+type CreateCustomerRequestRequest struct {
+	OrganizationName string  `json:"organization_name"`
+	Note             *string `json:"note,omitempty"`
+}
@@ -11,6 +11,7 @@ const (
 	EventIssueCreated   = "issue.created"
 	EventIssueUpdated   = "issue.updated"
 	EventIssueDeleted   = "issue.deleted"
+	EventIssueRestored  = "issue.restored"
 	EventIssueMoved     = "issue.moved"
 	EventCommentCreated = "comment.created"
 	EventCommentUpdated = "comment.updated"
@@ -19,6 +20,8 @@ const (
 	EventLabelRemoved   = "label.removed"
 	EventTasklistItemCreated   = "tasklist_item.created"
 	EventTasklistItemCompleted = "tasklist_item.completed"
+	EventSprintStarted         = "sprint.started"
+	EventSprintCompleted       = "sprint.completed"
 )
 
 // AllWebhookEvents returns all available webhook event types
@@ -27,6 +30,7 @@ func AllWebhookEvents() []string {
 		EventIssueCreated,
 		EventIssueUpdated,
 		EventIssueDeleted,
+		EventIssueRestored,
 		EventIssueMoved,
 		EventCommentCreated,
 		EventCommentUpdated,
@@ -35,6 +39,8 @@ func AllWebhookEvents() []string {
 		EventLabelRemoved,
 		EventTasklistItemCreated,
 		EventTasklistItemCompleted,
+		EventSprintStarted,
+		EventSprintCompleted,
 	}
 }
 
@@ -67,11 +73,13 @@ type WebhookDelivery struct {
 
 // WebhookPayload represents the payload sent to webhook endpoints
 type WebhookPayload struct {
-	Event     string      `json:"event"`
-	Timestamp time.Time   `json:"timestamp"`
-	ProjectID int         `json:"project_id"`
-	Actor     *User       `json:"actor,omitempty"`
-	Data      interface{} `json:"data"`
+	Event        string      `json:"event"`
+	Timestamp    time.Time   `json:"timestamp"`
+	ProjectID    int         `json:"project_id"`
+	ProjectKey   string      `json:"project_key,omitempty"`
+	PreviousKeys []string    `json:"previous_keys"` // Former project keys, newest first
+	Actor        *User       `json:"actor,omitempty"`
+	Data         interface{} `json:"data"`
 }
 
 // CreateWebhookRequest represents webhook creation request
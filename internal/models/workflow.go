@@ -0,0 +1,165 @@
+package models
+
+import "time"
+
+// StatusCategory groups workflow statuses for reports, progress and filters
+type StatusCategory string
+
+const (
+	StatusCategoryTodo       StatusCategory = "todo"
+	StatusCategoryInProgress StatusCategory = "in_progress"
+	StatusCategoryDone       StatusCategory = "done"
+)
+
+// Fields a workflow transition can require to be set on the issue
+const (
+	TransitionFieldResolution  = "resolution"
+	TransitionFieldAssignee    = "assignee"
+	TransitionFieldMilestone   = "milestone"
+	TransitionFieldDescription = "description"
+)
+
+// Workflow represents the statuses and allowed transitions of a project's issues
+type Workflow struct {
+	ID          int                   `json:"id"`
+	ProjectID   int                   `json:"project_id"`
+	Name        string                `json:"name"`
+	IsDefault   bool                  `json:"is_default"`  // Used by issue types without their own workflow
+	IssueTypes  []IssueType           `json:"issue_types"` // Issue types that use this workflow
+	Statuses    []*WorkflowStatus     `json:"statuses"`
+	Transitions []*WorkflowTransition `json:"transitions"`
+	CreatedAt   time.Time             `json:"created_at"`
+	UpdatedAt   time.Time             `json:"updated_at"`
+}
+
+// WorkflowStatus represents a status of a workflow
+type WorkflowStatus struct {
+	ID         int            `json:"id"`
+	WorkflowID int            `json:"workflow_id"`
+	Key        IssueStatus    `json:"key"` // Value stored in the issue status
+	Name       string         `json:"name"`
+	Category   StatusCategory `json:"category"`
+	Position   int            `json:"position"`
+	IsInitial  bool           `json:"is_initial"` // Status of newly created issues
+}
+
+// WorkflowTransition represents an allowed move between two statuses
+type WorkflowTransition struct {
+	ID             int           `json:"id"`
+	WorkflowID     int           `json:"workflow_id"`
+	Name           string        `json:"name"`
+	FromStatus     *IssueStatus  `json:"from_status,omitempty"` // nil: from any status
+	ToStatus       IssueStatus   `json:"to_status"`
+	AllowedRoles   []ProjectRole `json:"allowed_roles,omitempty"` // empty: any role with write access
+	RequiredFields []string      `json:"required_fields"`
+
+	// Target status (for available transitions)
+	To *WorkflowStatus `json:"to,omitempty"`
+}
+
+// WorkflowRequest represents the request to create or replace a workflow
+// Statuses are identified by key, and transitions reference them by key
+type WorkflowRequest struct {
+	Name        string                `json:"name" validate:"required,min=1,max=100"`
+	IsDefault   bool                  `json:"is_default"`
+	IssueTypes  []IssueType           `json:"issue_types,omitempty"`
+	Statuses    []*WorkflowStatus     `json:"statuses" validate:"required,min=1"`
+	Transitions []*WorkflowTransition `json:"transitions"`
+}
+
+// DefaultWorkflow returns the built-in workflow used by projects without a
+// workflow of their own: open, in progress and closed, with any transition allowed
+func DefaultWorkflow(projectID int) *Workflow {
+	statuses := []*WorkflowStatus{
+		{Key: IssueStatusOpen, Name: "Open", Category: StatusCategoryTodo, Position: 0, IsInitial: true},
+		{Key: IssueStatusInProgress, Name: "In Progress", Category: StatusCategoryInProgress, Position: 1},
+		{Key: IssueStatusClosed, Name: "Closed", Category: StatusCategoryDone, Position: 2},
+	}
+
+	transitions := make([]*WorkflowTransition, 0, len(statuses))
+	for _, status := range statuses {
+		transitions = append(transitions, &WorkflowTransition{
+			Name:           status.Name,
+			ToStatus:       status.Key,
+			RequiredFields: []string{},
+		})
+	}
+
+	return &Workflow{
+		ProjectID:   projectID,
+		Name:        "Default",
+		IsDefault:   true,
+		IssueTypes:  []IssueType{},
+		Statuses:    statuses,
+		Transitions: transitions,
+	}
+}
+
+// Status returns the workflow status with the given key, or nil
+func (w *Workflow) Status(key IssueStatus) *WorkflowStatus {
+	for _, status := range w.Statuses {
+		if status.Key == key {
+			return status
+		}
+	}
+	return nil
+}
+
+// InitialStatus returns the status of newly created issues
+func (w *Workflow) InitialStatus() *WorkflowStatus {
+	for _, status := range w.Statuses {
+		if status.IsInitial {
+			return status
+		}
+	}
+	if len(w.Statuses) > 0 {
+		return w.Statuses[0]
+	}
+	return nil
+}
+
+// TransitionsFrom returns the transitions leaving a status, including
+// transitions allowed from any status
+func (w *Workflow) TransitionsFrom(from IssueStatus) []*WorkflowTransition {
+	var transitions []*WorkflowTransition
+	for _, transition := range w.Transitions {
+		if transition.ToStatus == from {
+			continue
+		}
+		if transition.FromStatus == nil || *transition.FromStatus == from {
+			transitions = append(transitions, transition)
+		}
+	}
+	return transitions
+}
+
+// AllowsRole reports whether a project role may perform the transition
+func (t *WorkflowTransition) AllowsRole(role ProjectRole) bool {
+	if len(t.AllowedRoles) == 0 {
+		return true
+	}
+	for _, allowed := range t.AllowedRoles {
+		if allowed == role {
+			return true
+		}
+	}
+	return false
+}
+
+// IsValidStatusCategory checks if the status category is valid
+func IsValidStatusCategory(category StatusCategory) bool {
+	switch category {
+	case StatusCategoryTodo, StatusCategoryInProgress, StatusCategoryDone:
+		return true
+	}
+	return false
+}
+
+// IsValidTransitionField checks if a transition can require the field
+func IsValidTransitionField(field string) bool {
+	switch field {
+	case TransitionFieldResolution, TransitionFieldAssignee, TransitionFieldMilestone, TransitionFieldDescription:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,81 @@
+package models
+
+import "time"
+
+// Worklog is time a user spent on an issue
+type Worklog struct {
+	ID               int       `json:"id"`
+	IssueID          int       `json:"issue_id"`
+	UserID           int       `json:"user_id"`
+	TimeSpentMinutes int       `json:"time_spent_minutes"`
+	StartedAt        time.Time `json:"started_at"`
+	Description      *string   `json:"description,omitempty"`
+	CreatedAt        time.Time `json:"created_at"`
+	UpdatedAt        time.Time `json:"updated_at"`
+
+	// Populated fields
+	User *User `json:"user,omitempty"`
+}
+
+// CreateWorklogRequest represents the request to log work on an issue
+type CreateWorklogRequest struct {
+	TimeSpentMinutes int        `json:"time_spent_minutes" validate:"required,min=1"`
+	StartedAt        *time.Time `json:"started_at,omitempty"` // Default: now
+	Description      *string    `json:"description,omitempty"`
+
+	// Sets the issue's remaining estimate; by default it is reduced by the time spent
+	RemainingEstimateMinutes *int `json:"remaining_estimate_minutes,omitempty"`
+}
+
+// UpdateWorklogRequest represents the request to update a worklog
+type UpdateWorklogRequest struct {
+	TimeSpentMinutes *int       `json:"time_spent_minutes,omitempty" validate:"omitempty,min=1"`
+	StartedAt        *time.Time `json:"started_at,omitempty"`
+	Description      *string    `json:"description,omitempty"`
+}
+
+// TimeTracking summarizes the estimates and logged time of an issue, on its
+// own and rolled up with its subtasks and, for epics, the epic's issues
+type TimeTracking struct {
+	IssueID                  int  `json:"issue_id"`
+	OriginalEstimateMinutes  *int `json:"original_estimate_minutes,omitempty"`
+	RemainingEstimateMinutes *int `json:"remaining_estimate_minutes,omitempty"`
+	TimeSpentMinutes         int  `json:"time_spent_minutes"`
+
+	// Totals over the issue and its descendants
+	TotalOriginalEstimateMinutes  int `json:"total_original_estimate_minutes"`
+	TotalRemainingEstimateMinutes int `json:"total_remaining_estimate_minutes"`
+	TotalTimeSpentMinutes         int `json:"total_time_spent_minutes"`
+	IssueCount                    int `json:"issue_count"` // Issues included in the totals
+}
+
+// TimesheetFilter represents filters for a timesheet report
+type TimesheetFilter struct {
+	UserID    *int
+	ProjectID *int
+	From      time.Time // Inclusive
+	To        time.Time // Exclusive
+}
+
+// TimesheetEntry is a worklog with the issue and project it was logged on
+type TimesheetEntry struct {
+	WorklogID        int       `json:"worklog_id"`
+	StartedAt        time.Time `json:"started_at"`
+	UserID           int       `json:"user_id"`
+	Username         string    `json:"username"`
+	ProjectID        int       `json:"project_id"`
+	ProjectKey       string    `json:"project_key"`
+	IssueID          int       `json:"issue_id"`
+	IssueNumber      int       `json:"issue_number"`
+	IssueTitle       string    `json:"issue_title"`
+	TimeSpentMinutes int       `json:"time_spent_minutes"`
+	Description      *string   `json:"description,omitempty"`
+}
+
+// Timesheet is the logged time of a period
+type Timesheet struct {
+	From                  time.Time         `json:"from"`
+	To                    time.Time         `json:"to"`
+	TotalTimeSpentMinutes int               `json:"total_time_spent_minutes"`
+	Entries               []*TimesheetEntry `json:"entries"`
+}
@@ -0,0 +1,374 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/yourusername/issue-tracker/internal/models"
+	pkgerrors "github.com/yourusername/issue-tracker/pkg/errors"
+)
+
+// AccountRepository handles personal data exports and account deletion
+type AccountRepository struct {
+	db *sql.DB
+}
+
+// NewAccountRepository creates a new account repository
+func NewAccountRepository(db *sql.DB) *AccountRepository {
+	return &AccountRepository{db: db}
+}
+
+// exportSections lists the personal data included in an export
+// Each query returns a single JSON document for the user given as $1
+var exportSections = []struct {
+	name  string
+	query string
+}{
+	{"profile", `
+		SELECT row_to_json(t) FROM (
+			SELECT id, email, username, name, avatar_url, external_provider, created_at, updated_at
+			FROM users WHERE id = $1
+		) t`},
+	{"issues", `
+		SELECT COALESCE(json_agg(t ORDER BY t.created_at), '[]'::json) FROM (
+			SELECT i.*, p.key AS project_key
+			FROM issues i JOIN projects p ON p.id = i.project_id
+			WHERE i.reporter_id = $1
+		) t`},
+	{"comments", `
+		SELECT COALESCE(json_agg(t ORDER BY t.created_at), '[]'::json) FROM (
+			SELECT * FROM comments WHERE user_id = $1
+		) t`},
+	{"reactions", `
+		SELECT COALESCE(json_agg(t ORDER BY t.created_at), '[]'::json) FROM (
+			SELECT * FROM reactions WHERE user_id = $1
+		) t`},
+	{"watches", `
+		SELECT COALESCE(json_agg(t ORDER BY t.created_at), '[]'::json) FROM (
+			SELECT * FROM issue_watchers WHERE user_id = $1
+		) t`},
+	{"notifications", `
+		SELECT COALESCE(json_agg(t ORDER BY t.created_at), '[]'::json) FROM (
+			SELECT * FROM notifications WHERE user_id = $1
+		) t`},
+	{"worklogs", `
+		SELECT COALESCE(json_agg(t ORDER BY t.started_at), '[]'::json) FROM (
+			SELECT * FROM worklogs WHERE user_id = $1
+		) t`},
+	{"mentions", `
+		SELECT COALESCE(json_agg(t ORDER BY t.created_at), '[]'::json) FROM (
+			SELECT * FROM mentions WHERE user_id = $1 OR mentioned_by_user_id = $1
+		) t`},
+}
+
+// ExportData collects the personal data of a user as named JSON documents
+func (r *AccountRepository) ExportData(ctx context.Context, userID int) (map[string]json.RawMessage, error) {
+	data := make(map[string]json.RawMessage, len(exportSections))
+	for _, section := range exportSections {
+		var doc []byte
+		if err := r.db.QueryRowContext(ctx, section.query, userID).Scan(&doc); err != nil {
+			if err == sql.ErrNoRows {
+				return nil, pkgerrors.ErrNotFound
+			}
+			return nil, err
+		}
+		data[section.name] = json.RawMessage(doc)
+	}
+
+	return data, nil
+}
+
+// CreateExport creates a pending export job for a user
+func (r *AccountRepository) CreateExport(ctx context.Context, userID int) (*models.UserExport, error) {
+	query := `
+		INSERT INTO user_exports (user_id, status)
+		VALUES ($1, $2)
+		RETURNING id, user_id, status, storage_key, file_size, error_message, created_at, completed_at, downloaded_at
+	`
+
+	var export models.UserExport
+	err := r.db.QueryRowContext(ctx, query, userID, models.ExportStatusPending).Scan(
+		&export.ID,
+		&export.UserID,
+		&export.Status,
+		&export.StorageKey,
+		&export.FileSize,
+		&export.ErrorMessage,
+		&export.CreatedAt,
+		&export.CompletedAt,
+		&export.DownloadedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &export, nil
+}
+
+// GetExport retrieves an export job of a user
+func (r *AccountRepository) GetExport(ctx context.Context, userID, exportID int) (*models.UserExport, error) {
+	query := `
+		SELECT id, user_id, status, storage_key, file_size, error_message, created_at, completed_at, downloaded_at
+		FROM user_exports
+		WHERE id = $1 AND user_id = $2
+	`
+
+	var export models.UserExport
+	err := r.db.QueryRowContext(ctx, query, exportID, userID).Scan(
+		&export.ID,
+		&export.UserID,
+		&export.Status,
+		&export.StorageKey,
+		&export.FileSize,
+		&export.ErrorMessage,
+		&export.CreatedAt,
+		&export.CompletedAt,
+		&export.DownloadedAt,
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, pkgerrors.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return &export, nil
+}
+
+// UpdateExportStatus sets the status of an export job
+func (r *AccountRepository) UpdateExportStatus(ctx context.Context, exportID int, status models.UserExportStatus) error {
+	_, err := r.db.ExecContext(ctx, `UPDATE user_exports SET status = $2 WHERE id = $1`, exportID, status)
+	return err
+}
+
+// CompleteExport records the stored archive of a finished export job
+func (r *AccountRepository) CompleteExport(ctx context.Context, exportID int, storageKey string, fileSize int64) error {
+	query := `
+		UPDATE user_exports
+		SET status = $2, storage_key = $3, file_size = $4, completed_at = NOW()
+		WHERE id = $1
+	`
+
+	_, err := r.db.ExecContext(ctx, query, exportID, models.ExportStatusCompleted, storageKey, fileSize)
+	return err
+}
+
+// FailExport marks an export job as failed
+func (r *AccountRepository) FailExport(ctx context.Context, exportID int, message string) error {
+	query := `
+		UPDATE user_exports
+		SET status = $2, error_message = $3, completed_at = NOW()
+		WHERE id = $1
+	`
+
+	_, err := r.db.ExecContext(ctx, query, exportID, models.ExportStatusFailed, message)
+	return err
+}
+
+// exportClaimTimeout is how long a download claim blocks other downloads of
+// the export; a claim left behind by a crashed server can be taken over later
+const exportClaimTimeout = 15 * time.Minute
+
+// ClaimExportDownload atomically marks a completed export as being downloaded
+// and returns its storage key, so concurrent requests cannot download it twice
+// The export only counts as downloaded after FinishExportDownload
+func (r *AccountRepository) ClaimExportDownload(ctx context.Context, userID, exportID int) (string, error) {
+	query := `
+		UPDATE user_exports
+		SET status = $3, download_claimed_at = NOW()
+		WHERE id = $1 AND user_id = $2
+		  AND (status = $4 OR (status = $3 AND download_claimed_at < NOW() - $5 * INTERVAL '1 second'))
+		RETURNING storage_key
+	`
+
+	var storageKey string
+	err := r.db.QueryRowContext(ctx, query, exportID, userID,
+		models.ExportStatusDownloading, models.ExportStatusCompleted, exportClaimTimeout.Seconds(),
+	).Scan(&storageKey)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return "", pkgerrors.ErrNotFound
+		}
+		return "", err
+	}
+
+	return storageKey, nil
+}
+
+// FinishExportDownload marks a claimed export as downloaded
+func (r *AccountRepository) FinishExportDownload(ctx context.Context, exportID int) error {
+	query := `
+		UPDATE user_exports
+		SET status = $2, downloaded_at = NOW()
+		WHERE id = $1 AND status = $3
+	`
+
+	result, err := r.db.ExecContext(ctx, query, exportID, models.ExportStatusDownloaded, models.ExportStatusDownloading)
+	if err != nil {
+		return err
+	}
+
+	return requireRowsAffected(result)
+}
+
+// ReleaseExportDownload gives up the download claim of an export so it can
+// be downloaded again
+func (r *AccountRepository) ReleaseExportDownload(ctx context.Context, exportID int) error {
+	query := `
+		UPDATE user_exports
+		SET status = $2, download_claimed_at = NULL
+		WHERE id = $1 AND status = $3
+	`
+
+	_, err := r.db.ExecContext(ctx, query, exportID, models.ExportStatusCompleted, models.ExportStatusDownloading)
+	return err
+}
+
+// CountOwnedProjects counts the projects a user still owns
+func (r *AccountRepository) CountOwnedProjects(ctx context.Context, userID int) (int, error) {
+	query := `
+		SELECT COUNT(*) FROM projects p
+		WHERE p.owner_id = $1
+		   OR EXISTS (
+			SELECT 1 FROM project_members pm
+			WHERE pm.project_id = p.id AND pm.user_id = $1 AND pm.role = 'owner'
+		   )
+	`
+
+	var count int
+	if err := r.db.QueryRowContext(ctx, query, userID).Scan(&count); err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// CountSoleOwnedOrganizations counts the organizations where the user is the only owner
+func (r *AccountRepository) CountSoleOwnedOrganizations(ctx context.Context, userID int) (int, error) {
+	query := `
+		SELECT COUNT(*) FROM organization_members om
+		WHERE om.user_id = $1 AND om.role = 'owner'
+		  AND NOT EXISTS (
+			SELECT 1 FROM organization_members other
+			WHERE other.organization_id = om.organization_id AND other.role = 'owner' AND other.user_id <> $1
+		  )
+	`
+
+	var count int
+	if err := r.db.QueryRowContext(ctx, query, userID).Scan(&count); err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// ListExportStorageKeys returns the storage keys of archives not yet downloaded
+func (r *AccountRepository) ListExportStorageKeys(ctx context.Context, userID int) ([]string, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT storage_key FROM user_exports
+		WHERE user_id = $1 AND storage_key IS NOT NULL AND downloaded_at IS NULL
+	`, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	keys := make([]string, 0)
+	for rows.Next() {
+		var key string
+		if err := rows.Scan(&key); err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+
+	return keys, rows.Err()
+}
+
+// anonymizeStatements reassign authored content of the user ($1) to the
+// placeholder user ($2) before the user is deleted.
+// Personal data (reactions, watches, notifications, mentions, tokens,
+// memberships) is removed by the ON DELETE CASCADE foreign keys.
+var anonymizeStatements = []string{
+	`UPDATE issues SET reporter_id = $2 WHERE reporter_id = $1`,
+	`UPDATE comments SET user_id = $2 WHERE user_id = $1`,
+	`UPDATE activities SET user_id = $2, ip_address = NULL, user_agent = NULL WHERE user_id = $1`,
+	`UPDATE attachments SET user_id = $2 WHERE user_id = $1`,
+	`UPDATE worklogs SET user_id = $2 WHERE user_id = $1`,
+	`UPDATE webhooks SET created_by = $2 WHERE created_by = $1`,
+	`UPDATE integrations SET created_by = $2 WHERE created_by = $1`,
+	// User custom fields hold the user ID as a JSON number
+	`UPDATE issue_custom_field_values v SET value = to_jsonb($2::int), updated_at = NOW()
+	FROM custom_fields f
+	WHERE f.id = v.field_id AND f.field_type = 'user' AND v.value = to_jsonb($1::int)`,
+}
+
+// clearUserStatements clear optional references to the user ($1) before the
+// user is deleted
+var clearUserStatements = []string{
+	// Another assignee, if any, becomes primary
+	`UPDATE issues i SET assignee_id = (
+		SELECT ia.user_id FROM issue_assignees ia
+		WHERE ia.issue_id = i.id AND ia.user_id <> $1
+		ORDER BY ia.created_at, ia.user_id
+		LIMIT 1
+	) WHERE assignee_id = $1`,
+	`UPDATE project_members SET invited_by = NULL WHERE invited_by = $1`,
+	`UPDATE tasklist_items SET completed_by = NULL WHERE completed_by = $1`,
+}
+
+// DeleteUser anonymizes the authored content of a user and deletes the account
+func (r *AccountRepository) DeleteUser(ctx context.Context, userID int) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	var placeholderID int
+	err = tx.QueryRowContext(ctx, `SELECT id FROM users WHERE is_placeholder`).Scan(&placeholderID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return pkgerrors.NewInternalError("deleted user placeholder is missing", err)
+		}
+		return err
+	}
+	if placeholderID == userID {
+		return pkgerrors.ErrForbidden
+	}
+
+	for _, stmt := range anonymizeStatements {
+		if _, err := tx.ExecContext(ctx, stmt, userID, placeholderID); err != nil {
+			return err
+		}
+	}
+	for _, stmt := range clearUserStatements {
+		if _, err := tx.ExecContext(ctx, stmt, userID); err != nil {
+			return err
+		}
+	}
+
+	// Votes would cascade with the user and leave the issue totals stale;
+	// customer requests stay, so their count is unaffected
+	if err := deleteUserVotes(ctx, tx, userID); err != nil {
+		return err
+	}
+
+	result, err := tx.ExecContext(ctx, `DELETE FROM users WHERE id = $1`, userID)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return pkgerrors.ErrNotFound
+	}
+
+	return tx.Commit()
+}
@@ -0,0 +1,236 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"testing"
+
+	"github.com/yourusername/issue-tracker/internal/models"
+	pkgerrors "github.com/yourusername/issue-tracker/pkg/errors"
+)
+
+func setupAccountRepo(t *testing.T) (*AccountRepository, *sql.DB, func()) {
+	db := setupTestDB(t)
+
+	cleanupData := func() {
+		db.Exec("DELETE FROM issues WHERE project_id IN (SELECT id FROM projects WHERE key LIKE 'ACCT%')")
+		db.Exec("DELETE FROM project_members WHERE project_id IN (SELECT id FROM projects WHERE key LIKE 'ACCT%')")
+		db.Exec("DELETE FROM projects WHERE key LIKE 'ACCT%'")
+		db.Exec("DELETE FROM users WHERE email LIKE 'accttest%@example.com'")
+	}
+	cleanupData()
+
+	cleanup := func() {
+		cleanupData()
+		db.Close()
+	}
+
+	return NewAccountRepository(db), db, cleanup
+}
+
+func TestAccountRepository_DeleteUser(t *testing.T) {
+	repo, db, cleanup := setupAccountRepo(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	userRepo := NewUserRepository(db)
+	issueRepo := NewIssueRepository(db)
+	commentRepo := NewCommentRepository(db)
+
+	var placeholderID int
+	if err := db.QueryRow("SELECT id FROM users WHERE is_placeholder").Scan(&placeholderID); err != nil {
+		t.Fatalf("Failed to find placeholder user: %v", err)
+	}
+
+	owner, _ := userRepo.Create(ctx, &models.User{Email: "accttest1@example.com", Username: "accttest1", PasswordHash: "hash"})
+	leaving, _ := userRepo.Create(ctx, &models.User{Email: "accttest2@example.com", Username: "accttest2", PasswordHash: "hash"})
+	project, _ := NewProjectRepository(db).Create(ctx, &models.Project{Name: "Account", Key: "ACCT1", OwnerID: owner.ID})
+
+	issue, err := issueRepo.Create(ctx, &models.Issue{
+		ProjectID:  project.ID,
+		Title:      "Written by a leaving user",
+		Status:     models.IssueStatusOpen,
+		Priority:   models.PriorityMedium,
+		ReporterID: leaving.ID,
+		AssigneeID: &leaving.ID,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create issue: %v", err)
+	}
+	if err := issueRepo.SetAssignees(ctx, issue.ID, []int{leaving.ID, owner.ID}); err != nil {
+		t.Fatalf("Failed to set assignees: %v", err)
+	}
+	comment, err := commentRepo.Create(ctx, &models.Comment{IssueID: issue.ID, UserID: leaving.ID, Content: "Goodbye"})
+	if err != nil {
+		t.Fatalf("Failed to create comment: %v", err)
+	}
+	fieldRepo := NewCustomFieldRepository(db)
+	reviewer, err := fieldRepo.Create(ctx, &models.CustomField{ProjectID: project.ID, Key: "reviewer", Name: "Reviewer", FieldType: models.CustomFieldUser, Options: []string{}})
+	if err != nil {
+		t.Fatalf("Failed to create custom field: %v", err)
+	}
+	leavingValue, _ := json.Marshal(leaving.ID)
+	if err := fieldRepo.SetValue(ctx, issue.ID, reviewer.ID, leavingValue); err != nil {
+		t.Fatalf("Failed to set custom field value: %v", err)
+	}
+
+	voteRepo := NewIssueVoteRepository(db)
+	for _, vote := range []*models.IssueVote{
+		{IssueID: issue.ID, UserID: leaving.ID, Value: 1},
+		{IssueID: issue.ID, UserID: owner.ID, Value: -1},
+	} {
+		if err := voteRepo.Vote(ctx, vote); err != nil {
+			t.Fatalf("Failed to vote: %v", err)
+		}
+	}
+
+	t.Run("should refuse to delete the placeholder", func(t *testing.T) {
+		err := repo.DeleteUser(ctx, placeholderID)
+		if err != pkgerrors.ErrForbidden {
+			t.Errorf("Expected ErrForbidden, got %v", err)
+		}
+	})
+
+	t.Run("should reassign authored content and delete the user", func(t *testing.T) {
+		if err := repo.DeleteUser(ctx, leaving.ID); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		updated, err := issueRepo.GetByID(ctx, issue.ID)
+		if err != nil {
+			t.Fatalf("Failed to get issue: %v", err)
+		}
+		if updated.ReporterID != placeholderID {
+			t.Errorf("Expected reporter %d, got %d", placeholderID, updated.ReporterID)
+		}
+
+		updatedComment, err := commentRepo.GetByID(ctx, comment.ID)
+		if err != nil {
+			t.Fatalf("Failed to get comment: %v", err)
+		}
+		if updatedComment.UserID != placeholderID {
+			t.Errorf("Expected comment author %d, got %d", placeholderID, updatedComment.UserID)
+		}
+
+		if _, err := userRepo.GetByID(ctx, leaving.ID); err != pkgerrors.ErrNotFound {
+			t.Errorf("Expected user to be deleted, got %v", err)
+		}
+	})
+
+	t.Run("should reassign user custom field values", func(t *testing.T) {
+		values, err := fieldRepo.GetValues(ctx, issue.ID)
+		if err != nil {
+			t.Fatalf("Failed to get custom field values: %v", err)
+		}
+		var userID int
+		if err := json.Unmarshal(values[reviewer.ID], &userID); err != nil || userID != placeholderID {
+			t.Errorf("Expected reviewer %d, got %s", placeholderID, values[reviewer.ID])
+		}
+	})
+
+	t.Run("should recount the votes of the user's issues", func(t *testing.T) {
+		updated, err := issueRepo.GetByID(ctx, issue.ID)
+		if err != nil {
+			t.Fatalf("Failed to get issue: %v", err)
+		}
+		if updated.Upvotes != 0 || updated.Downvotes != 1 {
+			t.Errorf("Expected 0 upvotes and 1 downvote, got %d and %d", updated.Upvotes, updated.Downvotes)
+		}
+	})
+
+	t.Run("should promote another assignee to primary", func(t *testing.T) {
+		updated, err := issueRepo.GetByID(ctx, issue.ID)
+		if err != nil {
+			t.Fatalf("Failed to get issue: %v", err)
+		}
+		if updated.AssigneeID == nil || *updated.AssigneeID != owner.ID {
+			t.Errorf("Expected primary assignee %d, got %v", owner.ID, updated.AssigneeID)
+		}
+	})
+
+	t.Run("should return not found for unknown user", func(t *testing.T) {
+		if err := repo.DeleteUser(ctx, leaving.ID); err != pkgerrors.ErrNotFound {
+			t.Errorf("Expected ErrNotFound, got %v", err)
+		}
+	})
+}
+
+func TestAccountRepository_ClaimExportDownload(t *testing.T) {
+	repo, db, cleanup := setupAccountRepo(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	user, _ := NewUserRepository(db).Create(ctx, &models.User{Email: "accttest3@example.com", Username: "accttest3", PasswordHash: "hash"})
+
+	export, err := repo.CreateExport(ctx, user.ID)
+	if err != nil {
+		t.Fatalf("Failed to create export: %v", err)
+	}
+
+	t.Run("should not claim an export that is not completed", func(t *testing.T) {
+		if _, err := repo.ClaimExportDownload(ctx, user.ID, export.ID); err != pkgerrors.ErrNotFound {
+			t.Errorf("Expected ErrNotFound, got %v", err)
+		}
+	})
+
+	if err := repo.CompleteExport(ctx, export.ID, "exports/accttest.zip", 42); err != nil {
+		t.Fatalf("Failed to complete export: %v", err)
+	}
+
+	t.Run("should not be claimed twice", func(t *testing.T) {
+		key, err := repo.ClaimExportDownload(ctx, user.ID, export.ID)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if key != "exports/accttest.zip" {
+			t.Errorf("Expected storage key exports/accttest.zip, got %s", key)
+		}
+
+		if _, err := repo.ClaimExportDownload(ctx, user.ID, export.ID); err != pkgerrors.ErrNotFound {
+			t.Errorf("Expected ErrNotFound for second claim, got %v", err)
+		}
+	})
+
+	t.Run("should be claimable again after release", func(t *testing.T) {
+		if err := repo.ReleaseExportDownload(ctx, export.ID); err != nil {
+			t.Fatalf("Failed to release export: %v", err)
+		}
+
+		if _, err := repo.ClaimExportDownload(ctx, user.ID, export.ID); err != nil {
+			t.Errorf("Expected no error, got %v", err)
+		}
+	})
+
+	t.Run("should not be claimed after download finished", func(t *testing.T) {
+		if err := repo.FinishExportDownload(ctx, export.ID); err != nil {
+			t.Fatalf("Failed to finish download: %v", err)
+		}
+
+		if _, err := repo.ClaimExportDownload(ctx, user.ID, export.ID); err != pkgerrors.ErrNotFound {
+			t.Errorf("Expected ErrNotFound, got %v", err)
+		}
+
+		keys, err := repo.ListExportStorageKeys(ctx, user.ID)
+		if err != nil {
+			t.Fatalf("Failed to list storage keys: %v", err)
+		}
+		if len(keys) != 0 {
+			t.Errorf("Expected no pending archives, got %v", keys)
+		}
+	})
+
+	t.Run("should not be claimed by another user", func(t *testing.T) {
+		other, err := repo.CreateExport(ctx, user.ID)
+		if err != nil {
+			t.Fatalf("Failed to create export: %v", err)
+		}
+		if err := repo.CompleteExport(ctx, other.ID, "exports/accttest-2.zip", 42); err != nil {
+			t.Fatalf("Failed to complete export: %v", err)
+		}
+
+		if _, err := repo.ClaimExportDownload(ctx, user.ID+1, other.ID); err != pkgerrors.ErrNotFound {
+			t.Errorf("Expected ErrNotFound, got %v", err)
+		}
+	})
+}
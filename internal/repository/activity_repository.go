@@ -61,7 +61,7 @@ func (r *ActivityRepository) ListByProjectID(ctx context.Context, projectID int,
 			a.id, a.project_id, a.issue_id, a.user_id, a.action, a.entity_type,
 			a.entity_id, a.field_name, a.old_value, a.new_value, a.ip_address,
 			a.user_agent, a.metadata, a.created_at,
-			u.id, u.email, u.username, u.created_at, u.updated_at
+			u.id, u.email, u.username, u.is_bot, u.created_at, u.updated_at
 		FROM activities a
 		JOIN users u ON a.user_id = u.id
 		WHERE a.project_id = $1
@@ -85,7 +85,7 @@ func (r *ActivityRepository) ListByIssueID(ctx context.Context, issueID int, lim
 			a.id, a.project_id, a.issue_id, a.user_id, a.action, a.entity_type,
 			a.entity_id, a.field_name, a.old_value, a.new_value, a.ip_address,
 			a.user_agent, a.metadata, a.created_at,
-			u.id, u.email, u.username, u.created_at, u.updated_at
+			u.id, u.email, u.username, u.is_bot, u.created_at, u.updated_at
 		FROM activities a
 		JOIN users u ON a.user_id = u.id
 		WHERE a.issue_id = $1
@@ -109,7 +109,7 @@ func (r *ActivityRepository) List(ctx context.Context, filter *models.ActivityFi
 			a.id, a.project_id, a.issue_id, a.user_id, a.action, a.entity_type,
 			a.entity_id, a.field_name, a.old_value, a.new_value, a.ip_address,
 			a.user_agent, a.metadata, a.created_at,
-			u.id, u.email, u.username, u.created_at, u.updated_at
+			u.id, u.email, u.username, u.is_bot, u.created_at, u.updated_at
 		FROM activities a
 		JOIN users u ON a.user_id = u.id
 		WHERE 1=1
@@ -197,6 +197,7 @@ func (r *ActivityRepository) scanActivities(rows *sql.Rows) ([]*models.Activity,
 			&activity.User.ID,
 			&activity.User.Email,
 			&activity.User.Username,
+			&activity.User.IsBot,
 			&activity.User.CreatedAt,
 			&activity.User.UpdatedAt,
 		)
@@ -148,6 +148,50 @@ func (r *AttachmentRepository) ListByIssueID(ctx context.Context, issueID int) (
 	return attachments, nil
 }
 
+// ListByUserID retrieves all attachments uploaded by a user
+func (r *AttachmentRepository) ListByUserID(ctx context.Context, userID int) ([]*models.Attachment, error) {
+	query := `
+		SELECT id, issue_id, user_id, storage_key, original_filename,
+		       file_size, content_type, created_at, updated_at, deleted_at
+		FROM attachments
+		WHERE user_id = $1 AND deleted_at IS NULL
+		ORDER BY created_at
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, userID)
+	if err != nil {
+		return nil, errors.NewInternalError(fmt.Sprintf("failed to list attachments for user %d", userID), err)
+	}
+	defer rows.Close()
+
+	attachments := make([]*models.Attachment, 0)
+	for rows.Next() {
+		attachment := &models.Attachment{}
+		err := rows.Scan(
+			&attachment.ID,
+			&attachment.IssueID,
+			&attachment.UserID,
+			&attachment.StorageKey,
+			&attachment.OriginalFilename,
+			&attachment.FileSize,
+			&attachment.ContentType,
+			&attachment.CreatedAt,
+			&attachment.UpdatedAt,
+			&attachment.DeletedAt,
+		)
+		if err != nil {
+			return nil, errors.NewInternalError("failed to scan attachment row", err)
+		}
+		attachments = append(attachments, attachment)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, errors.NewInternalError("error iterating attachment rows", err)
+	}
+
+	return attachments, nil
+}
+
 // Delete soft deletes an attachment
 func (r *AttachmentRepository) Delete(ctx context.Context, id int) error {
 	query := `
@@ -3,12 +3,15 @@ package repository
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
+	"errors"
 
+	"github.com/lib/pq"
 	"github.com/yourusername/issue-tracker/internal/models"
 	pkgerrors "github.com/yourusername/issue-tracker/pkg/errors"
 )
 
-// BoardRepository handles board column data access
+// BoardRepository handles board and board column data access
 type BoardRepository struct {
 	db *sql.DB
 }
@@ -18,23 +21,78 @@ func NewBoardRepository(db *sql.DB) *BoardRepository {
 	return &BoardRepository{db: db}
 }
 
+// boardColumnColumns is the column list scanned by scanBoardColumn
+// Columns mapped to statuses count the issues in those statuses, other
+// columns the issues placed in them
+const boardColumnColumns = `id, project_id, board_id, name, position, statuses, wip_limit, wip_mode,
+	(SELECT COUNT(*) FROM issues
+	 WHERE issues.project_id = board_columns.project_id AND issues.deleted_at IS NULL
+	   AND CASE WHEN cardinality(board_columns.statuses) > 0
+	       THEN issues.status = ANY(board_columns.statuses)
+	       ELSE issues.column_id = board_columns.id END) AS issue_count,
+	created_at`
+
+// scanBoardColumn scans a board column row selected with boardColumnColumns
+func scanBoardColumn(row rowScanner, column *models.BoardColumn) error {
+	var statuses pq.StringArray
+	err := row.Scan(
+		&column.ID,
+		&column.ProjectID,
+		&column.BoardID,
+		&column.Name,
+		&column.Position,
+		&statuses,
+		&column.WIPLimit,
+		&column.WIPMode,
+		&column.IssueCount,
+		&column.CreatedAt,
+	)
+	if err != nil {
+		return err
+	}
+
+	column.OverLimit = column.WIPLimit != nil && column.IssueCount > *column.WIPLimit
+
+	column.Statuses = make([]models.IssueStatus, len(statuses))
+	for i, status := range statuses {
+		column.Statuses[i] = models.IssueStatus(status)
+	}
+	return nil
+}
+
+// statusArray converts issue statuses for a TEXT[] column
+func statusArray(statuses []models.IssueStatus) pq.StringArray {
+	array := make(pq.StringArray, len(statuses))
+	for i, status := range statuses {
+		array[i] = string(status)
+	}
+	return array
+}
+
 // CreateDefaultColumns creates default columns for a project (Backlog, In Progress, Done)
+// on its default board, mapped to the statuses of the default workflow
 func (r *BoardRepository) CreateDefaultColumns(ctx context.Context, projectID int) error {
+	boardID, err := r.EnsureDefaultBoard(ctx, projectID)
+	if err != nil {
+		return err
+	}
+
 	defaultColumns := []struct {
 		name     string
 		position int
+		status   models.IssueStatus
 	}{
-		{"Backlog", 0},
-		{"In Progress", 1},
-		{"Done", 2},
+		{"Backlog", 0, models.IssueStatusOpen},
+		{"In Progress", 1, models.IssueStatusInProgress},
+		{"Done", 2, models.IssueStatusClosed},
 	}
 
 	for _, col := range defaultColumns {
 		query := `
-			INSERT INTO board_columns (project_id, name, position)
-			VALUES ($1, $2, $3)
+			INSERT INTO board_columns (project_id, board_id, name, position, statuses)
+			VALUES ($1, $2, $3, $4, $5)
 		`
-		_, err := r.db.ExecContext(ctx, query, projectID, col.name, col.position)
+		_, err := r.db.ExecContext(ctx, query, projectID, boardID, col.name, col.position, statusArray([]models.IssueStatus{col.status}))
 		if err != nil {
 			return err
 		}
@@ -43,16 +101,33 @@ func (r *BoardRepository) CreateDefaultColumns(ctx context.Context, projectID in
 	return nil
 }
 
-// ListByProjectID retrieves all columns for a project
+// ListByProjectID retrieves all columns of a project's default board
 func (r *BoardRepository) ListByProjectID(ctx context.Context, projectID int) ([]*models.BoardColumn, error) {
 	query := `
-		SELECT id, project_id, name, position, created_at
+		SELECT ` + boardColumnColumns + `
 		FROM board_columns
-		WHERE project_id = $1
+		WHERE board_id = (SELECT id FROM boards WHERE project_id = $1 AND is_default)
+		ORDER BY position ASC
+	`
+
+	return r.listColumns(ctx, query, projectID)
+}
+
+// ListByBoardID retrieves all columns of a board
+func (r *BoardRepository) ListByBoardID(ctx context.Context, boardID int) ([]*models.BoardColumn, error) {
+	query := `
+		SELECT ` + boardColumnColumns + `
+		FROM board_columns
+		WHERE board_id = $1
 		ORDER BY position ASC
 	`
 
-	rows, err := r.db.QueryContext(ctx, query, projectID)
+	return r.listColumns(ctx, query, boardID)
+}
+
+// listColumns retrieves the board columns selected by a query
+func (r *BoardRepository) listColumns(ctx context.Context, query string, args ...interface{}) ([]*models.BoardColumn, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -61,14 +136,7 @@ func (r *BoardRepository) ListByProjectID(ctx context.Context, projectID int) ([
 	columns := make([]*models.BoardColumn, 0)
 	for rows.Next() {
 		var column models.BoardColumn
-		err := rows.Scan(
-			&column.ID,
-			&column.ProjectID,
-			&column.Name,
-			&column.Position,
-			&column.CreatedAt,
-		)
-		if err != nil {
+		if err := scanBoardColumn(rows, &column); err != nil {
 			return nil, err
 		}
 		columns = append(columns, &column)
@@ -82,25 +150,37 @@ func (r *BoardRepository) ListByProjectID(ctx context.Context, projectID int) ([
 }
 
 // Create creates a new board column
+// Columns without a board are added to the project's default board
 func (r *BoardRepository) Create(ctx context.Context, column *models.BoardColumn) (*models.BoardColumn, error) {
 	query := `
-		INSERT INTO board_columns (project_id, name, position)
-		VALUES ($1, $2, $3)
-		RETURNING id, project_id, name, position, created_at
-	`
+		INSERT INTO board_columns (project_id, board_id, name, position, statuses, wip_limit, wip_mode)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING ` + boardColumnColumns
+
+	boardID := column.BoardID
+	if boardID == 0 {
+		var err error
+		boardID, err = r.EnsureDefaultBoard(ctx, column.ProjectID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	wipMode := column.WIPMode
+	if wipMode == "" {
+		wipMode = models.WIPModeSoft
+	}
 
 	var created models.BoardColumn
-	err := r.db.QueryRowContext(ctx, query,
+	err := scanBoardColumn(r.db.QueryRowContext(ctx, query,
 		column.ProjectID,
+		boardID,
 		column.Name,
 		column.Position,
-	).Scan(
-		&created.ID,
-		&created.ProjectID,
-		&created.Name,
-		&created.Position,
-		&created.CreatedAt,
-	)
+		statusArray(column.Statuses),
+		column.WIPLimit,
+		wipMode,
+	), &created)
 
 	if err != nil {
 		return nil, err
@@ -112,19 +192,37 @@ func (r *BoardRepository) Create(ctx context.Context, column *models.BoardColumn
 // GetByID retrieves a board column by ID
 func (r *BoardRepository) GetByID(ctx context.Context, id int) (*models.BoardColumn, error) {
 	query := `
-		SELECT id, project_id, name, position, created_at
+		SELECT ` + boardColumnColumns + `
 		FROM board_columns
 		WHERE id = $1
 	`
 
 	var column models.BoardColumn
-	err := r.db.QueryRowContext(ctx, query, id).Scan(
-		&column.ID,
-		&column.ProjectID,
-		&column.Name,
-		&column.Position,
-		&column.CreatedAt,
-	)
+	err := scanBoardColumn(r.db.QueryRowContext(ctx, query, id), &column)
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, pkgerrors.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return &column, nil
+}
+
+// GetByStatus retrieves the column of a board a status is mapped to
+// Returns ErrNotFound when no column maps the status
+func (r *BoardRepository) GetByStatus(ctx context.Context, boardID int, status models.IssueStatus) (*models.BoardColumn, error) {
+	query := `
+		SELECT ` + boardColumnColumns + `
+		FROM board_columns
+		WHERE board_id = $1 AND $2 = ANY(statuses)
+		ORDER BY position ASC
+		LIMIT 1
+	`
+
+	var column models.BoardColumn
+	err := scanBoardColumn(r.db.QueryRowContext(ctx, query, boardID, string(status)), &column)
 
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -140,11 +238,11 @@ func (r *BoardRepository) GetByID(ctx context.Context, id int) (*models.BoardCol
 func (r *BoardRepository) Update(ctx context.Context, column *models.BoardColumn) error {
 	query := `
 		UPDATE board_columns
-		SET name = $1, position = $2
-		WHERE id = $3
+		SET name = $1, position = $2, statuses = $3, wip_limit = $4, wip_mode = $5
+		WHERE id = $6
 	`
 
-	result, err := r.db.ExecContext(ctx, query, column.Name, column.Position, column.ID)
+	result, err := r.db.ExecContext(ctx, query, column.Name, column.Position, statusArray(column.Statuses), column.WIPLimit, column.WIPMode, column.ID)
 	if err != nil {
 		return err
 	}
@@ -181,3 +279,305 @@ func (r *BoardRepository) Delete(ctx context.Context, id int) error {
 
 	return nil
 }
+
+// CreateWIPOverride records an admin exceeding a column's WIP limit
+func (r *BoardRepository) CreateWIPOverride(ctx context.Context, override *models.WIPOverride) error {
+	query := `
+		INSERT INTO board_wip_overrides (column_id, issue_id, user_id, reason, issue_count, wip_limit)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING id, created_at
+	`
+
+	return r.db.QueryRowContext(ctx, query,
+		override.ColumnID,
+		override.IssueID,
+		override.UserID,
+		override.Reason,
+		override.IssueCount,
+		override.WIPLimit,
+	).Scan(&override.ID, &override.CreatedAt)
+}
+
+// ListWIPOverrides retrieves the WIP limit overrides of a column, newest first
+func (r *BoardRepository) ListWIPOverrides(ctx context.Context, columnID int) ([]*models.WIPOverride, error) {
+	query := `
+		SELECT o.id, o.column_id, o.issue_id, o.user_id, o.reason, o.issue_count, o.wip_limit, o.created_at,
+			u.id, u.username, u.email
+		FROM board_wip_overrides o
+		LEFT JOIN users u ON u.id = o.user_id
+		WHERE o.column_id = $1
+		ORDER BY o.created_at DESC, o.id DESC
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, columnID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	overrides := make([]*models.WIPOverride, 0)
+	for rows.Next() {
+		var override models.WIPOverride
+		var userID sql.NullInt64
+		var username, email sql.NullString
+		err := rows.Scan(
+			&override.ID,
+			&override.ColumnID,
+			&override.IssueID,
+			&override.UserID,
+			&override.Reason,
+			&override.IssueCount,
+			&override.WIPLimit,
+			&override.CreatedAt,
+			&userID,
+			&username,
+			&email,
+		)
+		if err != nil {
+			return nil, err
+		}
+		if userID.Valid {
+			override.User = &models.User{
+				ID:       int(userID.Int64),
+				Username: username.String,
+				Email:    email.String,
+			}
+		}
+		overrides = append(overrides, &override)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return overrides, nil
+}
+
+// boardColumns is the column list scanned by scanBoard
+const boardColumns = `id, project_id, name, description, is_default, filter, swimlane, created_by, created_at, updated_at`
+
+// scanBoard scans a board row selected with boardColumns
+func scanBoard(row rowScanner, board *models.Board) error {
+	var filterJSON []byte
+	err := row.Scan(
+		&board.ID,
+		&board.ProjectID,
+		&board.Name,
+		&board.Description,
+		&board.IsDefault,
+		&filterJSON,
+		&board.Swimlane,
+		&board.CreatedBy,
+		&board.CreatedAt,
+		&board.UpdatedAt,
+	)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(filterJSON, &board.Filter)
+}
+
+// EnsureDefaultBoard returns the ID of a project's default board, creating it if needed
+func (r *BoardRepository) EnsureDefaultBoard(ctx context.Context, projectID int) (int, error) {
+	_, err := r.db.ExecContext(ctx, `
+		INSERT INTO boards (project_id, name, is_default)
+		VALUES ($1, 'Board', true)
+		ON CONFLICT (project_id) WHERE is_default DO NOTHING
+	`, projectID)
+	if err != nil {
+		return 0, err
+	}
+
+	var boardID int
+	err = r.db.QueryRowContext(ctx, `
+		SELECT id FROM boards WHERE project_id = $1 AND is_default
+	`, projectID).Scan(&boardID)
+	return boardID, err
+}
+
+// CreateBoard creates a board with its columns
+func (r *BoardRepository) CreateBoard(ctx context.Context, board *models.Board, columns []*models.BoardColumn) (*models.Board, error) {
+	filterJSON, err := json.Marshal(board.Filter)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	err = tx.QueryRowContext(ctx, `
+		INSERT INTO boards (project_id, name, description, filter, swimlane, created_by)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING id
+	`, board.ProjectID, board.Name, board.Description, filterJSON, board.Swimlane, board.CreatedBy).Scan(&board.ID)
+	if err != nil {
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code == "23505" { // Board name already used in the project
+			return nil, pkgerrors.ErrConflict
+		}
+		return nil, err
+	}
+
+	for _, column := range columns {
+		wipMode := column.WIPMode
+		if wipMode == "" {
+			wipMode = models.WIPModeSoft
+		}
+		_, err := tx.ExecContext(ctx, `
+			INSERT INTO board_columns (project_id, board_id, name, position, statuses, wip_limit, wip_mode)
+			VALUES ($1, $2, $3, $4, $5, $6, $7)
+		`, board.ProjectID, board.ID, column.Name, column.Position, statusArray(column.Statuses), column.WIPLimit, wipMode)
+		if err != nil {
+			var pqErr *pq.Error
+			if errors.As(err, &pqErr) && pqErr.Code == "23505" { // Two columns at the same position
+				return nil, pkgerrors.ErrValidation
+			}
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return r.GetBoard(ctx, board.ID)
+}
+
+// GetBoard retrieves a board by ID
+func (r *BoardRepository) GetBoard(ctx context.Context, id int) (*models.Board, error) {
+	var board models.Board
+	err := scanBoard(r.db.QueryRowContext(ctx, `
+		SELECT `+boardColumns+`
+		FROM boards
+		WHERE id = $1
+	`, id), &board)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, pkgerrors.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return &board, nil
+}
+
+// GetDefaultBoard retrieves the default board of a project
+// Returns ErrNotFound when the project has no board yet
+func (r *BoardRepository) GetDefaultBoard(ctx context.Context, projectID int) (*models.Board, error) {
+	var board models.Board
+	err := scanBoard(r.db.QueryRowContext(ctx, `
+		SELECT `+boardColumns+`
+		FROM boards
+		WHERE project_id = $1 AND is_default
+	`, projectID), &board)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, pkgerrors.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return &board, nil
+}
+
+// ListBoards retrieves the boards of a project, default board first
+func (r *BoardRepository) ListBoards(ctx context.Context, projectID int) ([]*models.Board, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT `+boardColumns+`
+		FROM boards
+		WHERE project_id = $1
+		ORDER BY is_default DESC, name ASC
+	`, projectID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	boards := make([]*models.Board, 0)
+	for rows.Next() {
+		var board models.Board
+		if err := scanBoard(rows, &board); err != nil {
+			return nil, err
+		}
+		boards = append(boards, &board)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return boards, nil
+}
+
+// UpdateBoard updates a board; making it the default unsets the previous default board
+func (r *BoardRepository) UpdateBoard(ctx context.Context, board *models.Board) error {
+	filterJSON, err := json.Marshal(board.Filter)
+	if err != nil {
+		return err
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if board.IsDefault {
+		_, err := tx.ExecContext(ctx, `
+			UPDATE boards SET is_default = false, updated_at = NOW()
+			WHERE project_id = $1 AND is_default AND id <> $2
+		`, board.ProjectID, board.ID)
+		if err != nil {
+			return err
+		}
+	}
+
+	result, err := tx.ExecContext(ctx, `
+		UPDATE boards
+		SET name = $1, description = $2, filter = $3, swimlane = $4, is_default = $5, updated_at = NOW()
+		WHERE id = $6
+	`, board.Name, board.Description, filterJSON, board.Swimlane, board.IsDefault, board.ID)
+	if err != nil {
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code == "23505" { // Board name already used in the project
+			return pkgerrors.ErrConflict
+		}
+		return err
+	}
+	if err := requireRowsAffected(result); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// DeleteBoard deletes a board and its columns; issues placed in them are unplaced
+func (r *BoardRepository) DeleteBoard(ctx context.Context, id int) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(ctx, `
+		UPDATE issues SET column_id = NULL, column_position = NULL
+		WHERE column_id IN (SELECT id FROM board_columns WHERE board_id = $1)
+	`, id)
+	if err != nil {
+		return err
+	}
+
+	result, err := tx.ExecContext(ctx, `DELETE FROM boards WHERE id = $1`, id)
+	if err != nil {
+		return err
+	}
+	if err := requireRowsAffected(result); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
@@ -13,6 +13,7 @@ func setupBoardRepo(t *testing.T) (*BoardRepository, *ProjectRepository, *UserRe
 
 	// Clean up test data
 	db.Exec("DELETE FROM board_columns")
+	db.Exec("DELETE FROM boards")
 	db.Exec("DELETE FROM project_members")
 	db.Exec("DELETE FROM projects")
 	db.Exec("DELETE FROM users")
@@ -269,3 +270,132 @@ func TestBoardRepository_Update(t *testing.T) {
 		t.Errorf("Expected position 1, got %d", updated.Position)
 	}
 }
+
+func TestBoardRepository_GetByStatus(t *testing.T) {
+	boardRepo, projectRepo, userRepo, cleanup := setupBoardRepo(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	project := createTestProjectForBoard(t, projectRepo, userRepo)
+
+	if err := boardRepo.CreateDefaultColumns(ctx, project.ID); err != nil {
+		t.Fatalf("CreateDefaultColumns failed: %v", err)
+	}
+
+	board, err := boardRepo.GetDefaultBoard(ctx, project.ID)
+	if err != nil {
+		t.Fatalf("GetDefaultBoard failed: %v", err)
+	}
+
+	column, err := boardRepo.GetByStatus(ctx, board.ID, models.IssueStatusInProgress)
+	if err != nil {
+		t.Fatalf("GetByStatus failed: %v", err)
+	}
+	if column.Name != "In Progress" {
+		t.Errorf("Expected column 'In Progress', got '%s'", column.Name)
+	}
+	if len(column.Statuses) != 1 || column.Statuses[0] != models.IssueStatusInProgress {
+		t.Errorf("Expected statuses [in_progress], got %v", column.Statuses)
+	}
+
+	_, err = boardRepo.GetByStatus(ctx, board.ID, models.IssueStatus("review"))
+	if err != pkgerrors.ErrNotFound {
+		t.Errorf("Expected ErrNotFound, got %v", err)
+	}
+}
+
+func TestBoardRepository_WIPLimit(t *testing.T) {
+	boardRepo, projectRepo, userRepo, cleanup := setupBoardRepo(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	project := createTestProjectForBoard(t, projectRepo, userRepo)
+
+	limit := 2
+	created, err := boardRepo.Create(ctx, &models.BoardColumn{
+		ProjectID: project.ID,
+		Name:      "Doing",
+		Position:  0,
+		WIPLimit:  &limit,
+		WIPMode:   models.WIPModeHard,
+	})
+	if err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+
+	if created.WIPLimit == nil || *created.WIPLimit != 2 {
+		t.Errorf("Expected WIP limit 2, got %v", created.WIPLimit)
+	}
+	if created.WIPMode != models.WIPModeHard {
+		t.Errorf("Expected WIP mode 'hard', got '%s'", created.WIPMode)
+	}
+	if created.IssueCount != 0 || created.OverLimit {
+		t.Errorf("Expected empty column within its limit, got count %d (over limit: %v)", created.IssueCount, created.OverLimit)
+	}
+
+	// Columns created without a mode default to soft
+	soft, err := boardRepo.Create(ctx, &models.BoardColumn{ProjectID: project.ID, Name: "Done", Position: 1})
+	if err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+	if soft.WIPMode != models.WIPModeSoft {
+		t.Errorf("Expected WIP mode 'soft', got '%s'", soft.WIPMode)
+	}
+}
+
+func TestBoardRepository_CreateBoard(t *testing.T) {
+	boardRepo, projectRepo, userRepo, cleanup := setupBoardRepo(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	project := createTestProjectForBoard(t, projectRepo, userRepo)
+
+	if err := boardRepo.CreateDefaultColumns(ctx, project.ID); err != nil {
+		t.Fatalf("CreateDefaultColumns failed: %v", err)
+	}
+
+	board := &models.Board{
+		ProjectID: project.ID,
+		Name:      "Bugs triage",
+		Filter:    models.BoardFilter{IssueTypes: []models.IssueType{models.IssueTypeBug}},
+		Swimlane:  models.SwimlanePriority,
+	}
+	columns := []*models.BoardColumn{
+		{Name: "New", Position: 0, Statuses: []models.IssueStatus{models.IssueStatusOpen}},
+		{Name: "Fixed", Position: 1, Statuses: []models.IssueStatus{models.IssueStatusClosed}},
+	}
+
+	created, err := boardRepo.CreateBoard(ctx, board, columns)
+	if err != nil {
+		t.Fatalf("CreateBoard failed: %v", err)
+	}
+	if created.IsDefault {
+		t.Error("Expected new board not to be the default board")
+	}
+	if len(created.Filter.IssueTypes) != 1 || created.Filter.IssueTypes[0] != models.IssueTypeBug {
+		t.Errorf("Expected filter on bugs, got %v", created.Filter.IssueTypes)
+	}
+
+	found, err := boardRepo.ListByBoardID(ctx, created.ID)
+	if err != nil {
+		t.Fatalf("ListByBoardID failed: %v", err)
+	}
+	if len(found) != 2 {
+		t.Errorf("Expected 2 columns, got %d", len(found))
+	}
+
+	// The default board keeps its own columns
+	defaults, err := boardRepo.ListByProjectID(ctx, project.ID)
+	if err != nil {
+		t.Fatalf("ListByProjectID failed: %v", err)
+	}
+	if len(defaults) != 3 {
+		t.Errorf("Expected 3 default board columns, got %d", len(defaults))
+	}
+
+	// Board names are unique per project
+	_, err = boardRepo.CreateBoard(ctx, &models.Board{ProjectID: project.ID, Name: "Bugs triage", Swimlane: models.SwimlaneNone}, nil)
+	if err != pkgerrors.ErrConflict {
+		t.Errorf("Expected ErrConflict, got %v", err)
+	}
+}
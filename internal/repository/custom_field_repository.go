@@ -0,0 +1,268 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"sort"
+
+	"github.com/lib/pq"
+	"github.com/yourusername/issue-tracker/internal/models"
+	pkgerrors "github.com/yourusername/issue-tracker/pkg/errors"
+)
+
+// CustomFieldRepository handles custom field data access
+type CustomFieldRepository struct {
+	db *sql.DB
+}
+
+// NewCustomFieldRepository creates a new custom field repository
+func NewCustomFieldRepository(db *sql.DB) *CustomFieldRepository {
+	return &CustomFieldRepository{db: db}
+}
+
+// customFieldColumns lists the custom field columns read by scanCustomField, in order
+const customFieldColumns = `id, project_id, key, name, field_type, options, issue_types, required, position, created_at, updated_at`
+
+// scanCustomField scans a row selected with customFieldColumns into a field
+func scanCustomField(row rowScanner, field *models.CustomField) error {
+	var options, issueTypes pq.StringArray
+	err := row.Scan(
+		&field.ID,
+		&field.ProjectID,
+		&field.Key,
+		&field.Name,
+		&field.FieldType,
+		&options,
+		&issueTypes,
+		&field.Required,
+		&field.Position,
+		&field.CreatedAt,
+		&field.UpdatedAt,
+	)
+	if err != nil {
+		return err
+	}
+
+	field.Options = []string(options)
+	field.IssueTypes = make([]models.IssueType, len(issueTypes))
+	for i, issueType := range issueTypes {
+		field.IssueTypes[i] = models.IssueType(issueType)
+	}
+	return nil
+}
+
+// issueTypeArray converts issue types for storage
+func issueTypeArray(issueTypes []models.IssueType) pq.StringArray {
+	types := make(pq.StringArray, len(issueTypes))
+	for i, issueType := range issueTypes {
+		types[i] = string(issueType)
+	}
+	return types
+}
+
+// Create creates a custom field
+// Returns ErrConflict when the project already has a field with the key
+func (r *CustomFieldRepository) Create(ctx context.Context, field *models.CustomField) (*models.CustomField, error) {
+	query := `
+		INSERT INTO custom_fields (project_id, key, name, field_type, options, issue_types, required, position)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		RETURNING ` + customFieldColumns
+
+	var created models.CustomField
+	err := scanCustomField(r.db.QueryRowContext(ctx, query,
+		field.ProjectID,
+		field.Key,
+		field.Name,
+		field.FieldType,
+		pq.StringArray(field.Options),
+		issueTypeArray(field.IssueTypes),
+		field.Required,
+		field.Position,
+	), &created)
+	if err != nil {
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code == "23505" {
+			return nil, pkgerrors.ErrConflict
+		}
+		return nil, err
+	}
+
+	return &created, nil
+}
+
+// GetByID retrieves a custom field by ID
+func (r *CustomFieldRepository) GetByID(ctx context.Context, id int) (*models.CustomField, error) {
+	query := `SELECT ` + customFieldColumns + ` FROM custom_fields WHERE id = $1`
+
+	var field models.CustomField
+	if err := scanCustomField(r.db.QueryRowContext(ctx, query, id), &field); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, pkgerrors.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return &field, nil
+}
+
+// ListByProjectID lists the custom fields of a project in display order
+func (r *CustomFieldRepository) ListByProjectID(ctx context.Context, projectID int) ([]*models.CustomField, error) {
+	query := `
+		SELECT ` + customFieldColumns + `
+		FROM custom_fields
+		WHERE project_id = $1
+		ORDER BY position, id
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, projectID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	fields := make([]*models.CustomField, 0)
+	for rows.Next() {
+		var field models.CustomField
+		if err := scanCustomField(rows, &field); err != nil {
+			return nil, err
+		}
+		fields = append(fields, &field)
+	}
+
+	return fields, rows.Err()
+}
+
+// Update updates a custom field
+func (r *CustomFieldRepository) Update(ctx context.Context, field *models.CustomField) error {
+	result, err := r.db.ExecContext(ctx, `
+		UPDATE custom_fields
+		SET name = $1, options = $2, issue_types = $3, required = $4, position = $5, updated_at = NOW()
+		WHERE id = $6
+	`, field.Name, pq.StringArray(field.Options), issueTypeArray(field.IssueTypes), field.Required, field.Position, field.ID)
+	if err != nil {
+		return err
+	}
+
+	return requireRowsAffected(result)
+}
+
+// Delete deletes a custom field with its values
+func (r *CustomFieldRepository) Delete(ctx context.Context, id int) error {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM custom_fields WHERE id = $1`, id)
+	if err != nil {
+		return err
+	}
+
+	return requireRowsAffected(result)
+}
+
+// GetValues returns the custom field values of an issue by field ID
+func (r *CustomFieldRepository) GetValues(ctx context.Context, issueID int) (map[int]json.RawMessage, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT field_id, value FROM issue_custom_field_values WHERE issue_id = $1
+	`, issueID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	values := make(map[int]json.RawMessage)
+	for rows.Next() {
+		var fieldID int
+		var value []byte
+		if err := rows.Scan(&fieldID, &value); err != nil {
+			return nil, err
+		}
+		values[fieldID] = json.RawMessage(value)
+	}
+
+	return values, rows.Err()
+}
+
+// ListValuesByIssueIDs returns the custom field values of issues, keyed by
+// issue ID and then field key
+func (r *CustomFieldRepository) ListValuesByIssueIDs(ctx context.Context, issueIDs []int) (map[int]models.CustomFieldValues, error) {
+	values := make(map[int]models.CustomFieldValues)
+	if len(issueIDs) == 0 {
+		return values, nil
+	}
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT v.issue_id, f.key, v.value
+		FROM issue_custom_field_values v
+		JOIN custom_fields f ON f.id = v.field_id
+		WHERE v.issue_id = ANY($1)
+	`, pq.Array(issueIDs))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var issueID int
+		var key string
+		var value []byte
+		if err := rows.Scan(&issueID, &key, &value); err != nil {
+			return nil, err
+		}
+		if values[issueID] == nil {
+			values[issueID] = make(models.CustomFieldValues)
+		}
+		values[issueID][key] = json.RawMessage(value)
+	}
+
+	return values, rows.Err()
+}
+
+// SetValue sets the value of a custom field on an issue
+func (r *CustomFieldRepository) SetValue(ctx context.Context, issueID, fieldID int, value json.RawMessage) error {
+	return writeCustomFieldValue(ctx, r.db, issueID, fieldID, value)
+}
+
+// DeleteValue clears the value of a custom field on an issue
+func (r *CustomFieldRepository) DeleteValue(ctx context.Context, issueID, fieldID int) error {
+	return writeCustomFieldValue(ctx, r.db, issueID, fieldID, nil)
+}
+
+// writeCustomFieldValue sets the value of a custom field on an issue, or
+// clears it when value is nil
+func writeCustomFieldValue(ctx context.Context, q execer, issueID, fieldID int, value json.RawMessage) error {
+	if value == nil {
+		_, err := q.ExecContext(ctx, `
+			DELETE FROM issue_custom_field_values WHERE issue_id = $1 AND field_id = $2
+		`, issueID, fieldID)
+		return err
+	}
+
+	_, err := q.ExecContext(ctx, `
+		INSERT INTO issue_custom_field_values (issue_id, field_id, value)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (issue_id, field_id) DO UPDATE SET value = EXCLUDED.value, updated_at = NOW()
+	`, issueID, fieldID, []byte(value))
+	return err
+}
+
+// customFieldMatch returns a condition matching issues (aliased by prefix,
+// e.g. "i.") whose custom field with the key placeholder has the value
+// placeholder; multi select values match any chosen option
+func customFieldMatch(prefix, keyParam, valueParam string) string {
+	return `EXISTS (
+		SELECT 1
+		FROM issue_custom_field_values cfv
+		JOIN custom_fields cf ON cf.id = cfv.field_id
+		WHERE cfv.issue_id = ` + prefix + `id AND cf.project_id = ` + prefix + `project_id AND cf.key = ` + keyParam + `
+		  AND (cfv.value #>> '{}' = ` + valueParam + ` OR (jsonb_typeof(cfv.value) = 'array' AND cfv.value ? ` + valueParam + `))
+	)`
+}
+
+// sortedKeys returns the keys of a string map in order, for stable queries
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
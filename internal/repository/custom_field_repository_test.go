@@ -0,0 +1,176 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/yourusername/issue-tracker/internal/models"
+	pkgerrors "github.com/yourusername/issue-tracker/pkg/errors"
+)
+
+func TestCustomFieldRepository(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	cleanup := func() {
+		db.Exec("DELETE FROM issues WHERE project_id IN (SELECT id FROM projects WHERE key = 'CFT')")
+		db.Exec("DELETE FROM projects WHERE key = 'CFT'")
+		db.Exec("DELETE FROM users WHERE email = 'cftest@example.com'")
+	}
+	cleanup()
+	defer cleanup()
+
+	fieldRepo := NewCustomFieldRepository(db)
+	issueRepo := NewIssueRepository(db)
+	ctx := context.Background()
+
+	user, err := NewUserRepository(db).Create(ctx, &models.User{
+		Email:        "cftest@example.com",
+		Username:     "cftest",
+		PasswordHash: "hash",
+	})
+	if err != nil {
+		t.Fatalf("Failed to create user: %v", err)
+	}
+	project, err := NewProjectRepository(db).Create(ctx, &models.Project{Name: "Custom Field Project", Key: "CFT", OwnerID: user.ID})
+	if err != nil {
+		t.Fatalf("Failed to create project: %v", err)
+	}
+
+	createIssue := func(title string) *models.Issue {
+		issue, err := issueRepo.Create(ctx, &models.Issue{
+			ProjectID:      project.ID,
+			Title:          title,
+			Status:         models.IssueStatusOpen,
+			StatusCategory: models.StatusCategoryTodo,
+			Priority:       models.PriorityMedium,
+			IssueType:      models.IssueTypeBug,
+			ReporterID:     user.ID,
+		})
+		if err != nil {
+			t.Fatalf("Failed to create issue: %v", err)
+		}
+		return issue
+	}
+
+	severity, err := fieldRepo.Create(ctx, &models.CustomField{
+		ProjectID:  project.ID,
+		Key:        "severity",
+		Name:       "Severity",
+		FieldType:  models.CustomFieldSingleSelect,
+		Options:    []string{"S1", "S2", "S3"},
+		IssueTypes: []models.IssueType{models.IssueTypeBug},
+		Required:   true,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create field: %v", err)
+	}
+	versions, err := fieldRepo.Create(ctx, &models.CustomField{
+		ProjectID: project.ID,
+		Key:       "affected_versions",
+		Name:      "Affected versions",
+		FieldType: models.CustomFieldMultiSelect,
+		Options:   []string{"2.0", "2.1"},
+		Position:  1,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create field: %v", err)
+	}
+
+	t.Run("should reject a duplicate key", func(t *testing.T) {
+		_, err := fieldRepo.Create(ctx, &models.CustomField{
+			ProjectID: project.ID,
+			Key:       "severity",
+			Name:      "Severity again",
+			FieldType: models.CustomFieldText,
+		})
+		if err != pkgerrors.ErrConflict {
+			t.Errorf("Expected ErrConflict, got %v", err)
+		}
+	})
+
+	t.Run("should list fields in position order", func(t *testing.T) {
+		fields, err := fieldRepo.ListByProjectID(ctx, project.ID)
+		if err != nil {
+			t.Fatalf("Failed to list fields: %v", err)
+		}
+		if len(fields) != 2 || fields[0].ID != severity.ID || fields[1].ID != versions.ID {
+			t.Fatalf("Expected severity then affected_versions, got %+v", fields)
+		}
+		if !fields[0].AppliesTo(models.IssueTypeBug) || fields[0].AppliesTo(models.IssueTypeFeature) {
+			t.Errorf("Expected severity to apply to bugs only, got %v", fields[0].IssueTypes)
+		}
+	})
+
+	crash := createIssue("Crash on save")
+	typo := createIssue("Typo in footer")
+
+	if err := fieldRepo.SetValue(ctx, crash.ID, severity.ID, json.RawMessage(`"S1"`)); err != nil {
+		t.Fatalf("Failed to set value: %v", err)
+	}
+	if err := fieldRepo.SetValue(ctx, crash.ID, versions.ID, json.RawMessage(`["2.0","2.1"]`)); err != nil {
+		t.Fatalf("Failed to set value: %v", err)
+	}
+	if err := fieldRepo.SetValue(ctx, typo.ID, severity.ID, json.RawMessage(`"S3"`)); err != nil {
+		t.Fatalf("Failed to set value: %v", err)
+	}
+
+	t.Run("should return values by field key", func(t *testing.T) {
+		values, err := fieldRepo.ListValuesByIssueIDs(ctx, []int{crash.ID, typo.ID})
+		if err != nil {
+			t.Fatalf("Failed to list values: %v", err)
+		}
+		if string(values[crash.ID]["severity"]) != `"S1"` {
+			t.Errorf("Expected severity S1, got %s", values[crash.ID]["severity"])
+		}
+		if _, ok := values[typo.ID]["affected_versions"]; ok {
+			t.Errorf("Expected no affected_versions on %s", typo.Title)
+		}
+	})
+
+	t.Run("should filter issues by custom field", func(t *testing.T) {
+		issues, err := issueRepo.List(ctx, &models.IssueFilter{
+			ProjectID:    project.ID,
+			CustomFields: map[string]string{"severity": "S1"},
+		})
+		if err != nil {
+			t.Fatalf("Failed to list issues: %v", err)
+		}
+		if len(issues) != 1 || issues[0].ID != crash.ID {
+			t.Errorf("Expected only %q, got %d issues", crash.Title, len(issues))
+		}
+
+		issues, err = issueRepo.List(ctx, &models.IssueFilter{
+			ProjectID:    project.ID,
+			CustomFields: map[string]string{"affected_versions": "2.1"},
+		})
+		if err != nil {
+			t.Fatalf("Failed to list issues: %v", err)
+		}
+		if len(issues) != 1 || issues[0].ID != crash.ID {
+			t.Errorf("Expected multi select to match one option, got %d issues", len(issues))
+		}
+	})
+
+	t.Run("should delete values with the field", func(t *testing.T) {
+		if err := fieldRepo.DeleteValue(ctx, typo.ID, severity.ID); err != nil {
+			t.Fatalf("Failed to delete value: %v", err)
+		}
+		if err := fieldRepo.Delete(ctx, versions.ID); err != nil {
+			t.Fatalf("Failed to delete field: %v", err)
+		}
+
+		values, err := fieldRepo.GetValues(ctx, crash.ID)
+		if err != nil {
+			t.Fatalf("Failed to get values: %v", err)
+		}
+		if len(values) != 1 {
+			t.Errorf("Expected only the severity value, got %d values", len(values))
+		}
+
+		if _, err := fieldRepo.GetByID(ctx, versions.ID); err != pkgerrors.ErrNotFound {
+			t.Errorf("Expected ErrNotFound, got %v", err)
+		}
+	})
+}
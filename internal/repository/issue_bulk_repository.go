@@ -0,0 +1,101 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+
+	"github.com/yourusername/issue-tracker/internal/models"
+	pkgerrors "github.com/yourusername/issue-tracker/pkg/errors"
+)
+
+// IssueBulkRepository handles background bulk change jobs
+type IssueBulkRepository struct {
+	db *sql.DB
+}
+
+// NewIssueBulkRepository creates a new issue bulk repository
+func NewIssueBulkRepository(db *sql.DB) *IssueBulkRepository {
+	return &IssueBulkRepository{db: db}
+}
+
+// bulkJobColumns lists the columns read by scanBulkJob, in order
+const bulkJobColumns = `id, project_id, user_id, status, total_issues, processed_issues,
+			result, created_at, completed_at`
+
+// scanBulkJob scans a row selected with bulkJobColumns
+func scanBulkJob(row rowScanner) (*models.IssueBulkJob, error) {
+	var job models.IssueBulkJob
+	var result []byte
+	err := row.Scan(
+		&job.ID,
+		&job.ProjectID,
+		&job.UserID,
+		&job.Status,
+		&job.TotalIssues,
+		&job.ProcessedIssues,
+		&result,
+		&job.CreatedAt,
+		&job.CompletedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if result != nil {
+		if err := json.Unmarshal(result, &job.Result); err != nil {
+			return nil, err
+		}
+	}
+	return &job, nil
+}
+
+// CreateJob creates a pending bulk job
+func (r *IssueBulkRepository) CreateJob(ctx context.Context, job *models.IssueBulkJob) (*models.IssueBulkJob, error) {
+	query := `
+		INSERT INTO issue_bulk_jobs (project_id, user_id, status, total_issues)
+		VALUES ($1, $2, $3, $4)
+		RETURNING ` + bulkJobColumns
+
+	return scanBulkJob(r.db.QueryRowContext(ctx, query, job.ProjectID, job.UserID, models.BulkJobStatusPending, job.TotalIssues))
+}
+
+// GetJob retrieves a bulk job started by a user
+func (r *IssueBulkRepository) GetJob(ctx context.Context, userID, jobID int) (*models.IssueBulkJob, error) {
+	query := `SELECT ` + bulkJobColumns + ` FROM issue_bulk_jobs WHERE id = $1 AND user_id = $2`
+
+	job, err := scanBulkJob(r.db.QueryRowContext(ctx, query, jobID, userID))
+	if err == sql.ErrNoRows {
+		return nil, pkgerrors.ErrNotFound
+	}
+	return job, err
+}
+
+// StartJob marks a bulk job as processing
+func (r *IssueBulkRepository) StartJob(ctx context.Context, jobID int) error {
+	_, err := r.db.ExecContext(ctx, `UPDATE issue_bulk_jobs SET status = $2 WHERE id = $1`, jobID, models.BulkJobStatusProcessing)
+	return err
+}
+
+// UpdateJobProgress records how many issues a running bulk job has processed
+func (r *IssueBulkRepository) UpdateJobProgress(ctx context.Context, jobID int, processed int) error {
+	_, err := r.db.ExecContext(ctx, `UPDATE issue_bulk_jobs SET processed_issues = $2 WHERE id = $1`, jobID, processed)
+	return err
+}
+
+// CompleteJob stores the per-issue outcomes of a finished bulk job
+func (r *IssueBulkRepository) CompleteJob(ctx context.Context, jobID int, result *models.BulkIssueResult) error {
+	data, err := json.Marshal(result)
+	if err != nil {
+		return err
+	}
+
+	query := `
+		UPDATE issue_bulk_jobs
+		SET status = $2, result = $3, processed_issues = total_issues, completed_at = NOW()
+		WHERE id = $1
+	`
+
+	_, err = r.db.ExecContext(ctx, query, jobID, models.BulkJobStatusCompleted, data)
+	return err
+}
@@ -0,0 +1,86 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/yourusername/issue-tracker/internal/models"
+	pkgerrors "github.com/yourusername/issue-tracker/pkg/errors"
+)
+
+func TestIssueBulkRepository(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	cleanup := func() {
+		db.Exec("DELETE FROM projects WHERE key = 'BLK'")
+		db.Exec("DELETE FROM users WHERE email = 'bulktest@example.com'")
+	}
+	cleanup()
+	defer cleanup()
+
+	bulkRepo := NewIssueBulkRepository(db)
+	ctx := context.Background()
+
+	user, err := NewUserRepository(db).Create(ctx, &models.User{
+		Email:        "bulktest@example.com",
+		Username:     "bulktest",
+		PasswordHash: "hash",
+	})
+	if err != nil {
+		t.Fatalf("Failed to create user: %v", err)
+	}
+	project, err := NewProjectRepository(db).Create(ctx, &models.Project{Name: "Bulk Project", Key: "BLK", OwnerID: user.ID})
+	if err != nil {
+		t.Fatalf("Failed to create project: %v", err)
+	}
+
+	job, err := bulkRepo.CreateJob(ctx, &models.IssueBulkJob{ProjectID: project.ID, UserID: user.ID, TotalIssues: 2})
+	if err != nil {
+		t.Fatalf("Failed to create job: %v", err)
+	}
+
+	t.Run("should track progress", func(t *testing.T) {
+		if job.Status != models.BulkJobStatusPending || job.Result != nil {
+			t.Errorf("Expected pending job without result, got %s", job.Status)
+		}
+
+		_ = bulkRepo.StartJob(ctx, job.ID)
+		_ = bulkRepo.UpdateJobProgress(ctx, job.ID, 1)
+
+		running, err := bulkRepo.GetJob(ctx, user.ID, job.ID)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if running.Status != models.BulkJobStatusProcessing || running.ProcessedIssues != 1 {
+			t.Errorf("Expected processing job at 1, got %s at %d", running.Status, running.ProcessedIssues)
+		}
+	})
+
+	t.Run("should store the per-issue results", func(t *testing.T) {
+		result := &models.BulkIssueResult{ProjectID: project.ID, Changes: []string{"priority"}, Total: 2}
+		result.Add(&models.BulkIssueItemResult{IssueID: 1, IssueNumber: 1, Outcome: models.BulkOutcomeUpdated, Version: 2})
+		result.Add(&models.BulkIssueItemResult{IssueID: 2, IssueNumber: 2, Outcome: models.BulkOutcomeConflict, Version: 5})
+
+		if err := bulkRepo.CompleteJob(ctx, job.ID, result); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		completed, err := bulkRepo.GetJob(ctx, user.ID, job.ID)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if completed.Status != models.BulkJobStatusCompleted || completed.ProcessedIssues != 2 {
+			t.Errorf("Expected completed job at 2, got %s at %d", completed.Status, completed.ProcessedIssues)
+		}
+		if completed.Result == nil || completed.Result.Succeeded != 1 || completed.Result.Conflicts != 1 || len(completed.Result.Results) != 2 {
+			t.Errorf("Expected one update and one conflict, got %+v", completed.Result)
+		}
+	})
+
+	t.Run("should hide jobs of other users", func(t *testing.T) {
+		if _, err := bulkRepo.GetJob(ctx, user.ID+1, job.ID); err != pkgerrors.ErrNotFound {
+			t.Errorf("Expected ErrNotFound, got %v", err)
+		}
+	})
+}
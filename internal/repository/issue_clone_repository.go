@@ -0,0 +1,208 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/yourusername/issue-tracker/internal/models"
+	pkgerrors "github.com/yourusername/issue-tracker/pkg/errors"
+)
+
+// IssueCloneRepository handles issue clones and background clone jobs
+type IssueCloneRepository struct {
+	db *sql.DB
+}
+
+// NewIssueCloneRepository creates a new issue clone repository
+func NewIssueCloneRepository(db *sql.DB) *IssueCloneRepository {
+	return &IssueCloneRepository{db: db}
+}
+
+// CloneIssues creates the clones in one transaction, in order, so parents
+// and epics must come before the issues that point to them
+// Custom field values are copied to the target field with the same key and
+// type; labels are matched by name in the target project. progress, when
+// set, is called with the number of issues cloned so far
+func (r *IssueCloneRepository) CloneIssues(ctx context.Context, clones []*models.IssueClone, req *models.CloneIssueRequest, userID int, progress func(cloned int)) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	type statement struct {
+		query string
+		args  []interface{}
+	}
+
+	cloneIDs := make(map[int]int, len(clones))
+	for i, clone := range clones {
+		issue := clone.Issue
+		if issue.ParentIssueID != nil {
+			if id, ok := cloneIDs[*issue.ParentIssueID]; ok {
+				issue.ParentIssueID = &id
+			}
+		}
+		if issue.EpicID != nil {
+			if id, ok := cloneIDs[*issue.EpicID]; ok {
+				issue.EpicID = &id
+			}
+		}
+
+		created, err := insertIssue(ctx, tx, issue)
+		if err != nil {
+			return err
+		}
+		cloneIDs[clone.SourceID] = created.ID
+		clone.Issue = created
+
+		statements := []statement{
+			{`
+				INSERT INTO issue_custom_field_values (issue_id, field_id, value)
+				SELECT $1, target.id, v.value
+				FROM issue_custom_field_values v
+				JOIN custom_fields source ON source.id = v.field_id
+				JOIN custom_fields target ON target.project_id = $3 AND target.key = source.key AND target.field_type = source.field_type
+				WHERE v.issue_id = $2
+			`, []interface{}{created.ID, clone.SourceID, created.ProjectID}},
+			{`
+				INSERT INTO issue_assignees (issue_id, user_id)
+				SELECT $1, user_id
+				FROM issue_assignees
+				WHERE issue_id = $2
+				ON CONFLICT DO NOTHING
+			`, []interface{}{created.ID, clone.SourceID}},
+		}
+		if req.IncludeLabels {
+			statements = append(statements, statement{`
+				INSERT INTO issue_labels (issue_id, label_id)
+				SELECT $1, target.id
+				FROM issue_labels il
+				JOIN labels source ON source.id = il.label_id
+				JOIN labels target ON target.project_id = $3 AND target.name = source.name
+				WHERE il.issue_id = $2
+				ON CONFLICT DO NOTHING
+			`, []interface{}{created.ID, clone.SourceID, created.ProjectID}})
+		}
+		if req.IncludeTasklist {
+			statements = append(statements, statement{`
+				INSERT INTO tasklist_items (issue_id, content, position)
+				SELECT $1, content, position
+				FROM tasklist_items
+				WHERE issue_id = $2
+			`, []interface{}{created.ID, clone.SourceID}})
+		}
+		if req.LinkToSource {
+			statements = append(statements, statement{`
+				INSERT INTO issue_links (source_issue_id, target_issue_id, link_type, created_by)
+				VALUES ($1, $2, $3, $4)
+			`, []interface{}{created.ID, clone.SourceID, models.LinkTypeClones, userID}})
+		}
+		for _, attachment := range clone.Attachments {
+			statements = append(statements, statement{`
+				INSERT INTO attachments (issue_id, user_id, storage_key, original_filename, file_size, content_type)
+				VALUES ($1, $2, $3, $4, $5, $6)
+			`, []interface{}{created.ID, attachment.UserID, attachment.StorageKey, attachment.OriginalFilename, attachment.FileSize, attachment.ContentType}})
+		}
+		for _, stmt := range statements {
+			if _, err := tx.ExecContext(ctx, stmt.query, stmt.args...); err != nil {
+				return err
+			}
+		}
+
+		if progress != nil {
+			progress(i + 1)
+		}
+	}
+
+	return tx.Commit()
+}
+
+// cloneJobColumns lists the columns read by scanCloneJob, in order
+const cloneJobColumns = `id, source_issue_id, target_project_id, user_id, status, total_issues, cloned_issues,
+			cloned_issue_id, error_message, created_at, completed_at`
+
+// scanCloneJob scans a row selected with cloneJobColumns
+func scanCloneJob(row rowScanner) (*models.IssueCloneJob, error) {
+	var job models.IssueCloneJob
+	err := row.Scan(
+		&job.ID,
+		&job.SourceIssueID,
+		&job.TargetProjectID,
+		&job.UserID,
+		&job.Status,
+		&job.TotalIssues,
+		&job.ClonedIssues,
+		&job.ClonedIssueID,
+		&job.ErrorMessage,
+		&job.CreatedAt,
+		&job.CompletedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &job, nil
+}
+
+// CreateJob creates a pending clone job
+func (r *IssueCloneRepository) CreateJob(ctx context.Context, job *models.IssueCloneJob) (*models.IssueCloneJob, error) {
+	query := `
+		INSERT INTO issue_clone_jobs (source_issue_id, target_project_id, user_id, status, total_issues)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING ` + cloneJobColumns
+
+	return scanCloneJob(r.db.QueryRowContext(ctx, query,
+		job.SourceIssueID,
+		job.TargetProjectID,
+		job.UserID,
+		models.CloneJobStatusPending,
+		job.TotalIssues,
+	))
+}
+
+// GetJob retrieves a clone job started by a user
+func (r *IssueCloneRepository) GetJob(ctx context.Context, userID, jobID int) (*models.IssueCloneJob, error) {
+	query := `SELECT ` + cloneJobColumns + ` FROM issue_clone_jobs WHERE id = $1 AND user_id = $2`
+
+	job, err := scanCloneJob(r.db.QueryRowContext(ctx, query, jobID, userID))
+	if err == sql.ErrNoRows {
+		return nil, pkgerrors.ErrNotFound
+	}
+	return job, err
+}
+
+// StartJob marks a clone job as processing
+func (r *IssueCloneRepository) StartJob(ctx context.Context, jobID int) error {
+	_, err := r.db.ExecContext(ctx, `UPDATE issue_clone_jobs SET status = $2 WHERE id = $1`, jobID, models.CloneJobStatusProcessing)
+	return err
+}
+
+// UpdateJobProgress records how many issues a running clone job has cloned
+func (r *IssueCloneRepository) UpdateJobProgress(ctx context.Context, jobID int, cloned int) error {
+	_, err := r.db.ExecContext(ctx, `UPDATE issue_clone_jobs SET cloned_issues = $2 WHERE id = $1`, jobID, cloned)
+	return err
+}
+
+// CompleteJob records the clone of the source issue of a finished job
+func (r *IssueCloneRepository) CompleteJob(ctx context.Context, jobID int, clonedIssueID int) error {
+	query := `
+		UPDATE issue_clone_jobs
+		SET status = $2, cloned_issue_id = $3, cloned_issues = total_issues, completed_at = NOW()
+		WHERE id = $1
+	`
+
+	_, err := r.db.ExecContext(ctx, query, jobID, models.CloneJobStatusCompleted, clonedIssueID)
+	return err
+}
+
+// FailJob marks a clone job as failed; its clones were rolled back
+func (r *IssueCloneRepository) FailJob(ctx context.Context, jobID int, message string) error {
+	query := `
+		UPDATE issue_clone_jobs
+		SET status = $2, cloned_issues = 0, error_message = $3, completed_at = NOW()
+		WHERE id = $1
+	`
+
+	_, err := r.db.ExecContext(ctx, query, jobID, models.CloneJobStatusFailed, message)
+	return err
+}
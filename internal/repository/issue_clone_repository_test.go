@@ -0,0 +1,178 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/yourusername/issue-tracker/internal/models"
+	pkgerrors "github.com/yourusername/issue-tracker/pkg/errors"
+)
+
+func TestIssueCloneRepository(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	cleanup := func() {
+		db.Exec("DELETE FROM issue_clone_jobs WHERE target_project_id IN (SELECT id FROM projects WHERE key IN ('CLN', 'CLN2'))")
+		db.Exec("DELETE FROM issues WHERE project_id IN (SELECT id FROM projects WHERE key IN ('CLN', 'CLN2'))")
+		db.Exec("DELETE FROM projects WHERE key IN ('CLN', 'CLN2')")
+		db.Exec("DELETE FROM users WHERE email = 'clonetest@example.com'")
+	}
+	cleanup()
+	defer cleanup()
+
+	cloneRepo := NewIssueCloneRepository(db)
+	issueRepo := NewIssueRepository(db)
+	labelRepo := NewLabelRepository(db)
+	tasklistRepo := NewTasklistRepository(db)
+	ctx := context.Background()
+
+	user, err := NewUserRepository(db).Create(ctx, &models.User{
+		Email:        "clonetest@example.com",
+		Username:     "clonetest",
+		PasswordHash: "hash",
+	})
+	if err != nil {
+		t.Fatalf("Failed to create user: %v", err)
+	}
+	projectRepo := NewProjectRepository(db)
+	source, err := projectRepo.Create(ctx, &models.Project{Name: "Clone Source", Key: "CLN", OwnerID: user.ID})
+	if err != nil {
+		t.Fatalf("Failed to create project: %v", err)
+	}
+	target, err := projectRepo.Create(ctx, &models.Project{Name: "Clone Target", Key: "CLN2", OwnerID: user.ID})
+	if err != nil {
+		t.Fatalf("Failed to create project: %v", err)
+	}
+
+	newIssue := func(projectID int, title string, issueType models.IssueType, parentID *int) *models.Issue {
+		return &models.Issue{
+			ProjectID:      projectID,
+			Title:          title,
+			Status:         models.IssueStatusOpen,
+			StatusCategory: models.StatusCategoryTodo,
+			Priority:       models.PriorityMedium,
+			IssueType:      issueType,
+			ParentIssueID:  parentID,
+			ReporterID:     user.ID,
+		}
+	}
+	parent, err := issueRepo.Create(ctx, newIssue(source.ID, "Release checklist", models.IssueTypeTask, nil))
+	if err != nil {
+		t.Fatalf("Failed to create issue: %v", err)
+	}
+	subtask, err := issueRepo.Create(ctx, newIssue(source.ID, "Tag the release", models.IssueTypeSubtask, &parent.ID))
+	if err != nil {
+		t.Fatalf("Failed to create issue: %v", err)
+	}
+
+	sourceLabel, _ := labelRepo.Create(ctx, &models.Label{ProjectID: source.ID, Name: "release", Color: "#00ff00"})
+	targetLabel, _ := labelRepo.Create(ctx, &models.Label{ProjectID: target.ID, Name: "release", Color: "#0000ff"})
+	_ = labelRepo.AddToIssue(ctx, parent.ID, sourceLabel.ID)
+	_, _ = tasklistRepo.Create(ctx, &models.TasklistItem{IssueID: parent.ID, Content: "Update changelog"})
+
+	t.Run("should clone an issue tree into another project", func(t *testing.T) {
+		clones := []*models.IssueClone{
+			{SourceID: parent.ID, Issue: newIssue(target.ID, parent.Title, models.IssueTypeTask, nil)},
+			{SourceID: subtask.ID, Issue: newIssue(target.ID, subtask.Title, models.IssueTypeSubtask, &parent.ID)},
+		}
+		req := &models.CloneIssueRequest{IncludeLabels: true, IncludeTasklist: true, LinkToSource: true}
+
+		var progress []int
+		err := cloneRepo.CloneIssues(ctx, clones, req, user.ID, func(cloned int) { progress = append(progress, cloned) })
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if len(progress) != 2 || progress[1] != 2 {
+			t.Errorf("Expected progress [1 2], got %v", progress)
+		}
+
+		parentClone, subtaskClone := clones[0].Issue, clones[1].Issue
+		if parentClone.ProjectID != target.ID {
+			t.Errorf("Expected clone in project %d, got %d", target.ID, parentClone.ProjectID)
+		}
+		if subtaskClone.ParentIssueID == nil || *subtaskClone.ParentIssueID != parentClone.ID {
+			t.Errorf("Expected subtask clone under %d, got %v", parentClone.ID, subtaskClone.ParentIssueID)
+		}
+
+		labels, err := labelRepo.ListByIssueID(ctx, parentClone.ID)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if len(labels) != 1 || labels[0].ID != targetLabel.ID {
+			t.Errorf("Expected the target project's release label, got %v", labels)
+		}
+
+		items, err := tasklistRepo.ListByIssueID(ctx, parentClone.ID)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if len(items) != 1 || items[0].Content != "Update changelog" || items[0].IsCompleted {
+			t.Errorf("Expected one unchecked tasklist item, got %v", items)
+		}
+
+		links, err := NewIssueLinkRepository(db).ListByIssueID(ctx, parentClone.ID)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if len(links) != 1 || links[0].LinkType != models.LinkTypeClones || links[0].TargetIssueID != parent.ID {
+			t.Errorf("Expected a clones link to the source, got %v", links)
+		}
+	})
+
+	t.Run("should roll back a failed clone", func(t *testing.T) {
+		clones := []*models.IssueClone{
+			{SourceID: parent.ID, Issue: newIssue(target.ID, "Rolled back", models.IssueTypeTask, nil)},
+			{SourceID: subtask.ID, Issue: newIssue(target.ID, "Invalid", "not-a-type", nil)},
+		}
+
+		if err := cloneRepo.CloneIssues(ctx, clones, &models.CloneIssueRequest{}, user.ID, nil); err == nil {
+			t.Fatal("Expected an error")
+		}
+
+		issues, err := issueRepo.List(ctx, &models.IssueFilter{ProjectID: target.ID})
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		for _, issue := range issues {
+			if issue.Title == "Rolled back" {
+				t.Error("Expected no issues of the failed clone")
+			}
+		}
+	})
+
+	t.Run("should track clone jobs of their user", func(t *testing.T) {
+		job, err := cloneRepo.CreateJob(ctx, &models.IssueCloneJob{
+			SourceIssueID:   &parent.ID,
+			TargetProjectID: target.ID,
+			UserID:          user.ID,
+			TotalIssues:     30,
+		})
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if job.Status != models.CloneJobStatusPending {
+			t.Errorf("Expected pending job, got %s", job.Status)
+		}
+
+		_ = cloneRepo.StartJob(ctx, job.ID)
+		_ = cloneRepo.UpdateJobProgress(ctx, job.ID, 12)
+		running, err := cloneRepo.GetJob(ctx, user.ID, job.ID)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if running.Status != models.CloneJobStatusProcessing || running.ClonedIssues != 12 {
+			t.Errorf("Expected processing job at 12, got %s at %d", running.Status, running.ClonedIssues)
+		}
+
+		_ = cloneRepo.CompleteJob(ctx, job.ID, parent.ID)
+		completed, _ := cloneRepo.GetJob(ctx, user.ID, job.ID)
+		if completed.Status != models.CloneJobStatusCompleted || completed.ClonedIssues != 30 {
+			t.Errorf("Expected completed job at 30, got %s at %d", completed.Status, completed.ClonedIssues)
+		}
+
+		if _, err := cloneRepo.GetJob(ctx, user.ID+1, job.ID); err != pkgerrors.ErrNotFound {
+			t.Errorf("Expected ErrNotFound for another user, got %v", err)
+		}
+	})
+}
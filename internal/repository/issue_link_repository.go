@@ -0,0 +1,267 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/yourusername/issue-tracker/internal/models"
+	pkgerrors "github.com/yourusername/issue-tracker/pkg/errors"
+)
+
+// IssueLinkRepository handles issue link data access
+type IssueLinkRepository struct {
+	db *sql.DB
+}
+
+// NewIssueLinkRepository creates a new issue link repository
+func NewIssueLinkRepository(db *sql.DB) *IssueLinkRepository {
+	return &IssueLinkRepository{db: db}
+}
+
+// issueLinkColumns lists the issue link columns read by scanIssueLink, in order
+const issueLinkColumns = `l.id, l.source_issue_id, l.target_issue_id, l.link_type, l.created_by, l.created_at`
+
+// scanIssueLink scans a row selected with issueLinkColumns into a link,
+// followed by any extra destinations
+func scanIssueLink(row rowScanner, link *models.IssueLink, extra ...interface{}) error {
+	dest := append([]interface{}{
+		&link.ID,
+		&link.SourceIssueID,
+		&link.TargetIssueID,
+		&link.LinkType,
+		&link.CreatedBy,
+		&link.CreatedAt,
+	}, extra...)
+	return row.Scan(dest...)
+}
+
+// qualifiedIssueColumns returns issueColumns qualified with a table alias
+func qualifiedIssueColumns(alias string) string {
+	columns := strings.Split(issueColumns, ",")
+	for i, column := range columns {
+		columns[i] = alias + "." + strings.TrimSpace(column)
+	}
+	return strings.Join(columns, ", ")
+}
+
+// Create creates a link
+// Returns ErrConflict when the issues already have a link of this type
+func (r *IssueLinkRepository) Create(ctx context.Context, link *models.IssueLink) (*models.IssueLink, error) {
+	query := `
+		INSERT INTO issue_links AS l (source_issue_id, target_issue_id, link_type, created_by)
+		VALUES ($1, $2, $3, $4)
+		RETURNING ` + issueLinkColumns
+
+	var created models.IssueLink
+	err := scanIssueLink(r.db.QueryRowContext(ctx, query,
+		link.SourceIssueID, link.TargetIssueID, link.LinkType, link.CreatedBy,
+	), &created)
+	if err != nil {
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code == "23505" {
+			return nil, pkgerrors.ErrConflict
+		}
+		return nil, err
+	}
+
+	return &created, nil
+}
+
+// GetByID retrieves a link by ID
+func (r *IssueLinkRepository) GetByID(ctx context.Context, id int) (*models.IssueLink, error) {
+	query := `SELECT ` + issueLinkColumns + ` FROM issue_links l WHERE l.id = $1`
+
+	var link models.IssueLink
+	if err := scanIssueLink(r.db.QueryRowContext(ctx, query, id), &link); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, pkgerrors.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return &link, nil
+}
+
+// Delete deletes a link
+func (r *IssueLinkRepository) Delete(ctx context.Context, id int) error {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM issue_links WHERE id = $1`, id)
+	if err != nil {
+		return err
+	}
+
+	return requireRowsAffected(result)
+}
+
+// ListByIssueID lists the links of an issue in both directions, with the
+// other issue of each link
+func (r *IssueLinkRepository) ListByIssueID(ctx context.Context, issueID int) ([]*models.IssueLink, error) {
+	query := `
+		SELECT ` + issueLinkColumns + `, ` + qualifiedIssueColumns("o") + `
+		FROM issue_links l
+		JOIN issues o ON o.id = CASE WHEN l.source_issue_id = $1 THEN l.target_issue_id ELSE l.source_issue_id END
+		WHERE (l.source_issue_id = $1 OR l.target_issue_id = $1) AND o.deleted_at IS NULL
+		ORDER BY l.link_type, l.created_at
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, issueID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	links := make([]*models.IssueLink, 0)
+	for rows.Next() {
+		var link models.IssueLink
+		var other models.Issue
+		if err := scanIssueLink(rows, &link, issueScanDest(&other)...); err != nil {
+			return nil, err
+		}
+
+		link.Direction = models.LinkDirectionOutward
+		if link.TargetIssueID == issueID && link.LinkType != models.LinkTypeRelates {
+			link.Direction = models.LinkDirectionInward
+		}
+		link.Relation = models.IssueLinkRelation(link.LinkType, link.Direction)
+		other.Overdue = other.IsOverdue(time.Now())
+		link.Issue = &other
+		links = append(links, &link)
+	}
+
+	return links, rows.Err()
+}
+
+// ListOpenBlockers returns the keys (e.g. PROJ-12) of the unfinished issues
+// that block an issue
+func (r *IssueLinkRepository) ListOpenBlockers(ctx context.Context, issueID int) ([]string, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT p.key || '-' || i.issue_number
+		FROM issue_links l
+		JOIN issues i ON i.id = l.source_issue_id
+		JOIN projects p ON p.id = i.project_id
+		WHERE l.target_issue_id = $1 AND l.link_type = 'blocks'
+		  AND i.status_category <> 'done' AND i.deleted_at IS NULL
+		ORDER BY p.key, i.issue_number
+	`, issueID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	keys := make([]string, 0)
+	for rows.Next() {
+		var key string
+		if err := rows.Scan(&key); err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+
+	return keys, rows.Err()
+}
+
+// BlockingPathExists reports whether an issue blocks another one, directly
+// or through a chain of blocks links
+func (r *IssueLinkRepository) BlockingPathExists(ctx context.Context, fromIssueID, toIssueID int) (bool, error) {
+	var exists bool
+	err := r.db.QueryRowContext(ctx, `
+		WITH RECURSIVE blocked(id) AS (
+			SELECT target_issue_id FROM issue_links WHERE source_issue_id = $1 AND link_type = 'blocks'
+			UNION
+			SELECT l.target_issue_id
+			FROM issue_links l
+			JOIN blocked b ON l.source_issue_id = b.id
+			WHERE l.link_type = 'blocks'
+		)
+		SELECT EXISTS (SELECT 1 FROM blocked WHERE id = $2)
+	`, fromIssueID, toIssueID).Scan(&exists)
+	return exists, err
+}
+
+// dependencyScopeCTE selects the issues of a dependency graph filter
+// ($1 project, $2 epic, $3 milestone) and the blocks links touching them
+const dependencyScopeCTE = `
+	WITH scoped AS (
+		SELECT i.id
+		FROM issues i
+		WHERE i.project_id = $1 AND i.deleted_at IS NULL
+		  AND ($2::int IS NULL OR i.epic_id = $2
+		       OR i.parent_issue_id IN (SELECT id FROM issues WHERE epic_id = $2))
+		  AND ($3::int IS NULL OR i.milestone_id = $3)
+	),
+	edges AS (
+		SELECT l.id, l.source_issue_id, l.target_issue_id
+		FROM issue_links l
+		JOIN issues s ON s.id = l.source_issue_id AND s.deleted_at IS NULL
+		JOIN issues t ON t.id = l.target_issue_id AND t.deleted_at IS NULL
+		WHERE l.link_type = 'blocks'
+		  AND (l.source_issue_id IN (SELECT id FROM scoped) OR l.target_issue_id IN (SELECT id FROM scoped))
+	)`
+
+// GetDependencyGraph returns the filtered issues of a project and the
+// blocking links between them; issues outside the filter that block or are
+// blocked by them are included as external nodes
+func (r *IssueLinkRepository) GetDependencyGraph(ctx context.Context, filter *models.DependencyGraphFilter) ([]*models.DependencyNode, []*models.DependencyEdge, error) {
+	args := []interface{}{filter.ProjectID, filter.EpicID, filter.MilestoneID}
+
+	rows, err := r.db.QueryContext(ctx, dependencyScopeCTE+`
+		SELECT i.id, p.key || '-' || i.issue_number, i.title, i.status, i.status_category,
+		       i.story_points, i.original_estimate_minutes, i.remaining_estimate_minutes, i.due_date,
+		       i.id NOT IN (SELECT id FROM scoped)
+		FROM issues i
+		JOIN projects p ON p.id = i.project_id
+		WHERE i.id IN (SELECT id FROM scoped)
+		   OR i.id IN (SELECT source_issue_id FROM edges)
+		   OR i.id IN (SELECT target_issue_id FROM edges)
+		ORDER BY p.key, i.issue_number
+	`, args...)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer rows.Close()
+
+	nodes := make([]*models.DependencyNode, 0)
+	for rows.Next() {
+		var node models.DependencyNode
+		if err := rows.Scan(
+			&node.IssueID,
+			&node.Key,
+			&node.Title,
+			&node.Status,
+			&node.StatusCategory,
+			&node.StoryPoints,
+			&node.EstimateMinutes,
+			&node.RemainingMinutes,
+			&node.DueDate,
+			&node.External,
+		); err != nil {
+			return nil, nil, err
+		}
+		nodes = append(nodes, &node)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	edgeRows, err := r.db.QueryContext(ctx, dependencyScopeCTE+`
+		SELECT id, source_issue_id, target_issue_id FROM edges ORDER BY id
+	`, args...)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer edgeRows.Close()
+
+	edges := make([]*models.DependencyEdge, 0)
+	for edgeRows.Next() {
+		var edge models.DependencyEdge
+		if err := edgeRows.Scan(&edge.LinkID, &edge.From, &edge.To); err != nil {
+			return nil, nil, err
+		}
+		edges = append(edges, &edge)
+	}
+
+	return nodes, edges, edgeRows.Err()
+}
@@ -0,0 +1,168 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/yourusername/issue-tracker/internal/models"
+	pkgerrors "github.com/yourusername/issue-tracker/pkg/errors"
+)
+
+func TestIssueLinkRepository(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	cleanup := func() {
+		db.Exec("DELETE FROM issues WHERE project_id IN (SELECT id FROM projects WHERE key = 'LNK')")
+		db.Exec("DELETE FROM projects WHERE key = 'LNK'")
+		db.Exec("DELETE FROM users WHERE email = 'linktest@example.com'")
+	}
+	cleanup()
+	defer cleanup()
+
+	linkRepo := NewIssueLinkRepository(db)
+	issueRepo := NewIssueRepository(db)
+	ctx := context.Background()
+
+	user, err := NewUserRepository(db).Create(ctx, &models.User{
+		Email:        "linktest@example.com",
+		Username:     "linktest",
+		PasswordHash: "hash",
+	})
+	if err != nil {
+		t.Fatalf("Failed to create user: %v", err)
+	}
+	project, err := NewProjectRepository(db).Create(ctx, &models.Project{Name: "Link Project", Key: "LNK", OwnerID: user.ID})
+	if err != nil {
+		t.Fatalf("Failed to create project: %v", err)
+	}
+
+	createIssue := func(title string) *models.Issue {
+		issue, err := issueRepo.Create(ctx, &models.Issue{
+			ProjectID:      project.ID,
+			Title:          title,
+			Status:         models.IssueStatusOpen,
+			StatusCategory: models.StatusCategoryTodo,
+			Priority:       models.PriorityMedium,
+			IssueType:      models.IssueTypeTask,
+			ReporterID:     user.ID,
+		})
+		if err != nil {
+			t.Fatalf("Failed to create issue: %v", err)
+		}
+		return issue
+	}
+
+	blocker := createIssue("Blocker")
+	blocked := createIssue("Blocked")
+
+	link, err := linkRepo.Create(ctx, &models.IssueLink{
+		SourceIssueID: blocker.ID,
+		TargetIssueID: blocked.ID,
+		LinkType:      models.LinkTypeBlocks,
+		CreatedBy:     &user.ID,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create link: %v", err)
+	}
+
+	t.Run("should reject a duplicate link", func(t *testing.T) {
+		_, err := linkRepo.Create(ctx, &models.IssueLink{
+			SourceIssueID: blocker.ID,
+			TargetIssueID: blocked.ID,
+			LinkType:      models.LinkTypeBlocks,
+		})
+		if err != pkgerrors.ErrConflict {
+			t.Errorf("Expected ErrConflict, got %v", err)
+		}
+	})
+
+	t.Run("should list the link from both sides", func(t *testing.T) {
+		links, err := linkRepo.ListByIssueID(ctx, blocked.ID)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if len(links) != 1 {
+			t.Fatalf("Expected 1 link, got %d", len(links))
+		}
+		if links[0].Relation != "is_blocked_by" || links[0].Issue.ID != blocker.ID {
+			t.Errorf("Expected is_blocked_by %d, got %s %d", blocker.ID, links[0].Relation, links[0].Issue.ID)
+		}
+
+		links, err = linkRepo.ListByIssueID(ctx, blocker.ID)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if len(links) != 1 || links[0].Relation != "blocks" {
+			t.Errorf("Expected one blocks link, got %+v", links)
+		}
+	})
+
+	t.Run("should list open blockers until they are done", func(t *testing.T) {
+		blockers, err := linkRepo.ListOpenBlockers(ctx, blocked.ID)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if len(blockers) != 1 || blockers[0] != "LNK-1" {
+			t.Errorf("Expected [LNK-1], got %v", blockers)
+		}
+
+		blocker.Status = models.IssueStatusClosed
+		blocker.StatusCategory = models.StatusCategoryDone
+		if err := issueRepo.Update(ctx, blocker); err != nil {
+			t.Fatalf("Failed to close blocker: %v", err)
+		}
+
+		blockers, err = linkRepo.ListOpenBlockers(ctx, blocked.ID)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if len(blockers) != 0 {
+			t.Errorf("Expected no open blockers, got %v", blockers)
+		}
+	})
+
+	t.Run("should follow blocking chains", func(t *testing.T) {
+		last := createIssue("Last")
+		if _, err := linkRepo.Create(ctx, &models.IssueLink{
+			SourceIssueID: blocked.ID,
+			TargetIssueID: last.ID,
+			LinkType:      models.LinkTypeBlocks,
+		}); err != nil {
+			t.Fatalf("Failed to create link: %v", err)
+		}
+
+		exists, err := linkRepo.BlockingPathExists(ctx, blocker.ID, last.ID)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if !exists {
+			t.Error("Expected a blocking path from the first to the last issue")
+		}
+
+		exists, err = linkRepo.BlockingPathExists(ctx, last.ID, blocker.ID)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if exists {
+			t.Error("Expected no blocking path back to the first issue")
+		}
+
+		nodes, edges, err := linkRepo.GetDependencyGraph(ctx, &models.DependencyGraphFilter{ProjectID: project.ID})
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if len(nodes) != 3 || len(edges) != 2 {
+			t.Errorf("Expected 3 nodes and 2 edges, got %d and %d", len(nodes), len(edges))
+		}
+	})
+
+	t.Run("should delete a link", func(t *testing.T) {
+		if err := linkRepo.Delete(ctx, link.ID); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if _, err := linkRepo.GetByID(ctx, link.ID); err != pkgerrors.ErrNotFound {
+			t.Errorf("Expected ErrNotFound, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,97 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/yourusername/issue-tracker/internal/models"
+)
+
+// IssueReminderRepository handles due date reminder data access
+type IssueReminderRepository struct {
+	db *sql.DB
+}
+
+// NewIssueReminderRepository creates a new issue reminder repository
+func NewIssueReminderRepository(db *sql.DB) *IssueReminderRepository {
+	return &IssueReminderRepository{db: db}
+}
+
+// ListPending lists the reminders due on a day that haven't been sent yet:
+// due_soon for unfinished issues due within daysBefore days, overdue for
+// unfinished issues past their due date, once per assignee and watcher
+func (r *IssueReminderRepository) ListPending(ctx context.Context, today time.Time, daysBefore int) ([]*models.IssueReminder, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		WITH due AS (
+			SELECT i.id, i.due_date, p.key || '-' || i.issue_number AS issue_key, i.title, p.name,
+			       CASE WHEN i.due_date < $1::date THEN 'overdue' ELSE 'due_soon' END AS kind
+			FROM issues i
+			JOIN projects p ON p.id = i.project_id
+			WHERE i.deleted_at IS NULL
+			  AND i.status_category <> 'done'
+			  AND i.due_date IS NOT NULL
+			  AND i.due_date <= $1::date + $2::int
+		),
+		recipients AS (
+			SELECT ia.issue_id, ia.user_id
+			FROM issue_assignees ia JOIN due d ON d.id = ia.issue_id
+			UNION
+			SELECT w.issue_id, w.user_id
+			FROM issue_watchers w JOIN due d ON d.id = w.issue_id
+		)
+		SELECT d.id, u.id, d.kind, d.due_date, d.issue_key, d.title, d.name, u.email
+		FROM due d
+		JOIN recipients rc ON rc.issue_id = d.id
+		JOIN users u ON u.id = rc.user_id
+		WHERE u.is_bot = FALSE AND u.is_placeholder = FALSE
+		  AND NOT EXISTS (
+			SELECT 1 FROM issue_reminders ir
+			WHERE ir.issue_id = d.id AND ir.user_id = u.id AND ir.kind = d.kind AND ir.due_date = d.due_date
+		  )
+		ORDER BY d.due_date, d.id, u.id
+	`, today, daysBefore)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	reminders := make([]*models.IssueReminder, 0)
+	for rows.Next() {
+		var reminder models.IssueReminder
+		if err := rows.Scan(
+			&reminder.IssueID,
+			&reminder.UserID,
+			&reminder.Kind,
+			&reminder.DueDate,
+			&reminder.IssueKey,
+			&reminder.IssueTitle,
+			&reminder.ProjectName,
+			&reminder.Email,
+		); err != nil {
+			return nil, err
+		}
+		reminders = append(reminders, &reminder)
+	}
+
+	return reminders, rows.Err()
+}
+
+// Claim records a reminder as sent; it returns false when it already was,
+// so each reminder is delivered once even across restarts and instances
+func (r *IssueReminderRepository) Claim(ctx context.Context, reminder *models.IssueReminder) (bool, error) {
+	result, err := r.db.ExecContext(ctx, `
+		INSERT INTO issue_reminders (issue_id, user_id, kind, due_date)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT DO NOTHING
+	`, reminder.IssueID, reminder.UserID, reminder.Kind, reminder.DueDate)
+	if err != nil {
+		return false, err
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return rows > 0, nil
+}
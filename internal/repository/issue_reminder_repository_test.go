@@ -0,0 +1,121 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/yourusername/issue-tracker/internal/models"
+)
+
+func TestIssueReminderRepository(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	cleanup := func() {
+		db.Exec("DELETE FROM issues WHERE project_id IN (SELECT id FROM projects WHERE key = 'REM')")
+		db.Exec("DELETE FROM projects WHERE key = 'REM'")
+		db.Exec("DELETE FROM users WHERE email = 'remindertest@example.com'")
+	}
+	cleanup()
+	defer cleanup()
+
+	reminderRepo := NewIssueReminderRepository(db)
+	issueRepo := NewIssueRepository(db)
+	ctx := context.Background()
+
+	user, err := NewUserRepository(db).Create(ctx, &models.User{
+		Email:        "remindertest@example.com",
+		Username:     "remindertest",
+		PasswordHash: "hash",
+	})
+	if err != nil {
+		t.Fatalf("Failed to create user: %v", err)
+	}
+	project, err := NewProjectRepository(db).Create(ctx, &models.Project{Name: "Reminder Project", Key: "REM", OwnerID: user.ID})
+	if err != nil {
+		t.Fatalf("Failed to create project: %v", err)
+	}
+
+	today := models.DateOnly(time.Now())
+	createIssue := func(dueDate time.Time) *models.Issue {
+		issue, err := issueRepo.Create(ctx, &models.Issue{
+			ProjectID:      project.ID,
+			Title:          "Dated issue",
+			Status:         models.IssueStatusOpen,
+			StatusCategory: models.StatusCategoryTodo,
+			Priority:       models.PriorityMedium,
+			IssueType:      models.IssueTypeTask,
+			ReporterID:     user.ID,
+			AssigneeID:     &user.ID,
+			DueDate:        &dueDate,
+		})
+		if err != nil {
+			t.Fatalf("Failed to create issue: %v", err)
+		}
+		return issue
+	}
+
+	overdue := createIssue(today.AddDate(0, 0, -1))
+	dueSoon := createIssue(today.AddDate(0, 0, 1))
+	createIssue(today.AddDate(0, 0, 10)) // Not due yet
+
+	t.Run("should mark overdue issues", func(t *testing.T) {
+		issue, err := issueRepo.GetByID(ctx, overdue.ID)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if !issue.Overdue {
+			t.Error("Expected issue to be overdue")
+		}
+	})
+
+	t.Run("should list pending reminders", func(t *testing.T) {
+		reminders, err := reminderRepo.ListPending(ctx, today, 1)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if len(reminders) != 2 {
+			t.Fatalf("Expected 2 reminders, got %d", len(reminders))
+		}
+		if reminders[0].IssueID != overdue.ID || reminders[0].Kind != models.ReminderOverdue {
+			t.Errorf("Expected overdue reminder for issue %d, got %s for %d", overdue.ID, reminders[0].Kind, reminders[0].IssueID)
+		}
+		if reminders[1].IssueID != dueSoon.ID || reminders[1].Kind != models.ReminderDueSoon {
+			t.Errorf("Expected due_soon reminder for issue %d, got %s for %d", dueSoon.ID, reminders[1].Kind, reminders[1].IssueID)
+		}
+	})
+
+	t.Run("should claim each reminder once", func(t *testing.T) {
+		reminders, err := reminderRepo.ListPending(ctx, today, 1)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		for _, reminder := range reminders {
+			claimed, err := reminderRepo.Claim(ctx, reminder)
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if !claimed {
+				t.Error("Expected first claim to succeed")
+			}
+
+			claimed, err = reminderRepo.Claim(ctx, reminder)
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if claimed {
+				t.Error("Expected second claim to fail")
+			}
+		}
+
+		pending, err := reminderRepo.ListPending(ctx, today, 1)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if len(pending) != 0 {
+			t.Errorf("Expected no pending reminders, got %d", len(pending))
+		}
+	})
+}
@@ -3,9 +3,12 @@ package repository
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"fmt"
 	"strings"
+	"time"
 
+	"github.com/lib/pq"
 	"github.com/yourusername/issue-tracker/internal/models"
 	pkgerrors "github.com/yourusername/issue-tracker/pkg/errors"
 )
@@ -20,22 +23,143 @@ func NewIssueRepository(db *sql.DB) *IssueRepository {
 	return &IssueRepository{db: db}
 }
 
+// rowScanner is implemented by *sql.Row and *sql.Rows
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+// issueColumns lists the issue columns read by scanIssue, in order
+const issueColumns = `id, project_id, issue_number, title, description, status, status_category, resolution,
+			column_id, column_position, priority, issue_type, parent_issue_id, epic_id,
+			assignee_id, assignee_team_id, reporter_id, milestone_id, sprint_id,
+			story_points, original_estimate_minutes, remaining_estimate_minutes,
+			start_date, due_date,
+			version, created_at, updated_at, deleted_at, deleted_by,
+			is_pinned, pinned_at, pinned_by_user_id,
+			upvotes, downvotes, vote_score, customer_request_count`
+
+// scanIssue scans a row selected with issueColumns into an issue
+func scanIssue(row rowScanner, issue *models.Issue) error {
+	if err := row.Scan(issueScanDest(issue)...); err != nil {
+		return err
+	}
+	issue.Overdue = issue.IsOverdue(time.Now())
+	return nil
+}
+
+// issueScanDest returns the scan destinations matching issueColumns
+func issueScanDest(issue *models.Issue) []interface{} {
+	return []interface{}{
+		&issue.ID,
+		&issue.ProjectID,
+		&issue.IssueNumber,
+		&issue.Title,
+		&issue.Description,
+		&issue.Status,
+		&issue.StatusCategory,
+		&issue.Resolution,
+		&issue.ColumnID,
+		&issue.ColumnPosition,
+		&issue.Priority,
+		&issue.IssueType,
+		&issue.ParentIssueID,
+		&issue.EpicID,
+		&issue.AssigneeID,
+		&issue.AssigneeTeamID,
+		&issue.ReporterID,
+		&issue.MilestoneID,
+		&issue.SprintID,
+		&issue.StoryPoints,
+		&issue.EstimateMinutes,
+		&issue.RemainingMinutes,
+		&issue.StartDate,
+		&issue.DueDate,
+		&issue.Version,
+		&issue.CreatedAt,
+		&issue.UpdatedAt,
+		&issue.DeletedAt,
+		&issue.DeletedByUserID,
+		&issue.IsPinned,
+		&issue.PinnedAt,
+		&issue.PinnedByUserID,
+		&issue.Upvotes,
+		&issue.Downvotes,
+		&issue.VoteScore,
+		&issue.CustomerRequests,
+	}
+}
+
+// queryRower is implemented by *sql.DB and *sql.Tx
+type queryRower interface {
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+}
+
+// execer is implemented by *sql.DB and *sql.Tx
+type execer interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+}
+
 // Create creates a new issue with auto-generated issue number
 func (r *IssueRepository) Create(ctx context.Context, issue *models.Issue) (*models.Issue, error) {
+	return insertIssue(ctx, r.db, issue)
+}
+
+// CreateWithDetails creates an issue together with its assignees and custom
+// field values in one transaction, so a failed write leaves no partial issue
+// assigneeIDs replaces the assignees when not nil; a nil value in values
+// clears that field
+func (r *IssueRepository) CreateWithDetails(ctx context.Context, issue *models.Issue, assigneeIDs []int, values map[int]json.RawMessage) (*models.Issue, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	created, err := insertIssue(ctx, tx, issue)
+	if err != nil {
+		return nil, err
+	}
+	if err := writeIssueDetails(ctx, tx, created.ID, assigneeIDs, values); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
+// writeIssueDetails writes the assignees and custom field values of an issue
+func writeIssueDetails(ctx context.Context, tx *sql.Tx, issueID int, assigneeIDs []int, values map[int]json.RawMessage) error {
+	if assigneeIDs != nil {
+		if err := replaceAssignees(ctx, tx, issueID, assigneeIDs); err != nil {
+			return err
+		}
+	}
+	for fieldID, value := range values {
+		if err := writeCustomFieldValue(ctx, tx, issueID, fieldID, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// insertIssue inserts an issue with the next number of its project
+func insertIssue(ctx context.Context, q queryRower, issue *models.Issue) (*models.Issue, error) {
 	query := `
 		INSERT INTO issues (
 			project_id, issue_number, title, description, status,
 			column_id, column_position, priority, issue_type, parent_issue_id, epic_id,
-			assignee_id, reporter_id, milestone_id
+			assignee_id, reporter_id, milestone_id, assignee_team_id, status_category,
+			story_points, original_estimate_minutes, remaining_estimate_minutes,
+			start_date, due_date
 		)
-		VALUES ($1, get_next_issue_number($1), $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
-		RETURNING id, project_id, issue_number, title, description, status,
-			column_id, column_position, priority, issue_type, parent_issue_id, epic_id,
-			assignee_id, reporter_id, milestone_id, version, created_at, updated_at, deleted_at
+		VALUES ($1, get_next_issue_number($1), $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20)
+		RETURNING ` + issueColumns + `
 	`
 
 	var created models.Issue
-	err := r.db.QueryRowContext(ctx, query,
+	row := q.QueryRowContext(ctx, query,
 		issue.ProjectID,
 		issue.Title,
 		issue.Description,
@@ -49,27 +173,15 @@ func (r *IssueRepository) Create(ctx context.Context, issue *models.Issue) (*mod
 		issue.AssigneeID,
 		issue.ReporterID,
 		issue.MilestoneID,
-	).Scan(
-		&created.ID,
-		&created.ProjectID,
-		&created.IssueNumber,
-		&created.Title,
-		&created.Description,
-		&created.Status,
-		&created.ColumnID,
-		&created.ColumnPosition,
-		&created.Priority,
-		&created.IssueType,
-		&created.ParentIssueID,
-		&created.EpicID,
-		&created.AssigneeID,
-		&created.ReporterID,
-		&created.MilestoneID,
-		&created.Version,
-		&created.CreatedAt,
-		&created.UpdatedAt,
-		&created.DeletedAt,
+		issue.AssigneeTeamID,
+		issue.StatusCategory,
+		issue.StoryPoints,
+		issue.EstimateMinutes,
+		issue.RemainingMinutes,
+		issue.StartDate,
+		issue.DueDate,
 	)
+	err := scanIssue(row, &created)
 
 	if err != nil {
 		return nil, err
@@ -81,35 +193,13 @@ func (r *IssueRepository) Create(ctx context.Context, issue *models.Issue) (*mod
 // GetByID retrieves an issue by ID (excluding soft-deleted)
 func (r *IssueRepository) GetByID(ctx context.Context, id int) (*models.Issue, error) {
 	query := `
-		SELECT id, project_id, issue_number, title, description, status,
-			column_id, column_position, priority, issue_type, parent_issue_id, epic_id,
-			assignee_id, reporter_id, milestone_id, version, created_at, updated_at, deleted_at
+		SELECT ` + issueColumns + `
 		FROM issues
 		WHERE id = $1 AND deleted_at IS NULL
 	`
 
 	var issue models.Issue
-	err := r.db.QueryRowContext(ctx, query, id).Scan(
-		&issue.ID,
-		&issue.ProjectID,
-		&issue.IssueNumber,
-		&issue.Title,
-		&issue.Description,
-		&issue.Status,
-		&issue.ColumnID,
-		&issue.ColumnPosition,
-		&issue.Priority,
-		&issue.IssueType,
-		&issue.ParentIssueID,
-		&issue.EpicID,
-		&issue.AssigneeID,
-		&issue.ReporterID,
-		&issue.MilestoneID,
-		&issue.Version,
-		&issue.CreatedAt,
-		&issue.UpdatedAt,
-		&issue.DeletedAt,
-	)
+	err := scanIssue(r.db.QueryRowContext(ctx, query, id), &issue)
 
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -124,35 +214,13 @@ func (r *IssueRepository) GetByID(ctx context.Context, id int) (*models.Issue, e
 // GetByProjectAndNumber retrieves an issue by project ID and issue number
 func (r *IssueRepository) GetByProjectAndNumber(ctx context.Context, projectID int, issueNumber int) (*models.Issue, error) {
 	query := `
-		SELECT id, project_id, issue_number, title, description, status,
-			column_id, column_position, priority, issue_type, parent_issue_id, epic_id,
-			assignee_id, reporter_id, milestone_id, version, created_at, updated_at, deleted_at
+		SELECT ` + issueColumns + `
 		FROM issues
 		WHERE project_id = $1 AND issue_number = $2 AND deleted_at IS NULL
 	`
 
 	var issue models.Issue
-	err := r.db.QueryRowContext(ctx, query, projectID, issueNumber).Scan(
-		&issue.ID,
-		&issue.ProjectID,
-		&issue.IssueNumber,
-		&issue.Title,
-		&issue.Description,
-		&issue.Status,
-		&issue.ColumnID,
-		&issue.ColumnPosition,
-		&issue.Priority,
-		&issue.IssueType,
-		&issue.ParentIssueID,
-		&issue.EpicID,
-		&issue.AssigneeID,
-		&issue.ReporterID,
-		&issue.MilestoneID,
-		&issue.Version,
-		&issue.CreatedAt,
-		&issue.UpdatedAt,
-		&issue.DeletedAt,
-	)
+	err := scanIssue(r.db.QueryRowContext(ctx, query, projectID, issueNumber), &issue)
 
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -167,9 +235,7 @@ func (r *IssueRepository) GetByProjectAndNumber(ctx context.Context, projectID i
 // List retrieves issues with filtering and search
 func (r *IssueRepository) List(ctx context.Context, filter *models.IssueFilter) ([]*models.Issue, error) {
 	query := `
-		SELECT id, project_id, issue_number, title, description, status,
-			column_id, column_position, priority, issue_type, parent_issue_id, epic_id,
-			assignee_id, reporter_id, milestone_id, version, created_at, updated_at, deleted_at
+		SELECT ` + issueColumns + `
 		FROM issues
 		WHERE project_id = $1 AND deleted_at IS NULL
 	`
@@ -196,6 +262,16 @@ func (r *IssueRepository) List(ctx context.Context, filter *models.IssueFilter)
 		args = append(args, *filter.IssueType)
 	}
 
+	if len(filter.IssueTypes) > 0 {
+		types := make([]string, len(filter.IssueTypes))
+		for i, issueType := range filter.IssueTypes {
+			types[i] = string(issueType)
+		}
+		argCount++
+		query += fmt.Sprintf(" AND issue_type = ANY($%d)", argCount)
+		args = append(args, pq.Array(types))
+	}
+
 	if filter.ParentIssueID != nil {
 		argCount++
 		query += fmt.Sprintf(" AND parent_issue_id = $%d", argCount)
@@ -216,12 +292,24 @@ func (r *IssueRepository) List(ctx context.Context, filter *models.IssueFilter)
 		}
 	}
 
+	if filter.StatusCategory != nil {
+		argCount++
+		query += fmt.Sprintf(" AND status_category = $%d", argCount)
+		args = append(args, *filter.StatusCategory)
+	}
+
 	if filter.AssigneeID != nil {
 		argCount++
-		query += fmt.Sprintf(" AND assignee_id = $%d", argCount)
+		query += fmt.Sprintf(" AND id IN (SELECT issue_id FROM issue_assignees WHERE user_id = $%d)", argCount)
 		args = append(args, *filter.AssigneeID)
 	}
 
+	if filter.AssigneeTeamID != nil {
+		argCount++
+		query += fmt.Sprintf(" AND assignee_team_id = $%d", argCount)
+		args = append(args, *filter.AssigneeTeamID)
+	}
+
 	if filter.ReporterID != nil {
 		argCount++
 		query += fmt.Sprintf(" AND reporter_id = $%d", argCount)
@@ -234,6 +322,54 @@ func (r *IssueRepository) List(ctx context.Context, filter *models.IssueFilter)
 		args = append(args, *filter.MilestoneID)
 	}
 
+	if filter.SprintID != nil {
+		argCount++
+		query += fmt.Sprintf(" AND sprint_id = $%d", argCount)
+		args = append(args, *filter.SprintID)
+	}
+
+	if filter.InBacklog {
+		query += " AND sprint_id IS NULL"
+	}
+
+	if filter.Pinned != nil {
+		argCount++
+		query += fmt.Sprintf(" AND is_pinned = $%d", argCount)
+		args = append(args, *filter.Pinned)
+	}
+
+	if filter.MinVotes != nil {
+		argCount++
+		query += fmt.Sprintf(" AND vote_score >= $%d", argCount)
+		args = append(args, *filter.MinVotes)
+	}
+
+	if filter.MinCustomers != nil {
+		argCount++
+		query += fmt.Sprintf(" AND customer_request_count >= $%d", argCount)
+		args = append(args, *filter.MinCustomers)
+	}
+
+	if filter.DueAfter != nil {
+		argCount++
+		query += fmt.Sprintf(" AND due_date >= $%d", argCount)
+		args = append(args, *filter.DueAfter)
+	}
+
+	if filter.DueBefore != nil {
+		argCount++
+		query += fmt.Sprintf(" AND due_date <= $%d", argCount)
+		args = append(args, *filter.DueBefore)
+	}
+
+	if filter.Overdue != nil {
+		if *filter.Overdue {
+			query += " AND due_date < CURRENT_DATE AND status_category <> 'done'"
+		} else {
+			query += " AND (due_date IS NULL OR due_date >= CURRENT_DATE OR status_category = 'done')"
+		}
+	}
+
 	// Search by title or description
 	if filter.Search != "" {
 		argCount++
@@ -254,8 +390,21 @@ func (r *IssueRepository) List(ctx context.Context, filter *models.IssueFilter)
 		)`, strings.Join(placeholders, ","))
 	}
 
-	// Order by issue number descending (newest first)
-	query += " ORDER BY issue_number DESC"
+	// Custom field filtering
+	for _, key := range sortedKeys(filter.CustomFields) {
+		query += " AND " + customFieldMatch("issues.", fmt.Sprintf("$%d", argCount+1), fmt.Sprintf("$%d", argCount+2))
+		args = append(args, key, filter.CustomFields[key])
+		argCount += 2
+	}
+
+	// Pinned issues always come first; each group is ordered by the requested
+	// field, else the most recently pinned on top and then by issue number
+	// descending (newest first)
+	if orderBy, ok := models.IssueOrderBy(filter.Sort, ""); ok {
+		query += " ORDER BY pinned_at IS NULL, " + orderBy
+	} else {
+		query += " ORDER BY pinned_at IS NULL, pinned_at DESC, issue_number DESC"
+	}
 
 	// Pagination
 	if filter.Limit > 0 {
@@ -279,27 +428,7 @@ func (r *IssueRepository) List(ctx context.Context, filter *models.IssueFilter)
 	issues := make([]*models.Issue, 0)
 	for rows.Next() {
 		var issue models.Issue
-		err := rows.Scan(
-			&issue.ID,
-			&issue.ProjectID,
-			&issue.IssueNumber,
-			&issue.Title,
-			&issue.Description,
-			&issue.Status,
-			&issue.ColumnID,
-			&issue.ColumnPosition,
-			&issue.Priority,
-			&issue.IssueType,
-			&issue.ParentIssueID,
-			&issue.EpicID,
-			&issue.AssigneeID,
-			&issue.ReporterID,
-			&issue.MilestoneID,
-			&issue.Version,
-			&issue.CreatedAt,
-			&issue.UpdatedAt,
-			&issue.DeletedAt,
-		)
+		err := scanIssue(rows, &issue)
 		if err != nil {
 			return nil, err
 		}
@@ -315,15 +444,33 @@ func (r *IssueRepository) List(ctx context.Context, filter *models.IssueFilter)
 
 // Update updates an issue with optimistic locking
 func (r *IssueRepository) Update(ctx context.Context, issue *models.Issue) error {
+	return r.UpdateWithDetails(ctx, issue, nil, nil)
+}
+
+// UpdateWithDetails updates an issue with optimistic locking together with its
+// assignees and custom field values, in one transaction
+// assigneeIDs replaces the assignees when not nil; a nil value in values
+// clears that field
+func (r *IssueRepository) UpdateWithDetails(ctx context.Context, issue *models.Issue, assigneeIDs []int, values map[int]json.RawMessage) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
 	query := `
 		UPDATE issues
 		SET title = $1, description = $2, status = $3, priority = $4,
 			issue_type = $5, epic_id = $6, assignee_id = $7, milestone_id = $8,
-			column_id = $9, column_position = $10, version = version + 1, updated_at = NOW()
+			column_id = $9, column_position = $10, assignee_team_id = $13,
+			status_category = $14, resolution = $15,
+			story_points = $16, original_estimate_minutes = $17, remaining_estimate_minutes = $18,
+			start_date = $19, due_date = $20,
+			version = version + 1, updated_at = NOW()
 		WHERE id = $11 AND version = $12 AND deleted_at IS NULL
 	`
 
-	result, err := r.db.ExecContext(ctx, query,
+	result, err := tx.ExecContext(ctx, query,
 		issue.Title,
 		issue.Description,
 		issue.Status,
@@ -336,6 +483,14 @@ func (r *IssueRepository) Update(ctx context.Context, issue *models.Issue) error
 		issue.ColumnPosition,
 		issue.ID,
 		issue.Version,
+		issue.AssigneeTeamID,
+		issue.StatusCategory,
+		issue.Resolution,
+		issue.StoryPoints,
+		issue.EstimateMinutes,
+		issue.RemainingMinutes,
+		issue.StartDate,
+		issue.DueDate,
 	)
 
 	if err != nil {
@@ -350,7 +505,7 @@ func (r *IssueRepository) Update(ctx context.Context, issue *models.Issue) error
 	if rowsAffected == 0 {
 		// Check if issue exists
 		var exists bool
-		err = r.db.QueryRowContext(ctx, "SELECT EXISTS(SELECT 1 FROM issues WHERE id = $1 AND deleted_at IS NULL)", issue.ID).Scan(&exists)
+		err = tx.QueryRowContext(ctx, "SELECT EXISTS(SELECT 1 FROM issues WHERE id = $1 AND deleted_at IS NULL)", issue.ID).Scan(&exists)
 		if err != nil {
 			return err
 		}
@@ -361,40 +516,478 @@ func (r *IssueRepository) Update(ctx context.Context, issue *models.Issue) error
 		return pkgerrors.ErrConflict
 	}
 
+	if err := writeIssueDetails(ctx, tx, issue.ID, assigneeIDs, values); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// ListAssigneesByIssueIDs returns the assignees of issues by issue ID, the
+// primary assignee first
+func (r *IssueRepository) ListAssigneesByIssueIDs(ctx context.Context, issueIDs []int) (map[int][]*models.User, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT ia.issue_id, u.id, u.username, u.email, u.name, u.avatar_url
+		FROM issue_assignees ia
+		JOIN issues i ON i.id = ia.issue_id
+		JOIN users u ON u.id = ia.user_id
+		WHERE ia.issue_id = ANY($1)
+		ORDER BY ia.issue_id, ia.user_id = i.assignee_id DESC, ia.created_at, ia.user_id
+	`, pq.Array(issueIDs))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	assignees := make(map[int][]*models.User)
+	for rows.Next() {
+		var issueID int
+		var user models.User
+		if err := rows.Scan(&issueID, &user.ID, &user.Username, &user.Email, &user.Name, &user.AvatarURL); err != nil {
+			return nil, err
+		}
+		assignees[issueID] = append(assignees[issueID], &user)
+	}
+
+	return assignees, rows.Err()
+}
+
+// SetAssignees replaces the assignees of an issue
+// The primary assignee is set by Update and must be one of userIDs
+func (r *IssueRepository) SetAssignees(ctx context.Context, issueID int, userIDs []int) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := replaceAssignees(ctx, tx, issueID, userIDs); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// replaceAssignees replaces the assignees of an issue within a transaction
+func replaceAssignees(ctx context.Context, tx *sql.Tx, issueID int, userIDs []int) error {
+	ids := pq.Array(append([]int{}, userIDs...))
+	if _, err := tx.ExecContext(ctx, `DELETE FROM issue_assignees WHERE issue_id = $1 AND NOT user_id = ANY($2)`, issueID, ids); err != nil {
+		return err
+	}
+	_, err := tx.ExecContext(ctx, `
+		INSERT INTO issue_assignees (issue_id, user_id)
+		SELECT $1, unnest($2::int[])
+		ON CONFLICT DO NOTHING
+	`, issueID, ids)
+	return err
+}
+
+// Pin pins an issue to the top of its project's issue list
+// Returns ErrConflict when the project already has limit pinned issues; the
+// project row is locked so concurrent pins can't exceed the limit
+func (r *IssueRepository) Pin(ctx context.Context, issue *models.Issue, userID int, limit int) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, "SELECT id FROM projects WHERE id = $1 FOR UPDATE", issue.ProjectID); err != nil {
+		return err
+	}
+
+	var pinned int
+	err = tx.QueryRowContext(ctx, `
+		SELECT COUNT(*) FROM issues
+		WHERE project_id = $1 AND is_pinned = TRUE AND deleted_at IS NULL
+	`, issue.ProjectID).Scan(&pinned)
+	if err != nil {
+		return err
+	}
+	if pinned >= limit {
+		return pkgerrors.ErrConflict
+	}
+
+	err = tx.QueryRowContext(ctx, `
+		UPDATE issues
+		SET is_pinned = TRUE, pinned_at = NOW(), pinned_by_user_id = $2
+		WHERE id = $1 AND deleted_at IS NULL
+		RETURNING pinned_at
+	`, issue.ID, userID).Scan(&issue.PinnedAt)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return pkgerrors.ErrNotFound
+		}
+		return err
+	}
+	issue.IsPinned = true
+	issue.PinnedByUserID = &userID
+
+	return tx.Commit()
+}
+
+// Unpin removes an issue from the pinned issues of its project
+func (r *IssueRepository) Unpin(ctx context.Context, issue *models.Issue) error {
+	result, err := r.db.ExecContext(ctx, `
+		UPDATE issues
+		SET is_pinned = FALSE, pinned_at = NULL, pinned_by_user_id = NULL
+		WHERE id = $1 AND deleted_at IS NULL
+	`, issue.ID)
+	if err != nil {
+		return err
+	}
+	if err := requireRowsAffected(result); err != nil {
+		return err
+	}
+
+	issue.IsPinned, issue.PinnedAt, issue.PinnedByUserID = false, nil, nil
 	return nil
 }
 
-// Delete soft deletes an issue
-func (r *IssueRepository) Delete(ctx context.Context, id int) error {
-	query := `
+// Delete moves an issue and its subtasks to the trash
+// Subtasks get the same deleted_at as their parent, so restoring the parent
+// brings back exactly the subtasks deleted with it. Trashed issues are
+// unpinned, so restoring them can't exceed the pin limit
+func (r *IssueRepository) Delete(ctx context.Context, id int, deletedBy int) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	var deletedAt time.Time
+	err = tx.QueryRowContext(ctx, `
 		UPDATE issues
-		SET deleted_at = NOW()
+		SET deleted_at = NOW(), deleted_by = $2,
+			is_pinned = FALSE, pinned_at = NULL, pinned_by_user_id = NULL
 		WHERE id = $1 AND deleted_at IS NULL
+		RETURNING deleted_at
+	`, id, deletedBy).Scan(&deletedAt)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return pkgerrors.ErrNotFound
+		}
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		UPDATE issues
+		SET deleted_at = $2, deleted_by = $3,
+			is_pinned = FALSE, pinned_at = NULL, pinned_by_user_id = NULL
+		WHERE parent_issue_id = $1 AND deleted_at IS NULL
+	`, id, deletedAt, deletedBy)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// GetDeletedByID retrieves an issue from the trash
+func (r *IssueRepository) GetDeletedByID(ctx context.Context, id int) (*models.Issue, error) {
+	query := `
+		SELECT ` + issueColumns + `
+		FROM issues
+		WHERE id = $1 AND deleted_at IS NOT NULL
 	`
 
-	result, err := r.db.ExecContext(ctx, query, id)
+	var issue models.Issue
+	if err := scanIssue(r.db.QueryRowContext(ctx, query, id), &issue); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, pkgerrors.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return &issue, nil
+}
+
+// ListDeleted lists the trash of a project, most recently deleted first
+func (r *IssueRepository) ListDeleted(ctx context.Context, projectID int) ([]*models.Issue, error) {
+	query := `
+		SELECT ` + issueColumns + `
+		FROM issues
+		WHERE project_id = $1 AND deleted_at IS NOT NULL
+		ORDER BY deleted_at DESC, id
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, projectID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	issues := []*models.Issue{}
+	for rows.Next() {
+		var issue models.Issue
+		if err := scanIssue(rows, &issue); err != nil {
+			return nil, err
+		}
+		issues = append(issues, &issue)
+	}
+
+	return issues, rows.Err()
+}
+
+// Restore takes an issue and the subtasks deleted with it out of the trash
+// The issue keeps its board column and position unless columnID is given
+// because its column was removed in the meantime
+func (r *IssueRepository) Restore(ctx context.Context, issue *models.Issue, columnID *int) error {
+	tx, err := r.db.BeginTx(ctx, nil)
 	if err != nil {
 		return err
 	}
+	defer tx.Rollback()
 
-	rowsAffected, err := result.RowsAffected()
+	result, err := tx.ExecContext(ctx, `
+		UPDATE issues
+		SET deleted_at = NULL, deleted_by = NULL,
+			column_id = COALESCE(column_id, $2),
+			updated_at = NOW()
+		WHERE id = $1 AND deleted_at = $3
+	`, issue.ID, columnID, issue.DeletedAt)
 	if err != nil {
 		return err
 	}
+	if err := requireRowsAffected(result); err != nil {
+		return err
+	}
 
-	if rowsAffected == 0 {
-		return pkgerrors.ErrNotFound
+	_, err = tx.ExecContext(ctx, `
+		UPDATE issues
+		SET deleted_at = NULL, deleted_by = NULL, updated_at = NOW()
+		WHERE parent_issue_id = $1 AND deleted_at = $2
+	`, issue.ID, issue.DeletedAt)
+	if err != nil {
+		return err
 	}
 
-	return nil
+	return tx.Commit()
+}
+
+// Purge permanently deletes an issue from the trash together with its
+// subtasks and everything attached to them
+// Returns the storage keys of the attachments whose files should be removed
+func (r *IssueRepository) Purge(ctx context.Context, id int) ([]string, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	rows, err := tx.QueryContext(ctx, `
+		SELECT a.storage_key
+		FROM attachments a
+		JOIN issues i ON i.id = a.issue_id
+		WHERE (i.id = $1 OR i.parent_issue_id = $1) AND a.deleted_at IS NULL
+	`, id)
+	if err != nil {
+		return nil, err
+	}
+	storageKeys := []string{}
+	for rows.Next() {
+		var key string
+		if err := rows.Scan(&key); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		storageKeys = append(storageKeys, key)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	// Revisions have no foreign key to their issue or comment
+	_, err = tx.ExecContext(ctx, `
+		DELETE FROM content_revisions
+		WHERE (entity_type = 'issue' AND entity_id IN (
+				SELECT id FROM issues WHERE id = $1 OR parent_issue_id = $1
+			))
+		   OR (entity_type = 'comment' AND entity_id IN (
+				SELECT c.id FROM comments c JOIN issues i ON i.id = c.issue_id
+				WHERE i.id = $1 OR i.parent_issue_id = $1
+			))
+	`, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Subtasks, labels, comments, attachments etc. are removed by cascade
+	result, err := tx.ExecContext(ctx, `DELETE FROM issues WHERE id = $1 AND deleted_at IS NOT NULL`, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := requireRowsAffected(result); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return storageKeys, nil
+}
+
+// ListExpiredDeleted returns the IDs of issues deleted before the given time
+// Subtasks deleted with their parent are left out, purging the parent
+// removes them
+func (r *IssueRepository) ListExpiredDeleted(ctx context.Context, before time.Time) ([]int, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT i.id
+		FROM issues i
+		WHERE i.deleted_at < $1
+		  AND NOT EXISTS (
+			SELECT 1 FROM issues p WHERE p.id = i.parent_issue_id AND p.deleted_at IS NOT NULL
+		  )
+		ORDER BY i.deleted_at
+	`, before)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	ids := []int{}
+	for rows.Next() {
+		var id int
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+
+	return ids, rows.Err()
+}
+
+// MoveToProject moves issues to another project in one transaction
+// The issues get new numbers from the target project's counter and their old
+// keys redirect to them; status, column, milestone, epic and team must
+// already be set for the target project. Labels are replaced through
+// labelMapping, custom field values move to the target field with the same
+// key and type, and whatever has no counterpart is dropped
+func (r *IssueRepository) MoveToProject(ctx context.Context, issues []*models.Issue, targetProjectID int, labelMapping map[int]int) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	issueIDs := make([]int, len(issues))
+	for i, issue := range issues {
+		issueIDs[i] = issue.ID
+
+		_, err := tx.ExecContext(ctx, `
+			INSERT INTO issue_key_redirects (project_id, issue_number, issue_id)
+			SELECT project_id, issue_number, id FROM issues WHERE id = $1
+			ON CONFLICT (project_id, issue_number) DO UPDATE SET issue_id = EXCLUDED.issue_id
+		`, issue.ID)
+		if err != nil {
+			return err
+		}
+
+		err = tx.QueryRowContext(ctx, `
+			UPDATE issues
+			SET project_id = $2, issue_number = get_next_issue_number($2),
+				status = $3, status_category = $4, resolution = $5,
+				column_id = $6, column_position = NULL, milestone_id = $7,
+				epic_id = $8, assignee_team_id = $9, sprint_id = NULL,
+				is_pinned = FALSE, pinned_at = NULL, pinned_by_user_id = NULL,
+				version = version + 1, updated_at = NOW()
+			WHERE id = $1 AND deleted_at IS NULL
+			RETURNING project_id, issue_number, version, updated_at
+		`,
+			issue.ID,
+			targetProjectID,
+			issue.Status,
+			issue.StatusCategory,
+			issue.Resolution,
+			issue.ColumnID,
+			issue.MilestoneID,
+			issue.EpicID,
+			issue.AssigneeTeamID,
+		).Scan(&issue.ProjectID, &issue.IssueNumber, &issue.Version, &issue.UpdatedAt)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return pkgerrors.ErrNotFound
+			}
+			return err
+		}
+		issue.ColumnPosition = nil
+		issue.SprintID = nil
+		issue.IsPinned, issue.PinnedAt, issue.PinnedByUserID = false, nil, nil
+	}
+
+	sources := make([]int, 0, len(labelMapping))
+	targets := make([]int, 0, len(labelMapping))
+	for source, target := range labelMapping {
+		sources = append(sources, source)
+		targets = append(targets, target)
+	}
+	statements := []struct {
+		query string
+		args  []interface{}
+	}{
+		{`
+			INSERT INTO issue_labels (issue_id, label_id)
+			SELECT il.issue_id, m.target
+			FROM issue_labels il
+			JOIN unnest($2::int[], $3::int[]) AS m(source, target) ON m.source = il.label_id
+			WHERE il.issue_id = ANY($1)
+			ON CONFLICT DO NOTHING
+		`, []interface{}{pq.Array(issueIDs), pq.Array(sources), pq.Array(targets)}},
+		{`
+			DELETE FROM issue_labels il
+			USING labels l
+			WHERE l.id = il.label_id AND il.issue_id = ANY($1) AND l.project_id <> $2
+		`, []interface{}{pq.Array(issueIDs), targetProjectID}},
+		{`
+			UPDATE issue_custom_field_values v
+			SET field_id = target.id
+			FROM custom_fields source, custom_fields target
+			WHERE source.id = v.field_id AND v.issue_id = ANY($1)
+			  AND target.project_id = $2 AND target.key = source.key AND target.field_type = source.field_type
+			  AND source.project_id <> $2
+		`, []interface{}{pq.Array(issueIDs), targetProjectID}},
+		{`
+			DELETE FROM issue_custom_field_values v
+			USING custom_fields f
+			WHERE f.id = v.field_id AND v.issue_id = ANY($1) AND f.project_id <> $2
+		`, []interface{}{pq.Array(issueIDs), targetProjectID}},
+		// Issues left behind in the old project leave a moved epic
+		{`
+			UPDATE issues SET epic_id = NULL
+			WHERE epic_id = ANY($1) AND project_id <> $2
+		`, []interface{}{pq.Array(issueIDs), targetProjectID}},
+	}
+	for _, stmt := range statements {
+		if _, err := tx.ExecContext(ctx, stmt.query, stmt.args...); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// GetRedirectedIssueID returns the ID of the issue that was moved away from
+// a project key, e.g. PROJ-12
+func (r *IssueRepository) GetRedirectedIssueID(ctx context.Context, projectID int, issueNumber int) (int, error) {
+	var issueID int
+	err := r.db.QueryRowContext(ctx, `
+		SELECT issue_id FROM issue_key_redirects WHERE project_id = $1 AND issue_number = $2
+	`, projectID, issueNumber).Scan(&issueID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return 0, pkgerrors.ErrNotFound
+		}
+		return 0, err
+	}
+
+	return issueID, nil
 }
 
 // Search searches for issues by text in title and description
 func (r *IssueRepository) Search(ctx context.Context, projectID int, query string, limit int, offset int) ([]*models.Issue, error) {
 	searchQuery := `
-		SELECT id, project_id, issue_number, title, description, status,
-			column_id, column_position, priority, issue_type, parent_issue_id, epic_id,
-			assignee_id, reporter_id, milestone_id, version, created_at, updated_at, deleted_at
+		SELECT ` + issueColumns + `
 		FROM issues
 		WHERE project_id = $1
 			AND deleted_at IS NULL
@@ -414,27 +1007,7 @@ func (r *IssueRepository) Search(ctx context.Context, projectID int, query strin
 	issues := make([]*models.Issue, 0)
 	for rows.Next() {
 		var issue models.Issue
-		err := rows.Scan(
-			&issue.ID,
-			&issue.ProjectID,
-			&issue.IssueNumber,
-			&issue.Title,
-			&issue.Description,
-			&issue.Status,
-			&issue.ColumnID,
-			&issue.ColumnPosition,
-			&issue.Priority,
-			&issue.IssueType,
-			&issue.ParentIssueID,
-			&issue.EpicID,
-			&issue.AssigneeID,
-			&issue.ReporterID,
-			&issue.MilestoneID,
-			&issue.Version,
-			&issue.CreatedAt,
-			&issue.UpdatedAt,
-			&issue.DeletedAt,
-		)
+		err := scanIssue(rows, &issue)
 		if err != nil {
 			return nil, err
 		}
@@ -447,9 +1020,7 @@ func (r *IssueRepository) Search(ctx context.Context, projectID int, query strin
 // GetSubtasks retrieves all subtasks for a given issue
 func (r *IssueRepository) GetSubtasks(ctx context.Context, parentIssueID int) ([]*models.Issue, error) {
 	query := `
-		SELECT id, project_id, issue_number, title, description, status,
-			column_id, column_position, priority, issue_type, parent_issue_id, epic_id,
-			assignee_id, reporter_id, milestone_id, version, created_at, updated_at, deleted_at
+		SELECT ` + issueColumns + `
 		FROM issues
 		WHERE parent_issue_id = $1 AND deleted_at IS NULL
 		ORDER BY issue_number ASC
@@ -464,27 +1035,7 @@ func (r *IssueRepository) GetSubtasks(ctx context.Context, parentIssueID int) ([
 	subtasks := make([]*models.Issue, 0)
 	for rows.Next() {
 		var issue models.Issue
-		err := rows.Scan(
-			&issue.ID,
-			&issue.ProjectID,
-			&issue.IssueNumber,
-			&issue.Title,
-			&issue.Description,
-			&issue.Status,
-			&issue.ColumnID,
-			&issue.ColumnPosition,
-			&issue.Priority,
-			&issue.IssueType,
-			&issue.ParentIssueID,
-			&issue.EpicID,
-			&issue.AssigneeID,
-			&issue.ReporterID,
-			&issue.MilestoneID,
-			&issue.Version,
-			&issue.CreatedAt,
-			&issue.UpdatedAt,
-			&issue.DeletedAt,
-		)
+		err := scanIssue(rows, &issue)
 		if err != nil {
 			return nil, err
 		}
@@ -497,9 +1048,7 @@ func (r *IssueRepository) GetSubtasks(ctx context.Context, parentIssueID int) ([
 // GetEpicIssues retrieves all issues under a given epic
 func (r *IssueRepository) GetEpicIssues(ctx context.Context, epicID int) ([]*models.Issue, error) {
 	query := `
-		SELECT id, project_id, issue_number, title, description, status,
-			column_id, column_position, priority, issue_type, parent_issue_id, epic_id,
-			assignee_id, reporter_id, milestone_id, version, created_at, updated_at, deleted_at
+		SELECT ` + issueColumns + `
 		FROM issues
 		WHERE epic_id = $1 AND deleted_at IS NULL
 		ORDER BY issue_number ASC
@@ -514,27 +1063,7 @@ func (r *IssueRepository) GetEpicIssues(ctx context.Context, epicID int) ([]*mod
 	issues := make([]*models.Issue, 0)
 	for rows.Next() {
 		var issue models.Issue
-		err := rows.Scan(
-			&issue.ID,
-			&issue.ProjectID,
-			&issue.IssueNumber,
-			&issue.Title,
-			&issue.Description,
-			&issue.Status,
-			&issue.ColumnID,
-			&issue.ColumnPosition,
-			&issue.Priority,
-			&issue.IssueType,
-			&issue.ParentIssueID,
-			&issue.EpicID,
-			&issue.AssigneeID,
-			&issue.ReporterID,
-			&issue.MilestoneID,
-			&issue.Version,
-			&issue.CreatedAt,
-			&issue.UpdatedAt,
-			&issue.DeletedAt,
-		)
+		err := scanIssue(rows, &issue)
 		if err != nil {
 			return nil, err
 		}
@@ -547,9 +1076,7 @@ func (r *IssueRepository) GetEpicIssues(ctx context.Context, epicID int) ([]*mod
 // GetEpics retrieves all epics for a project
 func (r *IssueRepository) GetEpics(ctx context.Context, projectID int) ([]*models.Issue, error) {
 	query := `
-		SELECT id, project_id, issue_number, title, description, status,
-			column_id, column_position, priority, issue_type, parent_issue_id, epic_id,
-			assignee_id, reporter_id, milestone_id, version, created_at, updated_at, deleted_at
+		SELECT ` + issueColumns + `
 		FROM issues
 		WHERE project_id = $1 AND issue_type = 'epic' AND deleted_at IS NULL
 		ORDER BY issue_number DESC
@@ -564,27 +1091,7 @@ func (r *IssueRepository) GetEpics(ctx context.Context, projectID int) ([]*model
 	epics := make([]*models.Issue, 0)
 	for rows.Next() {
 		var issue models.Issue
-		err := rows.Scan(
-			&issue.ID,
-			&issue.ProjectID,
-			&issue.IssueNumber,
-			&issue.Title,
-			&issue.Description,
-			&issue.Status,
-			&issue.ColumnID,
-			&issue.ColumnPosition,
-			&issue.Priority,
-			&issue.IssueType,
-			&issue.ParentIssueID,
-			&issue.EpicID,
-			&issue.AssigneeID,
-			&issue.ReporterID,
-			&issue.MilestoneID,
-			&issue.Version,
-			&issue.CreatedAt,
-			&issue.UpdatedAt,
-			&issue.DeletedAt,
-		)
+		err := scanIssue(rows, &issue)
 		if err != nil {
 			return nil, err
 		}
@@ -594,30 +1101,64 @@ func (r *IssueRepository) GetEpics(ctx context.Context, projectID int) ([]*model
 	return epics, rows.Err()
 }
 
-// CountSubtasks counts the number of subtasks for an issue
-func (r *IssueRepository) CountSubtasks(ctx context.Context, parentIssueID int) (total int, completed int, err error) {
-	query := `
+// progressQuery aggregates the issues joined as i, grouped by the estimation
+// type of the project joined as p
+const progressQuery = `
 		SELECT
-			COUNT(*),
-			COUNT(*) FILTER (WHERE status = 'closed')
-		FROM issues
-		WHERE parent_issue_id = $1 AND deleted_at IS NULL
+			p.estimation_type,
+			COUNT(i.id),
+			COUNT(i.id) FILTER (WHERE i.status_category = 'done'),
+			COALESCE(SUM(i.story_points), 0),
+			COALESCE(SUM(i.story_points) FILTER (WHERE i.status_category = 'done'), 0),
+			COALESCE(SUM(i.original_estimate_minutes), 0),
+			COALESCE(SUM(i.original_estimate_minutes) FILTER (WHERE i.status_category = 'done'), 0)
+`
+
+// scanProgress scans a row selected with progressQuery
+func scanProgress(row rowScanner) (*models.IssueProgress, error) {
+	var progress models.IssueProgress
+	err := row.Scan(
+		&progress.EstimationType,
+		&progress.Total,
+		&progress.Completed,
+		&progress.TotalStoryPoints,
+		&progress.CompletedStoryPoints,
+		&progress.TotalEstimateMinutes,
+		&progress.CompletedEstimateMinutes,
+	)
+	if err == sql.ErrNoRows {
+		return nil, pkgerrors.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	progress.Calculate()
+	return &progress, nil
+}
+
+// CountSubtasks counts the subtasks of an issue and sums their estimates
+func (r *IssueRepository) CountSubtasks(ctx context.Context, parentIssueID int) (*models.IssueProgress, error) {
+	query := progressQuery + `
+		FROM issues parent
+		JOIN projects p ON p.id = parent.project_id
+		LEFT JOIN issues i ON i.parent_issue_id = parent.id AND i.deleted_at IS NULL
+		WHERE parent.id = $1
+		GROUP BY p.estimation_type
 	`
 
-	err = r.db.QueryRowContext(ctx, query, parentIssueID).Scan(&total, &completed)
-	return
+	return scanProgress(r.db.QueryRowContext(ctx, query, parentIssueID))
 }
 
-// CountEpicIssues counts the number of issues under an epic
-func (r *IssueRepository) CountEpicIssues(ctx context.Context, epicID int) (total int, completed int, err error) {
-	query := `
-		SELECT
-			COUNT(*),
-			COUNT(*) FILTER (WHERE status = 'closed')
-		FROM issues
-		WHERE epic_id = $1 AND deleted_at IS NULL
+// CountEpicIssues counts the issues under an epic and sums their estimates
+func (r *IssueRepository) CountEpicIssues(ctx context.Context, epicID int) (*models.IssueProgress, error) {
+	query := progressQuery + `
+		FROM issues epic
+		JOIN projects p ON p.id = epic.project_id
+		LEFT JOIN issues i ON i.epic_id = epic.id AND i.deleted_at IS NULL
+		WHERE epic.id = $1
+		GROUP BY p.estimation_type
 	`
 
-	err = r.db.QueryRowContext(ctx, query, epicID).Scan(&total, &completed)
-	return
+	return scanProgress(r.db.QueryRowContext(ctx, query, epicID))
 }
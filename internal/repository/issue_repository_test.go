@@ -3,6 +3,7 @@ package repository
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/yourusername/issue-tracker/internal/models"
 	"github.com/yourusername/issue-tracker/pkg/database"
@@ -401,7 +402,7 @@ func TestIssueRepository_Delete(t *testing.T) {
 	})
 
 	t.Run("should soft delete issue", func(t *testing.T) {
-		err := issueRepo.Delete(ctx, issue.ID)
+		err := issueRepo.Delete(ctx, issue.ID, user.ID)
 		if err != nil {
 			t.Fatalf("Expected no error, got %v", err)
 		}
@@ -413,3 +414,358 @@ func TestIssueRepository_Delete(t *testing.T) {
 		}
 	})
 }
+
+func TestIssueRepository_Trash(t *testing.T) {
+	issueRepo, userRepo, projectRepo, _, cleanup := setupIssueRepo(t)
+	defer cleanup()
+
+	ctx := context.Background()
+
+	// Setup
+	user, _ := userRepo.Create(ctx, &models.User{Email: "issuetest8@example.com", Username: "issuetest8", PasswordHash: "hash"})
+	project, _ := projectRepo.Create(ctx, &models.Project{Name: "Test", Key: "ISS7", OwnerID: user.ID})
+	parent, _ := issueRepo.Create(ctx, &models.Issue{
+		ProjectID:  project.ID,
+		Title:      "Parent",
+		Status:     models.IssueStatusOpen,
+		Priority:   models.PriorityMedium,
+		IssueType:  models.IssueTypeTask,
+		ReporterID: user.ID,
+	})
+	createSubtask := func(title string) *models.Issue {
+		subtask, err := issueRepo.Create(ctx, &models.Issue{
+			ProjectID:     project.ID,
+			Title:         title,
+			Status:        models.IssueStatusOpen,
+			Priority:      models.PriorityMedium,
+			IssueType:     models.IssueTypeSubtask,
+			ParentIssueID: &parent.ID,
+			ReporterID:    user.ID,
+		})
+		if err != nil {
+			t.Fatalf("Failed to create subtask: %v", err)
+		}
+		return subtask
+	}
+	deletedEarlier := createSubtask("Deleted earlier")
+	deletedWithParent := createSubtask("Deleted with parent")
+
+	if err := issueRepo.Delete(ctx, deletedEarlier.ID, user.ID); err != nil {
+		t.Fatalf("Failed to delete subtask: %v", err)
+	}
+	if err := issueRepo.Delete(ctx, parent.ID, user.ID); err != nil {
+		t.Fatalf("Failed to delete parent: %v", err)
+	}
+
+	t.Run("should move subtasks to the trash with their parent", func(t *testing.T) {
+		trashed, err := issueRepo.ListDeleted(ctx, project.ID)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if len(trashed) != 3 {
+			t.Fatalf("Expected 3 deleted issues, got %d", len(trashed))
+		}
+		for _, issue := range trashed {
+			if issue.DeletedByUserID == nil || *issue.DeletedByUserID != user.ID {
+				t.Errorf("Expected %q to be deleted by the user, got %v", issue.Title, issue.DeletedByUserID)
+			}
+		}
+	})
+
+	t.Run("should only purge top-level issues when they expire", func(t *testing.T) {
+		ids, err := issueRepo.ListExpiredDeleted(ctx, time.Now().Add(time.Minute))
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		for _, id := range ids {
+			if id == deletedEarlier.ID || id == deletedWithParent.ID {
+				t.Errorf("Expected subtask %d of a deleted parent to be left out", id)
+			}
+		}
+	})
+
+	t.Run("should restore the subtasks deleted with the parent", func(t *testing.T) {
+		deleted, err := issueRepo.GetDeletedByID(ctx, parent.ID)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if err := issueRepo.Restore(ctx, deleted, nil); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		if _, err := issueRepo.GetByID(ctx, deletedWithParent.ID); err != nil {
+			t.Errorf("Expected subtask deleted with the parent to be restored, got %v", err)
+		}
+		if _, err := issueRepo.GetByID(ctx, deletedEarlier.ID); err != pkgerrors.ErrNotFound {
+			t.Errorf("Expected subtask deleted earlier to stay in the trash, got %v", err)
+		}
+	})
+
+	t.Run("should purge only deleted issues", func(t *testing.T) {
+		if _, err := issueRepo.Purge(ctx, parent.ID); err != pkgerrors.ErrNotFound {
+			t.Errorf("Expected ErrNotFound for an issue outside the trash, got %v", err)
+		}
+
+		if _, err := issueRepo.Purge(ctx, deletedEarlier.ID); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if _, err := issueRepo.GetDeletedByID(ctx, deletedEarlier.ID); err != pkgerrors.ErrNotFound {
+			t.Errorf("Expected purged issue to be gone, got %v", err)
+		}
+	})
+}
+
+func TestIssueRepository_MoveToProject(t *testing.T) {
+	issueRepo, userRepo, projectRepo, _, cleanup := setupIssueRepo(t)
+	defer cleanup()
+
+	ctx := context.Background()
+
+	// Setup
+	user, _ := userRepo.Create(ctx, &models.User{Email: "issuetest9@example.com", Username: "issuetest9", PasswordHash: "hash"})
+	source, _ := projectRepo.Create(ctx, &models.Project{Name: "Source", Key: "ISS8", OwnerID: user.ID})
+	target, _ := projectRepo.Create(ctx, &models.Project{Name: "Target", Key: "ISS9", OwnerID: user.ID})
+	issue, _ := issueRepo.Create(ctx, &models.Issue{
+		ProjectID:  source.ID,
+		Title:      "Filed in the wrong project",
+		Status:     models.IssueStatusOpen,
+		Priority:   models.PriorityMedium,
+		IssueType:  models.IssueTypeTask,
+		ReporterID: user.ID,
+	})
+	oldNumber := issue.IssueNumber
+
+	t.Run("should give the issue a number in the target project", func(t *testing.T) {
+		err := issueRepo.MoveToProject(ctx, []*models.Issue{issue}, target.ID, nil)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		moved, err := issueRepo.GetByProjectAndNumber(ctx, target.ID, issue.IssueNumber)
+		if err != nil {
+			t.Fatalf("Expected issue in the target project, got %v", err)
+		}
+		if moved.ID != issue.ID {
+			t.Errorf("Expected issue %d, got %d", issue.ID, moved.ID)
+		}
+	})
+
+	t.Run("should redirect the old key", func(t *testing.T) {
+		if _, err := issueRepo.GetByProjectAndNumber(ctx, source.ID, oldNumber); err != pkgerrors.ErrNotFound {
+			t.Errorf("Expected ErrNotFound for the old key, got %v", err)
+		}
+
+		issueID, err := issueRepo.GetRedirectedIssueID(ctx, source.ID, oldNumber)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if issueID != issue.ID {
+			t.Errorf("Expected the old key to redirect to %d, got %d", issue.ID, issueID)
+		}
+	})
+}
+
+func TestIssueRepository_Assignees(t *testing.T) {
+	issueRepo, userRepo, projectRepo, _, cleanup := setupIssueRepo(t)
+	defer cleanup()
+
+	ctx := context.Background()
+
+	// Setup
+	alice, _ := userRepo.Create(ctx, &models.User{Email: "issuetest10@example.com", Username: "issuetest10", PasswordHash: "hash"})
+	bob, _ := userRepo.Create(ctx, &models.User{Email: "issuetest11@example.com", Username: "issuetest11", PasswordHash: "hash"})
+	carol, _ := userRepo.Create(ctx, &models.User{Email: "issuetest12@example.com", Username: "issuetest12", PasswordHash: "hash"})
+	project, _ := projectRepo.Create(ctx, &models.Project{Name: "Test", Key: "ISS10", OwnerID: alice.ID})
+	issue, _ := issueRepo.Create(ctx, &models.Issue{
+		ProjectID:  project.ID,
+		Title:      "Shared work",
+		Status:     models.IssueStatusOpen,
+		Priority:   models.PriorityMedium,
+		ReporterID: alice.ID,
+		AssigneeID: &alice.ID,
+	})
+
+	assigneeIDs := func() []int {
+		t.Helper()
+		assignees, err := issueRepo.ListAssigneesByIssueIDs(ctx, []int{issue.ID})
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		ids := make([]int, 0)
+		for _, user := range assignees[issue.ID] {
+			ids = append(ids, user.ID)
+		}
+		return ids
+	}
+
+	t.Run("should add the primary assignee to the assignees", func(t *testing.T) {
+		ids := assigneeIDs()
+		if len(ids) != 1 || ids[0] != alice.ID {
+			t.Errorf("Expected [%d], got %v", alice.ID, ids)
+		}
+	})
+
+	t.Run("should set co-assignees with the primary first", func(t *testing.T) {
+		if err := issueRepo.SetAssignees(ctx, issue.ID, []int{alice.ID, carol.ID, bob.ID}); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		ids := assigneeIDs()
+		if len(ids) != 3 || ids[0] != alice.ID {
+			t.Errorf("Expected 3 assignees starting with %d, got %v", alice.ID, ids)
+		}
+	})
+
+	t.Run("should filter by co-assignee", func(t *testing.T) {
+		issues, err := issueRepo.List(ctx, &models.IssueFilter{ProjectID: project.ID, AssigneeID: &bob.ID})
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if len(issues) != 1 || issues[0].ID != issue.ID {
+			t.Errorf("Expected the shared issue, got %d issues", len(issues))
+		}
+	})
+
+	t.Run("should replace the old primary when assignee_id changes", func(t *testing.T) {
+		if err := issueRepo.SetAssignees(ctx, issue.ID, []int{alice.ID}); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		current, _ := issueRepo.GetByID(ctx, issue.ID)
+		current.AssigneeID = &bob.ID
+		if err := issueRepo.Update(ctx, current); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		ids := assigneeIDs()
+		if len(ids) != 1 || ids[0] != bob.ID {
+			t.Errorf("Expected [%d], got %v", bob.ID, ids)
+		}
+	})
+
+	t.Run("should remove every assignee", func(t *testing.T) {
+		current, _ := issueRepo.GetByID(ctx, issue.ID)
+		current.AssigneeID = nil
+		if err := issueRepo.Update(ctx, current); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if err := issueRepo.SetAssignees(ctx, issue.ID, nil); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		if ids := assigneeIDs(); len(ids) != 0 {
+			t.Errorf("Expected no assignees, got %v", ids)
+		}
+	})
+}
+
+func TestIssueRepository_Pin(t *testing.T) {
+	issueRepo, userRepo, projectRepo, _, cleanup := setupIssueRepo(t)
+	defer cleanup()
+
+	ctx := context.Background()
+
+	// Setup
+	user, _ := userRepo.Create(ctx, &models.User{Email: "issuetest13@example.com", Username: "issuetest13", PasswordHash: "hash"})
+	project, _ := projectRepo.Create(ctx, &models.Project{Name: "Test", Key: "ISS11", OwnerID: user.ID})
+	issues := make([]*models.Issue, 3)
+	for i := range issues {
+		issues[i], _ = issueRepo.Create(ctx, &models.Issue{
+			ProjectID:  project.ID,
+			Title:      "Known outage",
+			Status:     models.IssueStatusOpen,
+			Priority:   models.PriorityMedium,
+			ReporterID: user.ID,
+		})
+	}
+	oldest := issues[0]
+
+	t.Run("should list pinned issues first", func(t *testing.T) {
+		if err := issueRepo.Pin(ctx, oldest, user.ID, 2); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		listed, err := issueRepo.List(ctx, &models.IssueFilter{ProjectID: project.ID})
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if len(listed) != 3 || listed[0].ID != oldest.ID {
+			t.Fatalf("Expected the pinned issue first, got %d issues", len(listed))
+		}
+		if !listed[0].IsPinned || listed[0].PinnedByUserID == nil || *listed[0].PinnedByUserID != user.ID {
+			t.Errorf("Expected pin details, got %+v", listed[0])
+		}
+	})
+
+	t.Run("should list pinned issues first with an explicit sort", func(t *testing.T) {
+		listed, err := issueRepo.List(ctx, &models.IssueFilter{ProjectID: project.ID, Sort: "-created_at"})
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if len(listed) != 3 || listed[0].ID != oldest.ID {
+			t.Fatalf("Expected the pinned issue first, got %d issues", len(listed))
+		}
+		if listed[1].ID != issues[2].ID || listed[2].ID != issues[1].ID {
+			t.Errorf("Expected unpinned issues newest first, got %d and %d", listed[1].ID, listed[2].ID)
+		}
+	})
+
+	t.Run("should filter by pinned", func(t *testing.T) {
+		pinned := true
+		listed, err := issueRepo.List(ctx, &models.IssueFilter{ProjectID: project.ID, Pinned: &pinned})
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if len(listed) != 1 || listed[0].ID != oldest.ID {
+			t.Errorf("Expected only the pinned issue, got %d issues", len(listed))
+		}
+	})
+
+	t.Run("should enforce the per-project limit", func(t *testing.T) {
+		if err := issueRepo.Pin(ctx, issues[1], user.ID, 2); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if err := issueRepo.Pin(ctx, issues[2], user.ID, 2); err != pkgerrors.ErrConflict {
+			t.Errorf("Expected ErrConflict, got %v", err)
+		}
+	})
+
+	t.Run("should unpin an issue", func(t *testing.T) {
+		if err := issueRepo.Unpin(ctx, oldest); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		unpinned, _ := issueRepo.GetByID(ctx, oldest.ID)
+		if unpinned.IsPinned || unpinned.PinnedAt != nil {
+			t.Errorf("Expected the issue to be unpinned, got %+v", unpinned)
+		}
+	})
+
+	t.Run("should unpin trashed issues so restoring stays within the limit", func(t *testing.T) {
+		if err := issueRepo.Delete(ctx, issues[1].ID, user.ID); err != nil {
+			t.Fatalf("Failed to trash issue: %v", err)
+		}
+		for _, issue := range []*models.Issue{oldest, issues[2]} {
+			if err := issueRepo.Pin(ctx, issue, user.ID, 2); err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+		}
+
+		deleted, err := issueRepo.GetDeletedByID(ctx, issues[1].ID)
+		if err != nil {
+			t.Fatalf("Failed to get trashed issue: %v", err)
+		}
+		if err := issueRepo.Restore(ctx, deleted, nil); err != nil {
+			t.Fatalf("Failed to restore issue: %v", err)
+		}
+
+		pinned := true
+		listed, err := issueRepo.List(ctx, &models.IssueFilter{ProjectID: project.ID, Pinned: &pinned})
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if len(listed) != 2 {
+			t.Errorf("Expected 2 pinned issues, got %d", len(listed))
+		}
+	})
+}
@@ -0,0 +1,264 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/lib/pq"
+	"github.com/yourusername/issue-tracker/internal/models"
+	pkgerrors "github.com/yourusername/issue-tracker/pkg/errors"
+)
+
+// IssueVoteRepository handles issue votes and customer requests
+// Both keep totals on the issue row so issue lists can sort and filter by
+// them; changes lock the issue so concurrent votes count correctly
+type IssueVoteRepository struct {
+	db *sql.DB
+}
+
+// NewIssueVoteRepository creates a new issue vote repository
+func NewIssueVoteRepository(db *sql.DB) *IssueVoteRepository {
+	return &IssueVoteRepository{db: db}
+}
+
+// lockIssue locks a live issue for the rest of the transaction
+func lockIssue(ctx context.Context, tx *sql.Tx, issueID int) error {
+	var id int
+	err := tx.QueryRowContext(ctx, "SELECT id FROM issues WHERE id = $1 AND deleted_at IS NULL FOR UPDATE", issueID).Scan(&id)
+	if err == sql.ErrNoRows {
+		return pkgerrors.ErrNotFound
+	}
+	return err
+}
+
+// countVotes recounts the vote totals of an issue
+func countVotes(ctx context.Context, tx *sql.Tx, issueID int) error {
+	_, err := tx.ExecContext(ctx, `
+		UPDATE issues
+		SET upvotes = (SELECT COUNT(*) FROM issue_votes WHERE issue_id = $1 AND value = 1),
+			downvotes = (SELECT COUNT(*) FROM issue_votes WHERE issue_id = $1 AND value = -1)
+		WHERE id = $1
+	`, issueID)
+	return err
+}
+
+// deleteUserVotes removes the votes of a user and recounts the totals of the
+// issues they were cast on
+func deleteUserVotes(ctx context.Context, tx *sql.Tx, userID int) error {
+	rows, err := tx.QueryContext(ctx, `
+		DELETE FROM issue_votes WHERE user_id = $1 RETURNING issue_id
+	`, userID)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	var issueIDs []int
+	for rows.Next() {
+		var issueID int
+		if err := rows.Scan(&issueID); err != nil {
+			return err
+		}
+		issueIDs = append(issueIDs, issueID)
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	rows.Close()
+
+	for _, issueID := range issueIDs {
+		if err := countVotes(ctx, tx, issueID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// countCustomerRequests recounts the customer requests of an issue
+func countCustomerRequests(ctx context.Context, tx *sql.Tx, issueID int) error {
+	_, err := tx.ExecContext(ctx, `
+		UPDATE issues
+		SET customer_request_count = (SELECT COUNT(*) FROM issue_customer_requests WHERE issue_id = $1)
+		WHERE id = $1
+	`, issueID)
+	return err
+}
+
+// Vote sets a user's vote on an issue, replacing an earlier vote
+func (r *IssueVoteRepository) Vote(ctx context.Context, vote *models.IssueVote) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := lockIssue(ctx, tx, vote.IssueID); err != nil {
+		return err
+	}
+
+	err = tx.QueryRowContext(ctx, `
+		INSERT INTO issue_votes (issue_id, user_id, value)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (issue_id, user_id) DO UPDATE
+		SET value = EXCLUDED.value,
+			updated_at = CASE WHEN issue_votes.value = EXCLUDED.value THEN issue_votes.updated_at ELSE NOW() END
+		RETURNING created_at, updated_at
+	`, vote.IssueID, vote.UserID, vote.Value).Scan(&vote.CreatedAt, &vote.UpdatedAt)
+	if err != nil {
+		return err
+	}
+
+	if err := countVotes(ctx, tx, vote.IssueID); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// Unvote removes a user's vote from an issue
+// Removing a vote that doesn't exist changes nothing
+func (r *IssueVoteRepository) Unvote(ctx context.Context, issueID, userID int) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := lockIssue(ctx, tx, issueID); err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, "DELETE FROM issue_votes WHERE issue_id = $1 AND user_id = $2", issueID, userID)
+	if err != nil {
+		return err
+	}
+
+	if err := countVotes(ctx, tx, issueID); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// GetSummary returns the vote totals of an issue and the vote of a user
+func (r *IssueVoteRepository) GetSummary(ctx context.Context, issueID, userID int) (*models.IssueVoteSummary, error) {
+	summary := &models.IssueVoteSummary{IssueID: issueID}
+	err := r.db.QueryRowContext(ctx, `
+		SELECT i.upvotes, i.downvotes, i.vote_score, COALESCE(v.value, 0)
+		FROM issues i
+		LEFT JOIN issue_votes v ON v.issue_id = i.id AND v.user_id = $2
+		WHERE i.id = $1 AND i.deleted_at IS NULL
+	`, issueID, userID).Scan(&summary.Upvotes, &summary.Downvotes, &summary.Score, &summary.UserVote)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, pkgerrors.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return summary, nil
+}
+
+// AddCustomerRequest records a customer request on an issue
+// Returns ErrConflict when the organization already requested the issue
+func (r *IssueVoteRepository) AddCustomerRequest(ctx context.Context, request *models.CustomerRequest) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := lockIssue(ctx, tx, request.IssueID); err != nil {
+		return err
+	}
+
+	err = tx.QueryRowContext(ctx, `
+		INSERT INTO issue_customer_requests (issue_id, organization_name, note, created_by)
+		VALUES ($1, $2, $3, $4)
+		RETURNING id, created_at
+	`, request.IssueID, request.OrganizationName, request.Note, request.CreatedBy).Scan(&request.ID, &request.CreatedAt)
+	if err != nil {
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code == "23505" { // Organization already requested the issue
+			return pkgerrors.ErrConflict
+		}
+		return err
+	}
+
+	if err := countCustomerRequests(ctx, tx, request.IssueID); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// GetCustomerRequest retrieves a customer request by ID
+func (r *IssueVoteRepository) GetCustomerRequest(ctx context.Context, id int) (*models.CustomerRequest, error) {
+	var request models.CustomerRequest
+	err := r.db.QueryRowContext(ctx, `
+		SELECT id, issue_id, organization_name, note, created_by, created_at
+		FROM issue_customer_requests
+		WHERE id = $1
+	`, id).Scan(&request.ID, &request.IssueID, &request.OrganizationName, &request.Note, &request.CreatedBy, &request.CreatedAt)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, pkgerrors.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return &request, nil
+}
+
+// ListCustomerRequests lists the customer requests of an issue, oldest first
+func (r *IssueVoteRepository) ListCustomerRequests(ctx context.Context, issueID int) ([]*models.CustomerRequest, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT id, issue_id, organization_name, note, created_by, created_at
+		FROM issue_customer_requests
+		WHERE issue_id = $1
+		ORDER BY created_at, id
+	`, issueID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	requests := make([]*models.CustomerRequest, 0)
+	for rows.Next() {
+		var request models.CustomerRequest
+		if err := rows.Scan(&request.ID, &request.IssueID, &request.OrganizationName, &request.Note, &request.CreatedBy, &request.CreatedAt); err != nil {
+			return nil, err
+		}
+		requests = append(requests, &request)
+	}
+
+	return requests, rows.Err()
+}
+
+// DeleteCustomerRequest removes a customer request from its issue
+func (r *IssueVoteRepository) DeleteCustomerRequest(ctx context.Context, request *models.CustomerRequest) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := lockIssue(ctx, tx, request.IssueID); err != nil {
+		return err
+	}
+
+	result, err := tx.ExecContext(ctx, "DELETE FROM issue_customer_requests WHERE id = $1", request.ID)
+	if err != nil {
+		return err
+	}
+	if err := requireRowsAffected(result); err != nil {
+		return err
+	}
+
+	if err := countCustomerRequests(ctx, tx, request.IssueID); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
@@ -0,0 +1,108 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/yourusername/issue-tracker/internal/models"
+	pkgerrors "github.com/yourusername/issue-tracker/pkg/errors"
+)
+
+func TestIssueVoteRepository(t *testing.T) {
+	issueRepo, userRepo, projectRepo, _, cleanup := setupIssueRepo(t)
+	defer cleanup()
+
+	voteRepo := NewIssueVoteRepository(issueRepo.db)
+	ctx := context.Background()
+
+	// Setup
+	alice, _ := userRepo.Create(ctx, &models.User{Email: "issuetest14@example.com", Username: "issuetest14", PasswordHash: "hash"})
+	bob, _ := userRepo.Create(ctx, &models.User{Email: "issuetest15@example.com", Username: "issuetest15", PasswordHash: "hash"})
+	project, _ := projectRepo.Create(ctx, &models.Project{Name: "Test", Key: "ISS12", OwnerID: alice.ID})
+	issues := make([]*models.Issue, 2)
+	for i := range issues {
+		issues[i], _ = issueRepo.Create(ctx, &models.Issue{
+			ProjectID:  project.ID,
+			Title:      "Feature request",
+			Status:     models.IssueStatusOpen,
+			Priority:   models.PriorityMedium,
+			ReporterID: alice.ID,
+		})
+	}
+	wanted := issues[0]
+
+	t.Run("should count one vote per user", func(t *testing.T) {
+		for _, vote := range []*models.IssueVote{
+			{IssueID: wanted.ID, UserID: alice.ID, Value: models.VoteDown},
+			{IssueID: wanted.ID, UserID: alice.ID, Value: models.VoteUp},
+			{IssueID: wanted.ID, UserID: bob.ID, Value: models.VoteUp},
+		} {
+			if err := voteRepo.Vote(ctx, vote); err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+		}
+
+		summary, err := voteRepo.GetSummary(ctx, wanted.ID, alice.ID)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if summary.Upvotes != 2 || summary.Downvotes != 0 || summary.Score != 2 || summary.UserVote != models.VoteUp {
+			t.Errorf("Expected 2 upvotes including alice's, got %+v", summary)
+		}
+	})
+
+	t.Run("should sort and filter issues by votes", func(t *testing.T) {
+		minVotes := 1
+		listed, err := issueRepo.List(ctx, &models.IssueFilter{ProjectID: project.ID, Sort: "-votes", MinVotes: &minVotes})
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if len(listed) != 1 || listed[0].ID != wanted.ID || listed[0].VoteScore != 2 {
+			t.Errorf("Expected only the voted issue, got %d issues", len(listed))
+		}
+	})
+
+	t.Run("should remove a vote", func(t *testing.T) {
+		if err := voteRepo.Unvote(ctx, wanted.ID, bob.ID); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		summary, _ := voteRepo.GetSummary(ctx, wanted.ID, bob.ID)
+		if summary.Upvotes != 1 || summary.UserVote != 0 {
+			t.Errorf("Expected 1 upvote and no vote by bob, got %+v", summary)
+		}
+	})
+
+	t.Run("should record each customer once", func(t *testing.T) {
+		request := &models.CustomerRequest{IssueID: wanted.ID, OrganizationName: "Acme Corp", CreatedBy: &alice.ID}
+		if err := voteRepo.AddCustomerRequest(ctx, request); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		duplicate := &models.CustomerRequest{IssueID: wanted.ID, OrganizationName: "ACME corp"}
+		if err := voteRepo.AddCustomerRequest(ctx, duplicate); err != pkgerrors.ErrConflict {
+			t.Errorf("Expected ErrConflict, got %v", err)
+		}
+
+		updated, _ := issueRepo.GetByID(ctx, wanted.ID)
+		if updated.CustomerRequests != 1 {
+			t.Errorf("Expected 1 customer request, got %d", updated.CustomerRequests)
+		}
+	})
+
+	t.Run("should recount after deleting a customer request", func(t *testing.T) {
+		requests, err := voteRepo.ListCustomerRequests(ctx, wanted.ID)
+		if err != nil || len(requests) != 1 {
+			t.Fatalf("Expected 1 customer request, got %d (%v)", len(requests), err)
+		}
+
+		if err := voteRepo.DeleteCustomerRequest(ctx, requests[0]); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		updated, _ := issueRepo.GetByID(ctx, wanted.ID)
+		if updated.CustomerRequests != 0 {
+			t.Errorf("Expected no customer requests, got %d", updated.CustomerRequests)
+		}
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 
+	"github.com/lib/pq"
 	"github.com/yourusername/issue-tracker/internal/models"
 	pkgerrors "github.com/yourusername/issue-tracker/pkg/errors"
 )
@@ -231,3 +232,48 @@ func (r *LabelRepository) ListByIssueID(ctx context.Context, issueID int) ([]*mo
 
 	return labels, nil
 }
+
+// ListByIssueIDs retrieves the labels of several issues, keyed by issue ID
+func (r *LabelRepository) ListByIssueIDs(ctx context.Context, issueIDs []int) (map[int][]*models.Label, error) {
+	labels := make(map[int][]*models.Label)
+	if len(issueIDs) == 0 {
+		return labels, nil
+	}
+
+	query := `
+		SELECT il.issue_id, l.id, l.project_id, l.name, l.color, l.created_at
+		FROM labels l
+		INNER JOIN issue_labels il ON l.id = il.label_id
+		WHERE il.issue_id = ANY($1)
+		ORDER BY l.name ASC
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, pq.Array(issueIDs))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var issueID int
+		var label models.Label
+		err := rows.Scan(
+			&issueID,
+			&label.ID,
+			&label.ProjectID,
+			&label.Name,
+			&label.Color,
+			&label.CreatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		labels[issueID] = append(labels[issueID], &label)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return labels, nil
+}
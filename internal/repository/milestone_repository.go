@@ -169,16 +169,24 @@ func (r *MilestoneRepository) Delete(ctx context.Context, id int) error {
 }
 
 // GetWithProgress retrieves a milestone with progress calculation
+// Progress is weighted by the story points or time estimates of its issues,
+// depending on the project's estimation type
 func (r *MilestoneRepository) GetWithProgress(ctx context.Context, id int) (*models.Milestone, error) {
 	query := `
 		SELECT
 			m.id, m.project_id, m.title, m.description, m.due_date, m.status, m.created_at, m.updated_at,
 			COUNT(i.id) AS total_issues,
-			COUNT(CASE WHEN i.status = 'closed' THEN 1 END) AS closed_issues
+			COUNT(CASE WHEN i.status_category = 'done' THEN 1 END) AS closed_issues,
+			COALESCE(SUM(i.story_points), 0) AS total_story_points,
+			COALESCE(SUM(CASE WHEN i.status_category = 'done' THEN i.story_points END), 0) AS completed_story_points,
+			COALESCE(SUM(i.original_estimate_minutes), 0) AS total_estimate_minutes,
+			COALESCE(SUM(CASE WHEN i.status_category = 'done' THEN i.original_estimate_minutes END), 0) AS completed_estimate_minutes,
+			p.estimation_type
 		FROM milestones m
+		JOIN projects p ON p.id = m.project_id
 		LEFT JOIN issues i ON m.id = i.milestone_id AND i.deleted_at IS NULL
 		WHERE m.id = $1
-		GROUP BY m.id, m.project_id, m.title, m.description, m.due_date, m.status, m.created_at, m.updated_at
+		GROUP BY m.id, m.project_id, m.title, m.description, m.due_date, m.status, m.created_at, m.updated_at, p.estimation_type
 	`
 
 	milestone := &models.Milestone{}
@@ -193,6 +201,11 @@ func (r *MilestoneRepository) GetWithProgress(ctx context.Context, id int) (*mod
 		&milestone.UpdatedAt,
 		&milestone.TotalIssues,
 		&milestone.ClosedIssues,
+		&milestone.TotalStoryPoints,
+		&milestone.CompletedStoryPoints,
+		&milestone.TotalEstimateMinutes,
+		&milestone.CompletedEstimateMinutes,
+		&milestone.EstimationType,
 	)
 
 	if err == sql.ErrNoRows {
@@ -204,11 +217,10 @@ func (r *MilestoneRepository) GetWithProgress(ctx context.Context, id int) (*mod
 	}
 
 	// Calculate progress percentage
-	if milestone.TotalIssues > 0 {
-		milestone.Progress = (milestone.ClosedIssues * 100) / milestone.TotalIssues
-	} else {
-		milestone.Progress = 0
-	}
+	milestone.Progress = models.WeightedProgress(milestone.EstimationType,
+		milestone.TotalIssues, milestone.ClosedIssues,
+		milestone.TotalStoryPoints, milestone.CompletedStoryPoints,
+		milestone.TotalEstimateMinutes, milestone.CompletedEstimateMinutes)
 
 	return milestone, nil
 }
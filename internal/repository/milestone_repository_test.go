@@ -342,3 +342,61 @@ func TestMilestoneRepository_GetWithProgress(t *testing.T) {
 		t.Errorf("Expected progress %d%%, got %d%%", expectedProgress, withProgress.Progress)
 	}
 }
+
+func TestMilestoneRepository_GetWithProgress_StoryPoints(t *testing.T) {
+	milestoneRepo, projectRepo, userRepo, cleanup := setupMilestoneRepo(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	project, user := createTestProjectForMilestone(t, projectRepo, userRepo)
+
+	created, err := milestoneRepo.Create(ctx, &models.Milestone{
+		ProjectID: project.ID,
+		Title:     "Weighted Progress",
+		Status:    models.MilestoneStatusOpen,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create milestone: %v", err)
+	}
+
+	// One done 8-point issue and two open issues worth 2 points in total
+	issueRepo := NewIssueRepository(milestoneRepo.db)
+	issues := []struct {
+		points   float64
+		category models.StatusCategory
+	}{
+		{8, models.StatusCategoryDone},
+		{1, models.StatusCategoryTodo},
+		{1, models.StatusCategoryTodo},
+	}
+	for _, tc := range issues {
+		points := tc.points
+		_, err := issueRepo.Create(ctx, &models.Issue{
+			ProjectID:      project.ID,
+			Title:          "Estimated Issue",
+			ReporterID:     user.ID,
+			Priority:       models.PriorityMedium,
+			Status:         models.IssueStatusOpen,
+			StatusCategory: tc.category,
+			MilestoneID:    &created.ID,
+			StoryPoints:    &points,
+		})
+		if err != nil {
+			t.Fatalf("Failed to create issue: %v", err)
+		}
+	}
+
+	withProgress, err := milestoneRepo.GetWithProgress(ctx, created.ID)
+	if err != nil {
+		t.Fatalf("GetWithProgress failed: %v", err)
+	}
+
+	if withProgress.TotalStoryPoints != 10 || withProgress.CompletedStoryPoints != 8 {
+		t.Errorf("Expected 8 of 10 story points, got %v of %v", withProgress.CompletedStoryPoints, withProgress.TotalStoryPoints)
+	}
+
+	// Weighted by points: 8/10 rather than 1/3 of the issues
+	if withProgress.Progress != 80 {
+		t.Errorf("Expected progress 80%%, got %d%%", withProgress.Progress)
+	}
+}
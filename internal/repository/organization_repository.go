@@ -0,0 +1,387 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/lib/pq"
+	"github.com/yourusername/issue-tracker/internal/models"
+	pkgerrors "github.com/yourusername/issue-tracker/pkg/errors"
+)
+
+// OrganizationRepository handles organization and organization member data access
+type OrganizationRepository struct {
+	db *sql.DB
+}
+
+// NewOrganizationRepository creates a new organization repository
+func NewOrganizationRepository(db *sql.DB) *OrganizationRepository {
+	return &OrganizationRepository{db: db}
+}
+
+const organizationColumns = `
+	o.id, o.name, o.slug, o.default_project_role, o.default_project_template_id,
+	o.allowed_integrations, o.require_2fa, o.created_at, o.updated_at
+`
+
+// scanOrganization scans the organizationColumns (plus any extra destinations)
+func scanOrganization(row rowScanner, org *models.Organization, extra ...interface{}) error {
+	var allowed pq.StringArray
+	dest := []interface{}{
+		&org.ID,
+		&org.Name,
+		&org.Slug,
+		&org.Settings.DefaultProjectRole,
+		&org.Settings.DefaultProjectTemplateID,
+		&allowed,
+		&org.Settings.Require2FA,
+		&org.CreatedAt,
+		&org.UpdatedAt,
+	}
+	if err := row.Scan(append(dest, extra...)...); err != nil {
+		return err
+	}
+	if allowed != nil {
+		org.Settings.AllowedIntegrations = []string(allowed)
+	}
+	return nil
+}
+
+// Create creates an organization and adds the creator as its owner
+func (r *OrganizationRepository) Create(ctx context.Context, org *models.Organization, ownerID int) (*models.Organization, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	var created models.Organization
+	row := tx.QueryRowContext(ctx, `
+		INSERT INTO organizations AS o (name, slug)
+		VALUES ($1, $2)
+		RETURNING `+organizationColumns, org.Name, org.Slug)
+	if err := scanOrganization(row, &created); err != nil {
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code == "23505" {
+			return nil, pkgerrors.ErrConflict
+		}
+		return nil, err
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO organization_members (organization_id, user_id, role)
+		VALUES ($1, $2, $3)
+	`, created.ID, ownerID, models.OrgRoleOwner)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	created.Role = models.OrgRoleOwner
+	return &created, nil
+}
+
+// GetByID retrieves an organization by ID
+func (r *OrganizationRepository) GetByID(ctx context.Context, id int) (*models.Organization, error) {
+	var org models.Organization
+	row := r.db.QueryRowContext(ctx, `SELECT `+organizationColumns+` FROM organizations o WHERE o.id = $1`, id)
+	if err := scanOrganization(row, &org); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, pkgerrors.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return &org, nil
+}
+
+// GetByProjectID retrieves the organization a project belongs to
+// Returns ErrNotFound for personal projects
+func (r *OrganizationRepository) GetByProjectID(ctx context.Context, projectID int) (*models.Organization, error) {
+	var org models.Organization
+	row := r.db.QueryRowContext(ctx, `
+		SELECT `+organizationColumns+`
+		FROM organizations o
+		JOIN projects p ON p.organization_id = o.id
+		WHERE p.id = $1
+	`, projectID)
+	if err := scanOrganization(row, &org); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, pkgerrors.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return &org, nil
+}
+
+// ListByUserID retrieves the organizations a user is a member of, with the user's role
+func (r *OrganizationRepository) ListByUserID(ctx context.Context, userID int) ([]*models.Organization, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT `+organizationColumns+`, om.role
+		FROM organizations o
+		JOIN organization_members om ON om.organization_id = o.id
+		WHERE om.user_id = $1
+		ORDER BY o.name
+	`, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	orgs := make([]*models.Organization, 0)
+	for rows.Next() {
+		var org models.Organization
+		if err := scanOrganization(rows, &org, &org.Role); err != nil {
+			return nil, err
+		}
+		orgs = append(orgs, &org)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return orgs, nil
+}
+
+// Update updates the name of an organization
+func (r *OrganizationRepository) Update(ctx context.Context, org *models.Organization) error {
+	result, err := r.db.ExecContext(ctx, `
+		UPDATE organizations SET name = $1, updated_at = NOW() WHERE id = $2
+	`, org.Name, org.ID)
+	if err != nil {
+		return err
+	}
+
+	return requireRowsAffected(result)
+}
+
+// UpdateSettings replaces the org-wide settings of an organization
+func (r *OrganizationRepository) UpdateSettings(ctx context.Context, orgID int, settings *models.OrganizationSettings) error {
+	var allowed interface{}
+	if settings.AllowedIntegrations != nil {
+		allowed = pq.Array(settings.AllowedIntegrations)
+	}
+
+	result, err := r.db.ExecContext(ctx, `
+		UPDATE organizations
+		SET default_project_role = $1, default_project_template_id = $2,
+		    allowed_integrations = $3, require_2fa = $4, updated_at = NOW()
+		WHERE id = $5
+	`, settings.DefaultProjectRole, settings.DefaultProjectTemplateID, allowed, settings.Require2FA, orgID)
+	if err != nil {
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code == "23503" { // Template does not exist
+			return pkgerrors.ErrValidation
+		}
+		return err
+	}
+
+	return requireRowsAffected(result)
+}
+
+// Delete deletes an organization
+// Organizations that still own projects cannot be deleted
+func (r *OrganizationRepository) Delete(ctx context.Context, id int) error {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM organizations WHERE id = $1`, id)
+	if err != nil {
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code == "23503" { // Projects still reference it
+			return pkgerrors.ErrConflict
+		}
+		return err
+	}
+
+	return requireRowsAffected(result)
+}
+
+// AddMember adds a user to an organization
+func (r *OrganizationRepository) AddMember(ctx context.Context, member *models.OrganizationMember) error {
+	_, err := r.db.ExecContext(ctx, `
+		INSERT INTO organization_members (organization_id, user_id, role, invited_by)
+		VALUES ($1, $2, $3, $4)
+	`, member.OrganizationID, member.UserID, member.Role, member.InvitedBy)
+	if err != nil {
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code == "23505" {
+			return pkgerrors.ErrConflict
+		}
+		return err
+	}
+
+	return nil
+}
+
+// GetMember retrieves a member of an organization
+func (r *OrganizationRepository) GetMember(ctx context.Context, orgID, userID int) (*models.OrganizationMember, error) {
+	member := &models.OrganizationMember{}
+	err := r.db.QueryRowContext(ctx, `
+		SELECT organization_id, user_id, role, joined_at, invited_by
+		FROM organization_members
+		WHERE organization_id = $1 AND user_id = $2
+	`, orgID, userID).Scan(
+		&member.OrganizationID,
+		&member.UserID,
+		&member.Role,
+		&member.JoinedAt,
+		&member.InvitedBy,
+	)
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, pkgerrors.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return member, nil
+}
+
+// ListMembers retrieves all members of an organization with their user information
+func (r *OrganizationRepository) ListMembers(ctx context.Context, orgID int) ([]*models.OrganizationMember, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT om.organization_id, om.user_id, om.role, om.joined_at, om.invited_by,
+		       u.id, u.email, u.username, u.name, u.is_bot, u.created_at, u.updated_at
+		FROM organization_members om
+		JOIN users u ON om.user_id = u.id
+		WHERE om.organization_id = $1
+		ORDER BY om.joined_at ASC
+	`, orgID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	members := make([]*models.OrganizationMember, 0)
+	for rows.Next() {
+		member := &models.OrganizationMember{
+			User: &models.User{},
+		}
+
+		err := rows.Scan(
+			&member.OrganizationID,
+			&member.UserID,
+			&member.Role,
+			&member.JoinedAt,
+			&member.InvitedBy,
+			&member.User.ID,
+			&member.User.Email,
+			&member.User.Username,
+			&member.User.Name,
+			&member.User.IsBot,
+			&member.User.CreatedAt,
+			&member.User.UpdatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		members = append(members, member)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return members, nil
+}
+
+// CountOwners counts the owners of an organization
+func (r *OrganizationRepository) CountOwners(ctx context.Context, orgID int) (int, error) {
+	var count int
+	err := r.db.QueryRowContext(ctx, `
+		SELECT COUNT(*) FROM organization_members WHERE organization_id = $1 AND role = $2
+	`, orgID, models.OrgRoleOwner).Scan(&count)
+	return count, err
+}
+
+// CountMembersWithoutTwoFactor counts the organization's members who have not
+// enabled two-factor authentication
+func (r *OrganizationRepository) CountMembersWithoutTwoFactor(ctx context.Context, orgID int) (int, error) {
+	var count int
+	err := r.db.QueryRowContext(ctx, `
+		SELECT COUNT(*)
+		FROM organization_members om
+		LEFT JOIN user_two_factor tf ON tf.user_id = om.user_id AND tf.enabled_at IS NOT NULL
+		WHERE om.organization_id = $1 AND tf.user_id IS NULL
+	`, orgID).Scan(&count)
+	return count, err
+}
+
+// RequiresTwoFactor reports whether any organization the user belongs to
+// requires two-factor authentication
+func (r *OrganizationRepository) RequiresTwoFactor(ctx context.Context, userID int) (bool, error) {
+	var required bool
+	err := r.db.QueryRowContext(ctx, `
+		SELECT EXISTS (
+			SELECT 1 FROM organization_members om
+			JOIN organizations o ON o.id = om.organization_id
+			WHERE om.user_id = $1 AND o.require_2fa
+		)
+	`, userID).Scan(&required)
+	return required, err
+}
+
+// UpdateMemberRole changes the role of an organization member
+func (r *OrganizationRepository) UpdateMemberRole(ctx context.Context, orgID, userID int, role models.OrganizationRole) error {
+	result, err := r.db.ExecContext(ctx, `
+		UPDATE organization_members SET role = $1
+		WHERE organization_id = $2 AND user_id = $3
+	`, role, orgID, userID)
+	if err != nil {
+		return err
+	}
+
+	return requireRowsAffected(result)
+}
+
+// RemoveMember removes a user from an organization and its teams
+// Access to the organization's projects is revoked with it, except for
+// projects the user is a direct member of
+func (r *OrganizationRepository) RemoveMember(ctx context.Context, orgID, userID int) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	result, err := tx.ExecContext(ctx, `
+		DELETE FROM organization_members WHERE organization_id = $1 AND user_id = $2
+	`, orgID, userID)
+	if err != nil {
+		return err
+	}
+	if err := requireRowsAffected(result); err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		DELETE FROM team_members tm
+		USING teams t
+		WHERE t.id = tm.team_id AND t.organization_id = $1 AND tm.user_id = $2
+	`, orgID, userID)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// requireRowsAffected returns ErrNotFound when a statement changed no rows
+func requireRowsAffected(result sql.Result) error {
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return pkgerrors.ErrNotFound
+	}
+
+	return nil
+}
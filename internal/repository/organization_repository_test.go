@@ -0,0 +1,146 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/yourusername/issue-tracker/internal/models"
+	pkgerrors "github.com/yourusername/issue-tracker/pkg/errors"
+)
+
+func TestProjectAccess_Precedence(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	cleanupData := func() {
+		db.Exec("DELETE FROM project_members WHERE project_id IN (SELECT id FROM projects WHERE key = 'ORGT1')")
+		db.Exec("DELETE FROM projects WHERE key = 'ORGT1'")
+		db.Exec("DELETE FROM organizations WHERE slug = 'orgtest-access'")
+		db.Exec("DELETE FROM users WHERE email LIKE 'orgtest%@example.com'")
+	}
+	cleanupData()
+	defer cleanupData()
+
+	ctx := context.Background()
+	userRepo := NewUserRepository(db)
+	orgRepo := NewOrganizationRepository(db)
+	teamRepo := NewTeamRepository(db)
+	memberRepo := NewProjectMemberRepository(db)
+
+	users := make([]*models.User, 7)
+	for i := range users {
+		name := fmt.Sprintf("orgtest%d", i+1)
+		user, err := userRepo.Create(ctx, &models.User{Email: name + "@example.com", Username: name, PasswordHash: "hash"})
+		if err != nil {
+			t.Fatalf("Failed to create test user: %v", err)
+		}
+		users[i] = user
+	}
+	owner, orgMember, orgAdmin, teamUser, directAndTeam, tie, outsider := users[0], users[1], users[2], users[3], users[4], users[5], users[6]
+
+	org, err := orgRepo.Create(ctx, &models.Organization{Name: "Access", Slug: "orgtest-access"}, owner.ID)
+	if err != nil {
+		t.Fatalf("Failed to create organization: %v", err)
+	}
+	for _, member := range []*models.OrganizationMember{
+		{OrganizationID: org.ID, UserID: orgMember.ID, Role: models.OrgRoleMember},
+		{OrganizationID: org.ID, UserID: orgAdmin.ID, Role: models.OrgRoleAdmin},
+		{OrganizationID: org.ID, UserID: teamUser.ID, Role: models.OrgRoleMember},
+		{OrganizationID: org.ID, UserID: directAndTeam.ID, Role: models.OrgRoleMember},
+		{OrganizationID: org.ID, UserID: tie.ID, Role: models.OrgRoleMember},
+	} {
+		if err := orgRepo.AddMember(ctx, member); err != nil {
+			t.Fatalf("Failed to add organization member: %v", err)
+		}
+	}
+
+	project, err := NewProjectRepository(db).Create(ctx, &models.Project{Name: "Access", Key: "ORGT1", OwnerID: owner.ID, OrganizationID: &org.ID})
+	if err != nil {
+		t.Fatalf("Failed to create project: %v", err)
+	}
+
+	createTeam := func(slug string, role models.ProjectRole, memberIDs ...int) {
+		team, err := teamRepo.Create(ctx, &models.Team{OrganizationID: org.ID, Name: slug, Slug: slug})
+		if err != nil {
+			t.Fatalf("Failed to create team: %v", err)
+		}
+		for _, userID := range memberIDs {
+			if err := teamRepo.AddMember(ctx, &models.TeamMember{TeamID: team.ID, UserID: userID}); err != nil {
+				t.Fatalf("Failed to add team member: %v", err)
+			}
+		}
+		if err := teamRepo.AddProject(ctx, &models.ProjectTeam{ProjectID: project.ID, TeamID: team.ID, Role: role}); err != nil {
+			t.Fatalf("Failed to add team to project: %v", err)
+		}
+	}
+	createTeam("viewers", models.RoleViewer, teamUser.ID)
+	createTeam("admins", models.RoleAdmin, teamUser.ID, directAndTeam.ID, tie.ID)
+
+	addDirect := func(userID int, role models.ProjectRole) {
+		if err := memberRepo.AddMember(ctx, &models.ProjectMember{ProjectID: project.ID, UserID: userID, Role: string(role)}); err != nil {
+			t.Fatalf("Failed to add project member: %v", err)
+		}
+	}
+	addDirect(orgAdmin.ID, models.RoleViewer)
+	addDirect(directAndTeam.ID, models.RoleMember)
+	addDirect(tie.ID, models.RoleAdmin)
+
+	expectAccess := func(t *testing.T, userID int, role models.ProjectRole, source string) {
+		t.Helper()
+		access, err := memberRepo.GetAccess(ctx, project.ID, userID)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if access.Role != string(role) {
+			t.Errorf("Expected role %s, got %s", role, access.Role)
+		}
+		if access.Source != source {
+			t.Errorf("Expected source %s, got %s", source, access.Source)
+		}
+	}
+
+	t.Run("should give org members the org default role", func(t *testing.T) {
+		expectAccess(t, orgMember.ID, models.RoleMember, "organization")
+	})
+
+	t.Run("should follow the org default role setting", func(t *testing.T) {
+		if err := orgRepo.UpdateSettings(ctx, org.ID, &models.OrganizationSettings{DefaultProjectRole: models.RoleViewer}); err != nil {
+			t.Fatalf("Failed to update settings: %v", err)
+		}
+		expectAccess(t, orgMember.ID, models.RoleViewer, "organization")
+	})
+
+	t.Run("should let a direct grant override the org role", func(t *testing.T) {
+		// Org admins get admin on org projects, but the direct grant wins
+		expectAccess(t, orgAdmin.ID, models.RoleViewer, "direct")
+	})
+
+	t.Run("should use the highest team grant", func(t *testing.T) {
+		expectAccess(t, teamUser.ID, models.RoleAdmin, "team")
+	})
+
+	t.Run("should use a higher team grant over a direct grant", func(t *testing.T) {
+		expectAccess(t, directAndTeam.ID, models.RoleAdmin, "team")
+	})
+
+	t.Run("should prefer the direct grant when roles are equal", func(t *testing.T) {
+		expectAccess(t, tie.ID, models.RoleAdmin, "direct")
+	})
+
+	t.Run("should return one row per user", func(t *testing.T) {
+		var count int
+		if err := db.QueryRow("SELECT COUNT(*) FROM project_access WHERE project_id = $1 AND user_id = $2", project.ID, teamUser.ID).Scan(&count); err != nil {
+			t.Fatalf("Failed to count access rows: %v", err)
+		}
+		if count != 1 {
+			t.Errorf("Expected 1 access row, got %d", count)
+		}
+	})
+
+	t.Run("should not grant access to users outside the organization", func(t *testing.T) {
+		if _, err := memberRepo.GetAccess(ctx, project.ID, outsider.ID); err != pkgerrors.ErrNotFound {
+			t.Errorf("Expected ErrNotFound, got %v", err)
+		}
+	})
+}
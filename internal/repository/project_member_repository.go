@@ -33,16 +33,25 @@ func (r *ProjectMemberRepository) AddMember(ctx context.Context, member *models.
 
 // ListByProjectID retrieves all members of a project with their user information
 func (r *ProjectMemberRepository) ListByProjectID(ctx context.Context, projectID int) ([]*models.ProjectMember, error) {
+	return r.listByProjectID(ctx, projectID, true)
+}
+
+// ListHumansByProjectID retrieves the members of a project, excluding service accounts
+func (r *ProjectMemberRepository) ListHumansByProjectID(ctx context.Context, projectID int) ([]*models.ProjectMember, error) {
+	return r.listByProjectID(ctx, projectID, false)
+}
+
+func (r *ProjectMemberRepository) listByProjectID(ctx context.Context, projectID int, includeBots bool) ([]*models.ProjectMember, error) {
 	query := `
 		SELECT pm.project_id, pm.user_id, pm.role, pm.joined_at, pm.invited_by,
-		       u.id, u.email, u.username, u.created_at, u.updated_at
+		       u.id, u.email, u.username, u.is_bot, u.created_at, u.updated_at
 		FROM project_members pm
 		JOIN users u ON pm.user_id = u.id
-		WHERE pm.project_id = $1
+		WHERE pm.project_id = $1 AND ($2 OR u.is_bot = false)
 		ORDER BY pm.joined_at ASC
 	`
 
-	rows, err := r.db.QueryContext(ctx, query, projectID)
+	rows, err := r.db.QueryContext(ctx, query, projectID, includeBots)
 	if err != nil {
 		return nil, err
 	}
@@ -63,6 +72,7 @@ func (r *ProjectMemberRepository) ListByProjectID(ctx context.Context, projectID
 			&member.User.ID,
 			&member.User.Email,
 			&member.User.Username,
+			&member.User.IsBot,
 			&member.User.CreatedAt,
 			&member.User.UpdatedAt,
 		)
@@ -107,6 +117,35 @@ func (r *ProjectMemberRepository) GetMember(ctx context.Context, projectID, user
 	return member, nil
 }
 
+// GetAccess retrieves the effective access of a user to a project
+// A direct membership takes precedence over the organization default
+func (r *ProjectMemberRepository) GetAccess(ctx context.Context, projectID, userID int) (*models.ProjectMember, error) {
+	query := `
+		SELECT project_id, user_id, role, joined_at, invited_by, source
+		FROM project_access
+		WHERE project_id = $1 AND user_id = $2
+	`
+
+	member := &models.ProjectMember{}
+	err := r.db.QueryRowContext(ctx, query, projectID, userID).Scan(
+		&member.ProjectID,
+		&member.UserID,
+		&member.Role,
+		&member.JoinedAt,
+		&member.InvitedBy,
+		&member.Source,
+	)
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, pkgerrors.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return member, nil
+}
+
 // UpdateRole updates the role of a project member
 func (r *ProjectMemberRepository) UpdateRole(ctx context.Context, projectID, userID int, role string) error {
 	query := `
@@ -196,15 +235,16 @@ func (r *ProjectMemberRepository) ListByUserID(ctx context.Context, userID int)
 	return members, nil
 }
 
-// ListByUserIDWithProjects retrieves all projects a user is a member of with project details
+// ListByUserIDWithProjects retrieves all projects a user can access, directly
+// or through an organization, with project details
 func (r *ProjectMemberRepository) ListByUserIDWithProjects(ctx context.Context, userID int) ([]*models.ProjectMember, error) {
 	query := `
-		SELECT pm.project_id, pm.user_id, pm.role, pm.joined_at, pm.invited_by,
-		       p.id, p.name, p.key, p.description, p.owner_id, p.created_at, p.updated_at
-		FROM project_members pm
-		JOIN projects p ON pm.project_id = p.id
-		WHERE pm.user_id = $1
-		ORDER BY pm.joined_at DESC
+		SELECT pa.project_id, pa.user_id, pa.role, pa.joined_at, pa.invited_by, pa.source,
+		       p.id, p.name, p.key, p.description, p.owner_id, p.organization_id, p.created_at, p.updated_at
+		FROM project_access pa
+		JOIN projects p ON pa.project_id = p.id
+		WHERE pa.user_id = $1
+		ORDER BY pa.joined_at DESC
 	`
 
 	rows, err := r.db.QueryContext(ctx, query, userID)
@@ -226,11 +266,13 @@ func (r *ProjectMemberRepository) ListByUserIDWithProjects(ctx context.Context,
 			&member.Role,
 			&member.JoinedAt,
 			&member.InvitedBy,
+			&member.Source,
 			&member.Project.ID,
 			&member.Project.Name,
 			&member.Project.Key,
 			&description,
 			&member.Project.OwnerID,
+			&member.Project.OrganizationID,
 			&member.Project.CreatedAt,
 			&member.Project.UpdatedAt,
 		)
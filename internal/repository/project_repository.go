@@ -23,9 +23,9 @@ func NewProjectRepository(db *sql.DB) *ProjectRepository {
 // Create creates a new project
 func (r *ProjectRepository) Create(ctx context.Context, project *models.Project) (*models.Project, error) {
 	query := `
-		INSERT INTO projects (name, key, description, owner_id)
-		VALUES ($1, $2, $3, $4)
-		RETURNING id, name, key, description, owner_id, created_at, updated_at
+		INSERT INTO projects (name, key, description, owner_id, organization_id)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING id, name, key, description, owner_id, organization_id, created_at, updated_at
 	`
 
 	var created models.Project
@@ -34,12 +34,14 @@ func (r *ProjectRepository) Create(ctx context.Context, project *models.Project)
 		project.Key,
 		project.Description,
 		project.OwnerID,
+		project.OrganizationID,
 	).Scan(
 		&created.ID,
 		&created.Name,
 		&created.Key,
 		&created.Description,
 		&created.OwnerID,
+		&created.OrganizationID,
 		&created.CreatedAt,
 		&created.UpdatedAt,
 	)
@@ -60,7 +62,7 @@ func (r *ProjectRepository) Create(ctx context.Context, project *models.Project)
 // GetByID retrieves a project by ID
 func (r *ProjectRepository) GetByID(ctx context.Context, id int) (*models.Project, error) {
 	query := `
-		SELECT id, name, key, description, owner_id, created_at, updated_at
+		SELECT id, name, key, description, owner_id, organization_id, created_at, updated_at
 		FROM projects
 		WHERE id = $1
 	`
@@ -72,6 +74,7 @@ func (r *ProjectRepository) GetByID(ctx context.Context, id int) (*models.Projec
 		&project.Key,
 		&project.Description,
 		&project.OwnerID,
+		&project.OrganizationID,
 		&project.CreatedAt,
 		&project.UpdatedAt,
 	)
@@ -86,21 +89,23 @@ func (r *ProjectRepository) GetByID(ctx context.Context, id int) (*models.Projec
 	return &project, nil
 }
 
-// GetByKey retrieves a project by key
-func (r *ProjectRepository) GetByKey(ctx context.Context, key string) (*models.Project, error) {
+// GetByKey retrieves a project by key within an organization
+// A nil organization ID looks up personal projects
+func (r *ProjectRepository) GetByKey(ctx context.Context, organizationID *int, key string) (*models.Project, error) {
 	query := `
-		SELECT id, name, key, description, owner_id, created_at, updated_at
+		SELECT id, name, key, description, owner_id, organization_id, created_at, updated_at
 		FROM projects
-		WHERE key = $1
+		WHERE key = $1 AND organization_id IS NOT DISTINCT FROM $2
 	`
 
 	var project models.Project
-	err := r.db.QueryRowContext(ctx, query, key).Scan(
+	err := r.db.QueryRowContext(ctx, query, key, organizationID).Scan(
 		&project.ID,
 		&project.Name,
 		&project.Key,
 		&project.Description,
 		&project.OwnerID,
+		&project.OrganizationID,
 		&project.CreatedAt,
 		&project.UpdatedAt,
 	)
@@ -115,17 +120,34 @@ func (r *ProjectRepository) GetByKey(ctx context.Context, key string) (*models.P
 	return &project, nil
 }
 
-// ListByUserID retrieves all projects owned by a user
+// ListByUserID retrieves all projects a user owns or can access directly or
+// through an organization
 func (r *ProjectRepository) ListByUserID(ctx context.Context, userID int) ([]*models.Project, error) {
 	query := `
-		SELECT DISTINCT p.id, p.name, p.key, p.description, p.owner_id, p.created_at, p.updated_at
+		SELECT p.id, p.name, p.key, p.description, p.owner_id, p.organization_id, p.created_at, p.updated_at
 		FROM projects p
-		LEFT JOIN project_members pm ON p.id = pm.project_id
-		WHERE p.owner_id = $1 OR pm.user_id = $1
+		WHERE p.owner_id = $1
+		   OR EXISTS (SELECT 1 FROM project_access pa WHERE pa.project_id = p.id AND pa.user_id = $1)
 		ORDER BY p.created_at DESC
 	`
 
-	rows, err := r.db.QueryContext(ctx, query, userID)
+	return r.list(ctx, query, userID)
+}
+
+// ListByOrganizationID retrieves all projects of an organization
+func (r *ProjectRepository) ListByOrganizationID(ctx context.Context, organizationID int) ([]*models.Project, error) {
+	query := `
+		SELECT id, name, key, description, owner_id, organization_id, created_at, updated_at
+		FROM projects
+		WHERE organization_id = $1
+		ORDER BY key
+	`
+
+	return r.list(ctx, query, organizationID)
+}
+
+func (r *ProjectRepository) list(ctx context.Context, query string, args ...interface{}) ([]*models.Project, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -140,6 +162,7 @@ func (r *ProjectRepository) ListByUserID(ctx context.Context, userID int) ([]*mo
 			&project.Key,
 			&project.Description,
 			&project.OwnerID,
+			&project.OrganizationID,
 			&project.CreatedAt,
 			&project.UpdatedAt,
 		)
@@ -186,6 +209,104 @@ func (r *ProjectRepository) Update(ctx context.Context, project *models.Project)
 	return nil
 }
 
+// ChangeKey renames the key of a project and keeps the old key as an alias
+// Returns ErrConflict when another project of the same organization uses
+// the key, currently or as a former key
+func (r *ProjectRepository) ChangeKey(ctx context.Context, projectID int, key string) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	var oldKey string
+	var organizationID *int
+	err = tx.QueryRowContext(ctx, `
+		SELECT key, organization_id FROM projects WHERE id = $1 FOR UPDATE
+	`, projectID).Scan(&oldKey, &organizationID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return pkgerrors.ErrNotFound
+		}
+		return err
+	}
+	if oldKey == key {
+		return nil
+	}
+
+	var taken bool
+	err = tx.QueryRowContext(ctx, `
+		SELECT EXISTS(
+			SELECT 1 FROM project_key_aliases a
+			JOIN projects p ON p.id = a.project_id
+			WHERE a.key = $1 AND p.id <> $2 AND p.organization_id IS NOT DISTINCT FROM $3
+		)
+	`, key, projectID, organizationID).Scan(&taken)
+	if err != nil {
+		return err
+	}
+	if taken {
+		return pkgerrors.ErrConflict
+	}
+
+	statements := []struct {
+		query string
+		args  []interface{}
+	}{
+		{`INSERT INTO project_key_aliases (project_id, key) VALUES ($1, $2) ON CONFLICT DO NOTHING`, []interface{}{projectID, oldKey}},
+		// Renaming back to a former key makes it current again
+		{`DELETE FROM project_key_aliases WHERE project_id = $1 AND key = $2`, []interface{}{projectID, key}},
+		{`UPDATE projects SET key = $2, updated_at = NOW() WHERE id = $1`, []interface{}{projectID, key}},
+	}
+	for _, stmt := range statements {
+		if _, err := tx.ExecContext(ctx, stmt.query, stmt.args...); err != nil {
+			var pqErr *pq.Error
+			if errors.As(err, &pqErr) && pqErr.Code == "23505" { // unique violation
+				return pkgerrors.ErrConflict
+			}
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// ListKeyAliases lists the former keys of a project, newest first
+func (r *ProjectRepository) ListKeyAliases(ctx context.Context, projectID int) ([]string, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT key FROM project_key_aliases WHERE project_id = $1 ORDER BY created_at DESC, key
+	`, projectID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	keys := []string{}
+	for rows.Next() {
+		var key string
+		if err := rows.Scan(&key); err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+
+	return keys, rows.Err()
+}
+
+// IsKeyAlias reports whether a key is the former key of a project in an
+// organization (nil for personal projects)
+func (r *ProjectRepository) IsKeyAlias(ctx context.Context, organizationID *int, key string) (bool, error) {
+	var exists bool
+	err := r.db.QueryRowContext(ctx, `
+		SELECT EXISTS(
+			SELECT 1 FROM project_key_aliases a
+			JOIN projects p ON p.id = a.project_id
+			WHERE a.key = $1 AND p.organization_id IS NOT DISTINCT FROM $2
+		)
+	`, key, organizationID).Scan(&exists)
+	return exists, err
+}
+
 // Delete deletes a project
 func (r *ProjectRepository) Delete(ctx context.Context, id int) error {
 	query := `DELETE FROM projects WHERE id = $1`
@@ -206,3 +327,38 @@ func (r *ProjectRepository) Delete(ctx context.Context, id int) error {
 
 	return nil
 }
+
+// GetEstimationSettings retrieves the estimation configuration of a project
+func (r *ProjectRepository) GetEstimationSettings(ctx context.Context, projectID int) (*models.EstimationSettings, error) {
+	settings := &models.EstimationSettings{ProjectID: projectID}
+	var allowed pq.Float64Array
+
+	err := r.db.QueryRowContext(ctx, `
+		SELECT estimation_type, allowed_story_points
+		FROM projects
+		WHERE id = $1
+	`, projectID).Scan(&settings.EstimationType, &allowed)
+	if err == sql.ErrNoRows {
+		return nil, pkgerrors.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	settings.AllowedStoryPoints = []float64(allowed)
+	return settings, nil
+}
+
+// UpdateEstimationSettings updates the estimation configuration of a project
+func (r *ProjectRepository) UpdateEstimationSettings(ctx context.Context, settings *models.EstimationSettings) error {
+	result, err := r.db.ExecContext(ctx, `
+		UPDATE projects
+		SET estimation_type = $1, allowed_story_points = $2, updated_at = NOW()
+		WHERE id = $3
+	`, settings.EstimationType, pq.Float64Array(settings.AllowedStoryPoints), settings.ProjectID)
+	if err != nil {
+		return err
+	}
+
+	return requireRowsAffected(result)
+}
@@ -306,3 +306,141 @@ func TestProjectRepository_Delete(t *testing.T) {
 		}
 	})
 }
+
+func TestProjectRepository_EstimationSettings(t *testing.T) {
+	repo, userRepo, cleanup := setupProjectRepo(t)
+	defer cleanup()
+
+	ctx := context.Background()
+
+	testUser := &models.User{
+		Email:        "projecttest8@example.com",
+		Username:     "projecttest8",
+		PasswordHash: "hash",
+	}
+	createdUser, _ := userRepo.Create(ctx, testUser)
+
+	project, err := repo.Create(ctx, &models.Project{
+		Name:    "Estimation Project",
+		Key:     "TEST12",
+		OwnerID: createdUser.ID,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create project: %v", err)
+	}
+
+	t.Run("should default to Fibonacci story points", func(t *testing.T) {
+		settings, err := repo.GetEstimationSettings(ctx, project.ID)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		if settings.EstimationType != models.EstimationTypeStoryPoints {
+			t.Errorf("Expected story_points, got %s", settings.EstimationType)
+		}
+		if len(settings.AllowedStoryPoints) != len(models.DefaultStoryPoints) {
+			t.Errorf("Expected %v, got %v", models.DefaultStoryPoints, settings.AllowedStoryPoints)
+		}
+		if settings.AllowsStoryPoints(4) {
+			t.Error("Expected 4 not to be an allowed value")
+		}
+	})
+
+	t.Run("should update estimation settings", func(t *testing.T) {
+		err := repo.UpdateEstimationSettings(ctx, &models.EstimationSettings{
+			ProjectID:          project.ID,
+			EstimationType:     models.EstimationTypeTime,
+			AllowedStoryPoints: []float64{0.5, 1, 2},
+		})
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		settings, _ := repo.GetEstimationSettings(ctx, project.ID)
+		if settings.EstimationType != models.EstimationTypeTime || !settings.AllowsStoryPoints(0.5) {
+			t.Errorf("Expected updated settings, got %+v", settings)
+		}
+	})
+
+	t.Run("should return not found for a missing project", func(t *testing.T) {
+		_, err := repo.GetEstimationSettings(ctx, 999999)
+		if err != pkgerrors.ErrNotFound {
+			t.Errorf("Expected ErrNotFound, got %v", err)
+		}
+	})
+}
+
+func TestProjectRepository_ChangeKey(t *testing.T) {
+	repo, userRepo, cleanup := setupProjectRepo(t)
+	defer cleanup()
+
+	ctx := context.Background()
+
+	createdUser, _ := userRepo.Create(ctx, &models.User{
+		Email:        "projecttest9@example.com",
+		Username:     "projecttest9",
+		PasswordHash: "hash",
+	})
+
+	project, err := repo.Create(ctx, &models.Project{Name: "Renamed Project", Key: "TEST13", OwnerID: createdUser.ID})
+	if err != nil {
+		t.Fatalf("Failed to create project: %v", err)
+	}
+	other, err := repo.Create(ctx, &models.Project{Name: "Other Project", Key: "TEST15", OwnerID: createdUser.ID})
+	if err != nil {
+		t.Fatalf("Failed to create project: %v", err)
+	}
+
+	t.Run("should keep the old key as an alias", func(t *testing.T) {
+		if err := repo.ChangeKey(ctx, project.ID, "TEST14"); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		renamed, err := repo.GetByID(ctx, project.ID)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if renamed.Key != "TEST14" {
+			t.Errorf("Expected key TEST14, got %s", renamed.Key)
+		}
+
+		aliases, err := repo.ListKeyAliases(ctx, project.ID)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if len(aliases) != 1 || aliases[0] != "TEST13" {
+			t.Errorf("Expected alias TEST13, got %v", aliases)
+		}
+	})
+
+	t.Run("should not reuse another project's key or alias", func(t *testing.T) {
+		if err := repo.ChangeKey(ctx, other.ID, "TEST14"); err != pkgerrors.ErrConflict {
+			t.Errorf("Expected ErrConflict for a current key, got %v", err)
+		}
+		if err := repo.ChangeKey(ctx, other.ID, "TEST13"); err != pkgerrors.ErrConflict {
+			t.Errorf("Expected ErrConflict for a former key, got %v", err)
+		}
+
+		isAlias, err := repo.IsKeyAlias(ctx, nil, "TEST13")
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if !isAlias {
+			t.Error("Expected TEST13 to be a former key")
+		}
+	})
+
+	t.Run("should make a former key current again", func(t *testing.T) {
+		if err := repo.ChangeKey(ctx, project.ID, "TEST13"); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		aliases, err := repo.ListKeyAliases(ctx, project.ID)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if len(aliases) != 1 || aliases[0] != "TEST14" {
+			t.Errorf("Expected alias TEST14, got %v", aliases)
+		}
+	})
+}
@@ -0,0 +1,182 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/lib/pq"
+	"github.com/yourusername/issue-tracker/internal/models"
+	pkgerrors "github.com/yourusername/issue-tracker/pkg/errors"
+)
+
+// RevisionRepository handles revision history of issue descriptions and
+// comments
+type RevisionRepository struct {
+	db *sql.DB
+}
+
+// NewRevisionRepository creates a new revision repository
+func NewRevisionRepository(db *sql.DB) *RevisionRepository {
+	return &RevisionRepository{db: db}
+}
+
+// revisionColumns lists the columns read by scanRevision, in order
+const revisionColumns = `r.id, r.entity_type, r.entity_id, r.revision, r.content, r.author_id,
+			r.created_at, r.redacted_at, r.redacted_by, u.id, u.username, u.email`
+
+// scanRevision scans a row selected with revisionColumns
+func scanRevision(row rowScanner) (*models.ContentRevision, error) {
+	var revision models.ContentRevision
+	var userID sql.NullInt64
+	var username, email sql.NullString
+	err := row.Scan(
+		&revision.ID,
+		&revision.EntityType,
+		&revision.EntityID,
+		&revision.Revision,
+		&revision.Content,
+		&revision.AuthorID,
+		&revision.CreatedAt,
+		&revision.RedactedAt,
+		&revision.RedactedBy,
+		&userID,
+		&username,
+		&email,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	revision.Redacted = revision.RedactedAt != nil
+	if userID.Valid {
+		revision.Author = &models.User{
+			ID:       int(userID.Int64),
+			Username: username.String,
+			Email:    email.String,
+		}
+	}
+	return &revision, nil
+}
+
+// Record stores revision as the next revision of its entity
+// Text written before revisions were recorded has no history yet; baseline,
+// when given, is then stored first as revision 1 so the change can be diffed
+func (r *RevisionRepository) Record(ctx context.Context, baseline *models.ContentRevision, revision *models.ContentRevision) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if baseline != nil {
+		_, err := tx.ExecContext(ctx, `
+			INSERT INTO content_revisions (entity_type, entity_id, revision, content, author_id, created_at)
+			SELECT $1, $2, 1, $3, $4, $5
+			WHERE NOT EXISTS (
+				SELECT 1 FROM content_revisions WHERE entity_type = $1 AND entity_id = $2
+			)
+		`, revision.EntityType, revision.EntityID, baseline.Content, baseline.AuthorID, baseline.CreatedAt)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = tx.QueryRowContext(ctx, `
+		INSERT INTO content_revisions (entity_type, entity_id, revision, content, author_id)
+		VALUES ($1, $2, (
+			SELECT COALESCE(MAX(revision), 0) + 1
+			FROM content_revisions
+			WHERE entity_type = $1 AND entity_id = $2
+		), $3, $4)
+		RETURNING id, revision, created_at
+	`, revision.EntityType, revision.EntityID, revision.Content, revision.AuthorID).Scan(
+		&revision.ID,
+		&revision.Revision,
+		&revision.CreatedAt,
+	)
+	if err != nil {
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code == "23505" { // Saved concurrently with another revision
+			return pkgerrors.ErrConflict
+		}
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// List returns the revisions of an entity, newest first
+func (r *RevisionRepository) List(ctx context.Context, entityType models.RevisionEntityType, entityID int) ([]*models.ContentRevision, error) {
+	query := `
+		SELECT ` + revisionColumns + `
+		FROM content_revisions r
+		LEFT JOIN users u ON r.author_id = u.id
+		WHERE r.entity_type = $1 AND r.entity_id = $2
+		ORDER BY r.revision DESC
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, entityType, entityID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	revisions := make([]*models.ContentRevision, 0)
+	for rows.Next() {
+		revision, err := scanRevision(rows)
+		if err != nil {
+			return nil, err
+		}
+		revisions = append(revisions, revision)
+	}
+
+	return revisions, rows.Err()
+}
+
+// Get retrieves one revision of an entity by its number
+func (r *RevisionRepository) Get(ctx context.Context, entityType models.RevisionEntityType, entityID, number int) (*models.ContentRevision, error) {
+	query := `
+		SELECT ` + revisionColumns + `
+		FROM content_revisions r
+		LEFT JOIN users u ON r.author_id = u.id
+		WHERE r.entity_type = $1 AND r.entity_id = $2 AND r.revision = $3
+	`
+
+	revision, err := scanRevision(r.db.QueryRowContext(ctx, query, entityType, entityID, number))
+	if err == sql.ErrNoRows {
+		return nil, pkgerrors.ErrNotFound
+	}
+	return revision, err
+}
+
+// Latest returns the number of the current revision of an entity, or 0 when
+// it has no revisions
+func (r *RevisionRepository) Latest(ctx context.Context, entityType models.RevisionEntityType, entityID int) (int, error) {
+	var number int
+	err := r.db.QueryRowContext(ctx, `
+		SELECT COALESCE(MAX(revision), 0)
+		FROM content_revisions
+		WHERE entity_type = $1 AND entity_id = $2
+	`, entityType, entityID).Scan(&number)
+	return number, err
+}
+
+// Redact removes the content of a revision, keeping its author and time
+func (r *RevisionRepository) Redact(ctx context.Context, entityType models.RevisionEntityType, entityID, number, userID int) error {
+	result, err := r.db.ExecContext(ctx, `
+		UPDATE content_revisions
+		SET content = NULL, redacted_at = NOW(), redacted_by = $4
+		WHERE entity_type = $1 AND entity_id = $2 AND revision = $3 AND redacted_at IS NULL
+	`, entityType, entityID, number, userID)
+	if err != nil {
+		return err
+	}
+	return requireRowsAffected(result)
+}
+
+// DeleteByEntity deletes all revisions of an entity
+func (r *RevisionRepository) DeleteByEntity(ctx context.Context, entityType models.RevisionEntityType, entityID int) error {
+	_, err := r.db.ExecContext(ctx, `DELETE FROM content_revisions WHERE entity_type = $1 AND entity_id = $2`, entityType, entityID)
+	return err
+}
@@ -0,0 +1,117 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/yourusername/issue-tracker/internal/models"
+	pkgerrors "github.com/yourusername/issue-tracker/pkg/errors"
+)
+
+func TestRevisionRepository(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	// Revisions have no foreign key, an unused comment ID keeps them apart
+	const commentID = 987654
+
+	cleanup := func() {
+		db.Exec("DELETE FROM content_revisions WHERE entity_type = 'comment' AND entity_id = $1", commentID)
+		db.Exec("DELETE FROM users WHERE email = 'revisiontest@example.com'")
+	}
+	cleanup()
+	defer cleanup()
+
+	revisionRepo := NewRevisionRepository(db)
+	ctx := context.Background()
+
+	user, err := NewUserRepository(db).Create(ctx, &models.User{
+		Email:        "revisiontest@example.com",
+		Username:     "revisiontest",
+		PasswordHash: "hash",
+	})
+	if err != nil {
+		t.Fatalf("Failed to create user: %v", err)
+	}
+
+	text := func(s string) *string { return &s }
+	record := func(baseline *models.ContentRevision, content string) *models.ContentRevision {
+		t.Helper()
+		revision := &models.ContentRevision{
+			EntityType: models.RevisionEntityComment,
+			EntityID:   commentID,
+			Content:    text(content),
+			AuthorID:   &user.ID,
+		}
+		if err := revisionRepo.Record(ctx, baseline, revision); err != nil {
+			t.Fatalf("Failed to record revision: %v", err)
+		}
+		return revision
+	}
+
+	t.Run("should store text written before history as the first revision", func(t *testing.T) {
+		baseline := &models.ContentRevision{Content: text("token=abc"), CreatedAt: time.Now().Add(-time.Hour)}
+		revision := record(baseline, "token=<hidden>")
+
+		if revision.Revision != 2 {
+			t.Errorf("Expected revision 2, got %d", revision.Revision)
+		}
+
+		first, err := revisionRepo.Get(ctx, models.RevisionEntityComment, commentID, 1)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if first.Content == nil || *first.Content != "token=abc" || first.AuthorID != nil {
+			t.Errorf("Expected the baseline without author, got %+v", first)
+		}
+	})
+
+	t.Run("should ignore the baseline once history exists", func(t *testing.T) {
+		revision := record(&models.ContentRevision{Content: text("ignored")}, "third")
+
+		revisions, err := revisionRepo.List(ctx, models.RevisionEntityComment, commentID)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if len(revisions) != 3 || revisions[0].Revision != revision.Revision || revision.Revision != 3 {
+			t.Fatalf("Expected 3 revisions, newest first, got %d", len(revisions))
+		}
+		if revisions[0].Author == nil || revisions[0].Author.ID != user.ID {
+			t.Errorf("Expected the author to be loaded, got %+v", revisions[0].Author)
+		}
+
+		latest, err := revisionRepo.Latest(ctx, models.RevisionEntityComment, commentID)
+		if err != nil || latest != 3 {
+			t.Errorf("Expected latest revision 3, got %d (%v)", latest, err)
+		}
+	})
+
+	t.Run("should redact the content only once", func(t *testing.T) {
+		if err := revisionRepo.Redact(ctx, models.RevisionEntityComment, commentID, 1, user.ID); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		redacted, err := revisionRepo.Get(ctx, models.RevisionEntityComment, commentID, 1)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if !redacted.Redacted || redacted.Content != nil || redacted.RedactedBy == nil || *redacted.RedactedBy != user.ID {
+			t.Errorf("Expected redacted revision without content, got %+v", redacted)
+		}
+
+		if err := revisionRepo.Redact(ctx, models.RevisionEntityComment, commentID, 1, user.ID); err != pkgerrors.ErrNotFound {
+			t.Errorf("Expected ErrNotFound for an already redacted revision, got %v", err)
+		}
+	})
+
+	t.Run("should delete the history of an entity", func(t *testing.T) {
+		if err := revisionRepo.DeleteByEntity(ctx, models.RevisionEntityComment, commentID); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		if _, err := revisionRepo.Get(ctx, models.RevisionEntityComment, commentID, 2); err != pkgerrors.ErrNotFound {
+			t.Errorf("Expected ErrNotFound, got %v", err)
+		}
+	})
+}
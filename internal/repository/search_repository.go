@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/lib/pq"
 	"github.com/yourusername/issue-tracker/internal/models"
 )
 
@@ -76,7 +77,7 @@ func (r *SearchRepository) SearchIssues(ctx context.Context, req *models.IssueSe
 
 	// Filter by assignee
 	if req.AssigneeID != nil {
-		whereClauses = append(whereClauses, fmt.Sprintf("i.assignee_id = $%d", argCount))
+		whereClauses = append(whereClauses, fmt.Sprintf("i.id IN (SELECT issue_id FROM issue_assignees WHERE user_id = $%d)", argCount))
 		args = append(args, *req.AssigneeID)
 		argCount++
 	}
@@ -107,6 +108,41 @@ func (r *SearchRepository) SearchIssues(ctx context.Context, req *models.IssueSe
 		`, strings.Join(placeholders, ", "), len(req.LabelIDs)))
 	}
 
+	// Filter by due date range
+	if req.DueAfter != nil {
+		whereClauses = append(whereClauses, fmt.Sprintf("i.due_date >= $%d", argCount))
+		args = append(args, *req.DueAfter)
+		argCount++
+	}
+	if req.DueBefore != nil {
+		whereClauses = append(whereClauses, fmt.Sprintf("i.due_date <= $%d", argCount))
+		args = append(args, *req.DueBefore)
+		argCount++
+	}
+
+	// Filter by overdue
+	if req.Overdue != nil {
+		if *req.Overdue {
+			whereClauses = append(whereClauses, "i.due_date < CURRENT_DATE AND i.status_category <> 'done'")
+		} else {
+			whereClauses = append(whereClauses, "(i.due_date IS NULL OR i.due_date >= CURRENT_DATE OR i.status_category = 'done')")
+		}
+	}
+
+	// Filter by vote score
+	if req.MinVotes != nil {
+		whereClauses = append(whereClauses, fmt.Sprintf("i.vote_score >= $%d", argCount))
+		args = append(args, *req.MinVotes)
+		argCount++
+	}
+
+	// Filter by custom fields, matched by key in each issue's project
+	for _, key := range sortedKeys(req.CustomFields) {
+		whereClauses = append(whereClauses, customFieldMatch("i.", fmt.Sprintf("$%d", argCount), fmt.Sprintf("$%d", argCount+1)))
+		args = append(args, key, req.CustomFields[key])
+		argCount += 2
+	}
+
 	whereClause := strings.Join(whereClauses, " AND ")
 
 	// Get total count
@@ -132,6 +168,11 @@ func (r *SearchRepository) SearchIssues(ctx context.Context, req *models.IssueSe
 		offset = 0
 	}
 
+	orderBy, ok := models.IssueOrderBy(req.Sort, "i.")
+	if !ok {
+		orderBy = "i.updated_at DESC"
+	}
+
 	// Get paginated results
 	query := fmt.Sprintf(`
 		SELECT
@@ -144,15 +185,27 @@ func (r *SearchRepository) SearchIssues(ctx context.Context, req *models.IssueSe
 			i.status,
 			i.priority,
 			i.assignee_id,
+			ARRAY(
+				SELECT ia.user_id FROM issue_assignees ia
+				WHERE ia.issue_id = i.id
+				ORDER BY ia.user_id = i.assignee_id DESC, ia.created_at, ia.user_id
+			),
 			i.reporter_id,
+			i.start_date,
+			i.due_date,
+			i.due_date IS NOT NULL AND i.due_date < CURRENT_DATE AND i.status_category <> 'done',
+			i.upvotes,
+			i.downvotes,
+			i.vote_score,
+			i.customer_request_count,
 			i.created_at,
 			i.updated_at
 		FROM issues i
 		JOIN projects p ON i.project_id = p.id
 		WHERE %s
-		ORDER BY i.updated_at DESC
+		ORDER BY %s
 		LIMIT $%d OFFSET $%d
-	`, whereClause, argCount, argCount+1)
+	`, whereClause, orderBy, argCount, argCount+1)
 
 	args = append(args, limit, offset)
 
@@ -165,6 +218,7 @@ func (r *SearchRepository) SearchIssues(ctx context.Context, req *models.IssueSe
 	results := make([]*models.IssueSearchResult, 0)
 	for rows.Next() {
 		result := &models.IssueSearchResult{}
+		var assigneeIDs pq.Int64Array
 		err := rows.Scan(
 			&result.ID,
 			&result.ProjectID,
@@ -175,13 +229,26 @@ func (r *SearchRepository) SearchIssues(ctx context.Context, req *models.IssueSe
 			&result.Status,
 			&result.Priority,
 			&result.AssigneeID,
+			&assigneeIDs,
 			&result.ReporterID,
+			&result.StartDate,
+			&result.DueDate,
+			&result.Overdue,
+			&result.Upvotes,
+			&result.Downvotes,
+			&result.VoteScore,
+			&result.Customers,
 			&result.CreatedAt,
 			&result.UpdatedAt,
 		)
 		if err != nil {
 			return nil, 0, err
 		}
+
+		result.AssigneeIDs = make([]int, len(assigneeIDs))
+		for i, id := range assigneeIDs {
+			result.AssigneeIDs[i] = int(id)
+		}
 		results = append(results, result)
 	}
 
@@ -0,0 +1,319 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/lib/pq"
+	"github.com/yourusername/issue-tracker/internal/models"
+	pkgerrors "github.com/yourusername/issue-tracker/pkg/errors"
+)
+
+// ServiceAccountRepository handles service account and token data access
+type ServiceAccountRepository struct {
+	db *sql.DB
+}
+
+// NewServiceAccountRepository creates a new service account repository
+func NewServiceAccountRepository(db *sql.DB) *ServiceAccountRepository {
+	return &ServiceAccountRepository{db: db}
+}
+
+// Create creates a bot user and adds it to the project with the given role
+func (r *ServiceAccountRepository) Create(ctx context.Context, account *models.ServiceAccount) (*models.ServiceAccount, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	created := &models.ServiceAccount{
+		ProjectID: account.ProjectID,
+		Role:      account.Role,
+		CreatedBy: account.CreatedBy,
+	}
+
+	err = tx.QueryRowContext(ctx, `
+		INSERT INTO users (email, username, password_hash, name, is_bot, bot_project_id)
+		VALUES ($1, $2, '', $3, true, $4)
+		RETURNING id, email, username, name, is_bot, bot_project_id, created_at, updated_at
+	`, account.Email, account.Username, account.Name, account.ProjectID).Scan(
+		&created.ID,
+		&created.Email,
+		&created.Username,
+		&created.Name,
+		&created.IsBot,
+		&created.BotProjectID,
+		&created.CreatedAt,
+		&created.UpdatedAt,
+	)
+	if err != nil {
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code == "23505" {
+			return nil, pkgerrors.ErrConflict
+		}
+		return nil, err
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO project_members (project_id, user_id, role, invited_by)
+		VALUES ($1, $2, $3, $4)
+	`, account.ProjectID, created.ID, string(account.Role), account.CreatedBy)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+// GetByID retrieves a service account of a project by user ID
+func (r *ServiceAccountRepository) GetByID(ctx context.Context, projectID, userID int) (*models.ServiceAccount, error) {
+	query := `
+		SELECT u.id, u.email, u.username, u.name, u.is_bot, u.bot_project_id, u.created_at, u.updated_at,
+		       pm.project_id, pm.role, pm.invited_by
+		FROM users u
+		JOIN project_members pm ON pm.user_id = u.id AND pm.project_id = u.bot_project_id
+		WHERE u.is_bot = true AND u.bot_project_id = $1 AND u.id = $2
+	`
+
+	var account models.ServiceAccount
+	err := r.db.QueryRowContext(ctx, query, projectID, userID).Scan(
+		&account.ID,
+		&account.Email,
+		&account.Username,
+		&account.Name,
+		&account.IsBot,
+		&account.BotProjectID,
+		&account.CreatedAt,
+		&account.UpdatedAt,
+		&account.ProjectID,
+		&account.Role,
+		&account.CreatedBy,
+	)
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, pkgerrors.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return &account, nil
+}
+
+// ListByProject retrieves all active service accounts of a project
+func (r *ServiceAccountRepository) ListByProject(ctx context.Context, projectID int) ([]*models.ServiceAccount, error) {
+	query := `
+		SELECT u.id, u.email, u.username, u.name, u.is_bot, u.bot_project_id, u.created_at, u.updated_at,
+		       pm.project_id, pm.role, pm.invited_by
+		FROM users u
+		JOIN project_members pm ON pm.user_id = u.id AND pm.project_id = u.bot_project_id
+		WHERE u.is_bot = true AND u.bot_project_id = $1
+		ORDER BY u.username
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, projectID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	accounts := make([]*models.ServiceAccount, 0)
+	for rows.Next() {
+		var account models.ServiceAccount
+		err := rows.Scan(
+			&account.ID,
+			&account.Email,
+			&account.Username,
+			&account.Name,
+			&account.IsBot,
+			&account.BotProjectID,
+			&account.CreatedAt,
+			&account.UpdatedAt,
+			&account.ProjectID,
+			&account.Role,
+			&account.CreatedBy,
+		)
+		if err != nil {
+			return nil, err
+		}
+		accounts = append(accounts, &account)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return accounts, nil
+}
+
+// Deactivate revokes all tokens of a service account and removes its project membership
+// The user row is kept so past activities stay attributed to the bot
+func (r *ServiceAccountRepository) Deactivate(ctx context.Context, projectID, userID int) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(ctx, `
+		UPDATE service_account_tokens SET revoked_at = NOW()
+		WHERE user_id = $1 AND revoked_at IS NULL
+	`, userID)
+	if err != nil {
+		return err
+	}
+
+	result, err := tx.ExecContext(ctx, `
+		DELETE FROM project_members WHERE project_id = $1 AND user_id = $2
+	`, projectID, userID)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return pkgerrors.ErrNotFound
+	}
+
+	return tx.Commit()
+}
+
+// CreateToken stores a new token for a service account
+func (r *ServiceAccountRepository) CreateToken(ctx context.Context, token *models.ServiceAccountToken) (*models.ServiceAccountToken, error) {
+	query := `
+		INSERT INTO service_account_tokens (user_id, name, token_hash, token_prefix, created_by, expires_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING id, user_id, name, token_hash, token_prefix, created_by, expires_at, last_used_at, revoked_at, created_at
+	`
+
+	var created models.ServiceAccountToken
+	err := r.db.QueryRowContext(ctx, query,
+		token.UserID,
+		token.Name,
+		token.TokenHash,
+		token.TokenPrefix,
+		token.CreatedBy,
+		token.ExpiresAt,
+	).Scan(
+		&created.ID,
+		&created.UserID,
+		&created.Name,
+		&created.TokenHash,
+		&created.TokenPrefix,
+		&created.CreatedBy,
+		&created.ExpiresAt,
+		&created.LastUsedAt,
+		&created.RevokedAt,
+		&created.CreatedAt,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &created, nil
+}
+
+// ListTokens retrieves all tokens of a service account
+func (r *ServiceAccountRepository) ListTokens(ctx context.Context, userID int) ([]*models.ServiceAccountToken, error) {
+	query := `
+		SELECT id, user_id, name, token_hash, token_prefix, created_by, expires_at, last_used_at, revoked_at, created_at
+		FROM service_account_tokens
+		WHERE user_id = $1
+		ORDER BY created_at DESC
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	tokens := make([]*models.ServiceAccountToken, 0)
+	for rows.Next() {
+		var token models.ServiceAccountToken
+		err := rows.Scan(
+			&token.ID,
+			&token.UserID,
+			&token.Name,
+			&token.TokenHash,
+			&token.TokenPrefix,
+			&token.CreatedBy,
+			&token.ExpiresAt,
+			&token.LastUsedAt,
+			&token.RevokedAt,
+			&token.CreatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		tokens = append(tokens, &token)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return tokens, nil
+}
+
+// RevokeToken revokes a token of a service account
+func (r *ServiceAccountRepository) RevokeToken(ctx context.Context, userID, tokenID int) error {
+	query := `
+		UPDATE service_account_tokens SET revoked_at = NOW()
+		WHERE id = $1 AND user_id = $2 AND revoked_at IS NULL
+	`
+
+	result, err := r.db.ExecContext(ctx, query, tokenID, userID)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return pkgerrors.ErrNotFound
+	}
+
+	return nil
+}
+
+// Authenticate resolves a token hash to the owning bot user ID
+// Revoked and expired tokens are rejected; last_used_at is updated on success
+func (r *ServiceAccountRepository) Authenticate(ctx context.Context, tokenHash string) (int, error) {
+	query := `
+		UPDATE service_account_tokens t
+		SET last_used_at = NOW()
+		FROM users u
+		WHERE t.user_id = u.id
+		  AND u.is_bot = true
+		  AND t.token_hash = $1
+		  AND t.revoked_at IS NULL
+		  AND (t.expires_at IS NULL OR t.expires_at > NOW())
+		RETURNING t.user_id
+	`
+
+	var userID int
+	err := r.db.QueryRowContext(ctx, query, tokenHash).Scan(&userID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return 0, pkgerrors.ErrUnauthorized
+		}
+		return 0, err
+	}
+
+	return userID, nil
+}
@@ -0,0 +1,230 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/yourusername/issue-tracker/internal/models"
+	pkgerrors "github.com/yourusername/issue-tracker/pkg/errors"
+)
+
+func setupServiceAccountRepo(t *testing.T) (*ServiceAccountRepository, *models.Project, *models.User, *UserRepository, *ProjectMemberRepository, func()) {
+	db := setupTestDB(t)
+
+	cleanupData := func() {
+		db.Exec("DELETE FROM service_account_tokens WHERE user_id IN (SELECT id FROM users WHERE email LIKE '%@sacc.bots.invalid')")
+		db.Exec("DELETE FROM project_members WHERE project_id IN (SELECT id FROM projects WHERE key = 'SACC')")
+		db.Exec("DELETE FROM projects WHERE key = 'SACC'")
+		db.Exec("DELETE FROM users WHERE email LIKE '%@sacc.bots.invalid' OR email LIKE 'sacctest%@example.com'")
+	}
+	cleanupData()
+
+	userRepo := NewUserRepository(db)
+	projectRepo := NewProjectRepository(db)
+
+	owner, err := userRepo.Create(context.Background(), &models.User{
+		Email:        "sacctest1@example.com",
+		Username:     "sacctest1",
+		PasswordHash: "hashedpassword",
+	})
+	if err != nil {
+		t.Fatalf("Failed to create test user: %v", err)
+	}
+
+	project, err := projectRepo.Create(context.Background(), &models.Project{
+		Name:    "Service Account Project",
+		Key:     "SACC",
+		OwnerID: owner.ID,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create test project: %v", err)
+	}
+
+	cleanup := func() {
+		cleanupData()
+		db.Close()
+	}
+
+	return NewServiceAccountRepository(db), project, owner, userRepo, NewProjectMemberRepository(db), cleanup
+}
+
+func createTestServiceAccount(t *testing.T, repo *ServiceAccountRepository, project *models.Project, owner *models.User, username string) *models.ServiceAccount {
+	account, err := repo.Create(context.Background(), &models.ServiceAccount{
+		User: models.User{
+			Email:    username + "@sacc.bots.invalid",
+			Username: username,
+		},
+		ProjectID: project.ID,
+		Role:      models.RoleMember,
+		CreatedBy: &owner.ID,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create service account: %v", err)
+	}
+	return account
+}
+
+func TestServiceAccountRepository_Authenticate(t *testing.T) {
+	repo, project, owner, _, _, cleanup := setupServiceAccountRepo(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	account := createTestServiceAccount(t, repo, project, owner, "saccbot1")
+
+	createToken := func(t *testing.T, hash string, expiresAt *time.Time) *models.ServiceAccountToken {
+		token, err := repo.CreateToken(ctx, &models.ServiceAccountToken{
+			UserID:      account.ID,
+			Name:        hash,
+			TokenHash:   hash,
+			TokenPrefix: "flowbot_test",
+			CreatedBy:   &owner.ID,
+			ExpiresAt:   expiresAt,
+		})
+		if err != nil {
+			t.Fatalf("Failed to create token: %v", err)
+		}
+		return token
+	}
+
+	t.Run("should authenticate valid token", func(t *testing.T) {
+		future := time.Now().Add(time.Hour)
+		createToken(t, "sacc-valid-hash", &future)
+
+		userID, err := repo.Authenticate(ctx, "sacc-valid-hash")
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if userID != account.ID {
+			t.Errorf("Expected user ID %d, got %d", account.ID, userID)
+		}
+
+		tokens, err := repo.ListTokens(ctx, account.ID)
+		if err != nil {
+			t.Fatalf("Failed to list tokens: %v", err)
+		}
+		for _, token := range tokens {
+			if token.TokenHash == "sacc-valid-hash" && token.LastUsedAt == nil {
+				t.Error("Expected last_used_at to be set")
+			}
+		}
+	})
+
+	t.Run("should reject expired token", func(t *testing.T) {
+		past := time.Now().Add(-time.Hour)
+		createToken(t, "sacc-expired-hash", &past)
+
+		_, err := repo.Authenticate(ctx, "sacc-expired-hash")
+		if err != pkgerrors.ErrUnauthorized {
+			t.Errorf("Expected ErrUnauthorized, got %v", err)
+		}
+	})
+
+	t.Run("should reject revoked token", func(t *testing.T) {
+		token := createToken(t, "sacc-revoked-hash", nil)
+		if err := repo.RevokeToken(ctx, account.ID, token.ID); err != nil {
+			t.Fatalf("Failed to revoke token: %v", err)
+		}
+
+		_, err := repo.Authenticate(ctx, "sacc-revoked-hash")
+		if err != pkgerrors.ErrUnauthorized {
+			t.Errorf("Expected ErrUnauthorized, got %v", err)
+		}
+	})
+
+	t.Run("should reject unknown token", func(t *testing.T) {
+		_, err := repo.Authenticate(ctx, "sacc-unknown-hash")
+		if err != pkgerrors.ErrUnauthorized {
+			t.Errorf("Expected ErrUnauthorized, got %v", err)
+		}
+	})
+
+	t.Run("should reject tokens of deactivated account", func(t *testing.T) {
+		other := createTestServiceAccount(t, repo, project, owner, "saccbot2")
+		if _, err := repo.CreateToken(ctx, &models.ServiceAccountToken{
+			UserID:      other.ID,
+			Name:        "deactivated",
+			TokenHash:   "sacc-deactivated-hash",
+			TokenPrefix: "flowbot_test",
+		}); err != nil {
+			t.Fatalf("Failed to create token: %v", err)
+		}
+		if err := repo.Deactivate(ctx, project.ID, other.ID); err != nil {
+			t.Fatalf("Failed to deactivate account: %v", err)
+		}
+
+		_, err := repo.Authenticate(ctx, "sacc-deactivated-hash")
+		if err != pkgerrors.ErrUnauthorized {
+			t.Errorf("Expected ErrUnauthorized, got %v", err)
+		}
+	})
+}
+
+func TestServiceAccountRepository_ExcludedFromListings(t *testing.T) {
+	repo, project, owner, userRepo, memberRepo, cleanup := setupServiceAccountRepo(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	account := createTestServiceAccount(t, repo, project, owner, "sacctestbot")
+
+	containsUser := func(users []*models.User, id int) bool {
+		for _, user := range users {
+			if user.ID == id {
+				return true
+			}
+		}
+		return false
+	}
+
+	containsMember := func(members []*models.ProjectMember, id int) bool {
+		for _, member := range members {
+			if member.UserID == id {
+				return true
+			}
+		}
+		return false
+	}
+
+	t.Run("should exclude bots from search by default", func(t *testing.T) {
+		users, err := userRepo.Search(ctx, "sacctest", 50, false)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if containsUser(users, account.ID) {
+			t.Error("Expected service account to be excluded")
+		}
+		if !containsUser(users, owner.ID) {
+			t.Error("Expected human user to be included")
+		}
+	})
+
+	t.Run("should include bots in search when requested", func(t *testing.T) {
+		users, err := userRepo.Search(ctx, "sacctest", 50, true)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if !containsUser(users, account.ID) {
+			t.Error("Expected service account to be included")
+		}
+	})
+
+	t.Run("should exclude bots from human member list", func(t *testing.T) {
+		members, err := memberRepo.ListHumansByProjectID(ctx, project.ID)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if containsMember(members, account.ID) {
+			t.Error("Expected service account to be excluded")
+		}
+	})
+
+	t.Run("should include bots in full member list", func(t *testing.T) {
+		members, err := memberRepo.ListByProjectID(ctx, project.ID)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if !containsMember(members, account.ID) {
+			t.Error("Expected service account to be included")
+		}
+	})
+}
@@ -0,0 +1,260 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+
+	"github.com/lib/pq"
+	"github.com/yourusername/issue-tracker/internal/models"
+	pkgerrors "github.com/yourusername/issue-tracker/pkg/errors"
+)
+
+// SprintRepository handles sprint data access
+type SprintRepository struct {
+	db *sql.DB
+}
+
+// NewSprintRepository creates a new sprint repository
+func NewSprintRepository(db *sql.DB) *SprintRepository {
+	return &SprintRepository{db: db}
+}
+
+// sprintColumns is the column list scanned by scanSprint
+const sprintColumns = `s.id, s.board_id, s.project_id, s.name, s.goal, s.start_date, s.end_date, s.state,
+	s.committed_issue_ids, s.report, s.started_at, s.completed_at, s.created_by, s.created_at, s.updated_at,
+	(SELECT COUNT(*) FROM issues i WHERE i.sprint_id = s.id AND i.deleted_at IS NULL),
+	(SELECT COUNT(*) FROM issues i WHERE i.sprint_id = s.id AND i.deleted_at IS NULL AND i.status_category = 'done')`
+
+// scanSprint scans a sprint row selected with sprintColumns
+func scanSprint(row rowScanner, sprint *models.Sprint) error {
+	var committed pq.Int64Array
+	var reportJSON []byte
+	err := row.Scan(
+		&sprint.ID,
+		&sprint.BoardID,
+		&sprint.ProjectID,
+		&sprint.Name,
+		&sprint.Goal,
+		&sprint.StartDate,
+		&sprint.EndDate,
+		&sprint.State,
+		&committed,
+		&reportJSON,
+		&sprint.StartedAt,
+		&sprint.CompletedAt,
+		&sprint.CreatedBy,
+		&sprint.CreatedAt,
+		&sprint.UpdatedAt,
+		&sprint.TotalIssues,
+		&sprint.CompletedIssues,
+	)
+	if err != nil {
+		return err
+	}
+
+	sprint.CommittedIssueIDs = make([]int, len(committed))
+	for i, id := range committed {
+		sprint.CommittedIssueIDs[i] = int(id)
+	}
+
+	if reportJSON != nil {
+		sprint.Report = &models.SprintReport{}
+		if err := json.Unmarshal(reportJSON, sprint.Report); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Create creates a future sprint
+func (r *SprintRepository) Create(ctx context.Context, sprint *models.Sprint) (*models.Sprint, error) {
+	var id int
+	err := r.db.QueryRowContext(ctx, `
+		INSERT INTO sprints (board_id, project_id, name, goal, start_date, end_date, created_by)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING id
+	`, sprint.BoardID, sprint.ProjectID, sprint.Name, sprint.Goal, sprint.StartDate, sprint.EndDate, sprint.CreatedBy).Scan(&id)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.GetByID(ctx, id)
+}
+
+// GetByID retrieves a sprint by ID
+func (r *SprintRepository) GetByID(ctx context.Context, id int) (*models.Sprint, error) {
+	var sprint models.Sprint
+	err := scanSprint(r.db.QueryRowContext(ctx, `
+		SELECT `+sprintColumns+`
+		FROM sprints s
+		WHERE s.id = $1
+	`, id), &sprint)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, pkgerrors.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return &sprint, nil
+}
+
+// ListByBoardID retrieves the sprints of a board: active first, then future
+// sprints in planned order, then closed sprints newest first
+func (r *SprintRepository) ListByBoardID(ctx context.Context, boardID int, state *models.SprintState) ([]*models.Sprint, error) {
+	query := `
+		SELECT ` + sprintColumns + `
+		FROM sprints s
+		WHERE s.board_id = $1 AND ($2::VARCHAR IS NULL OR s.state = $2)
+		ORDER BY CASE s.state WHEN 'active' THEN 0 WHEN 'future' THEN 1 ELSE 2 END,
+			CASE WHEN s.state = 'closed' THEN s.completed_at END DESC,
+			s.start_date ASC NULLS LAST, s.id ASC
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, boardID, state)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	sprints := make([]*models.Sprint, 0)
+	for rows.Next() {
+		var sprint models.Sprint
+		if err := scanSprint(rows, &sprint); err != nil {
+			return nil, err
+		}
+		sprints = append(sprints, &sprint)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return sprints, nil
+}
+
+// GetNextFutureSprint retrieves the next future sprint of a board after a sprint
+// Returns ErrNotFound when none is planned
+func (r *SprintRepository) GetNextFutureSprint(ctx context.Context, boardID int, afterSprintID int) (*models.Sprint, error) {
+	var sprint models.Sprint
+	err := scanSprint(r.db.QueryRowContext(ctx, `
+		SELECT `+sprintColumns+`
+		FROM sprints s
+		WHERE s.board_id = $1 AND s.state = 'future' AND s.id <> $2
+		ORDER BY s.start_date ASC NULLS LAST, s.id ASC
+		LIMIT 1
+	`, boardID, afterSprintID), &sprint)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, pkgerrors.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return &sprint, nil
+}
+
+// Update updates the name, goal and dates of a sprint
+func (r *SprintRepository) Update(ctx context.Context, sprint *models.Sprint) error {
+	result, err := r.db.ExecContext(ctx, `
+		UPDATE sprints
+		SET name = $1, goal = $2, start_date = $3, end_date = $4, updated_at = NOW()
+		WHERE id = $5
+	`, sprint.Name, sprint.Goal, sprint.StartDate, sprint.EndDate, sprint.ID)
+	if err != nil {
+		return err
+	}
+
+	return requireRowsAffected(result)
+}
+
+// Delete deletes a sprint; its issues return to the backlog
+func (r *SprintRepository) Delete(ctx context.Context, id int) error {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM sprints WHERE id = $1`, id)
+	if err != nil {
+		return err
+	}
+
+	return requireRowsAffected(result)
+}
+
+// SetIssueSprint moves issues of a project into a sprint, or to the backlog
+// when sprintID is nil
+// Returns the number of issues moved
+func (r *SprintRepository) SetIssueSprint(ctx context.Context, projectID int, issueIDs []int, sprintID *int) (int, error) {
+	result, err := r.db.ExecContext(ctx, `
+		UPDATE issues
+		SET sprint_id = $1, updated_at = NOW()
+		WHERE id = ANY($2) AND project_id = $3 AND deleted_at IS NULL
+	`, sprintID, pq.Array(issueIDs), projectID)
+	if err != nil {
+		return 0, err
+	}
+
+	moved, err := result.RowsAffected()
+	return int(moved), err
+}
+
+// Start activates a future sprint and records the issues committed to it
+// Returns ErrConflict when the board already has an active sprint
+func (r *SprintRepository) Start(ctx context.Context, sprint *models.Sprint) error {
+	result, err := r.db.ExecContext(ctx, `
+		UPDATE sprints
+		SET state = 'active', start_date = $1, end_date = $2, started_at = NOW(), updated_at = NOW(),
+			committed_issue_ids = COALESCE(
+				(SELECT array_agg(id ORDER BY id) FROM issues WHERE sprint_id = $3 AND deleted_at IS NULL),
+				'{}'
+			)
+		WHERE id = $3 AND state = 'future'
+	`, sprint.StartDate, sprint.EndDate, sprint.ID)
+	if err != nil {
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code == "23505" { // Another sprint of the board is active
+			return pkgerrors.ErrConflict
+		}
+		return err
+	}
+
+	return requireRowsAffected(result)
+}
+
+// Complete closes an active sprint with its report and moves its unfinished
+// issues to another sprint, or to the backlog when nextSprintID is nil
+func (r *SprintRepository) Complete(ctx context.Context, sprintID int, report *models.SprintReport, nextSprintID *int) error {
+	reportJSON, err := json.Marshal(report)
+	if err != nil {
+		return err
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	result, err := tx.ExecContext(ctx, `
+		UPDATE sprints
+		SET state = 'closed', report = $1, completed_at = NOW(), updated_at = NOW()
+		WHERE id = $2 AND state = 'active'
+	`, reportJSON, sprintID)
+	if err != nil {
+		return err
+	}
+	if err := requireRowsAffected(result); err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		UPDATE issues
+		SET sprint_id = $1, updated_at = NOW()
+		WHERE sprint_id = $2 AND status_category <> 'done' AND deleted_at IS NULL
+	`, nextSprintID, sprintID)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
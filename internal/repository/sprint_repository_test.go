@@ -0,0 +1,126 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/yourusername/issue-tracker/internal/models"
+	pkgerrors "github.com/yourusername/issue-tracker/pkg/errors"
+)
+
+func TestSprintRepository_Lifecycle(t *testing.T) {
+	boardRepo, projectRepo, userRepo, cleanup := setupBoardRepo(t)
+	defer cleanup()
+
+	db := boardRepo.db
+	defer db.Exec("DELETE FROM sprints")
+	defer db.Exec("DELETE FROM issues")
+
+	sprintRepo := NewSprintRepository(db)
+	issueRepo := NewIssueRepository(db)
+	ctx := context.Background()
+
+	project := createTestProjectForBoard(t, projectRepo, userRepo)
+	if err := boardRepo.CreateDefaultColumns(ctx, project.ID); err != nil {
+		t.Fatalf("Failed to create default columns: %v", err)
+	}
+	board, err := boardRepo.GetDefaultBoard(ctx, project.ID)
+	if err != nil {
+		t.Fatalf("Failed to get default board: %v", err)
+	}
+
+	createIssue := func(title string, category models.StatusCategory) *models.Issue {
+		issue, err := issueRepo.Create(ctx, &models.Issue{
+			ProjectID:      project.ID,
+			Title:          title,
+			Status:         models.IssueStatusOpen,
+			StatusCategory: category,
+			Priority:       models.PriorityMedium,
+			ReporterID:     project.OwnerID,
+		})
+		if err != nil {
+			t.Fatalf("Failed to create issue: %v", err)
+		}
+		return issue
+	}
+
+	done := createIssue("Done issue", models.StatusCategoryDone)
+	open := createIssue("Open issue", models.StatusCategoryTodo)
+
+	start := time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC)
+	end := start.AddDate(0, 0, 14)
+	sprint, err := sprintRepo.Create(ctx, &models.Sprint{
+		BoardID:   board.ID,
+		ProjectID: project.ID,
+		Name:      "Sprint 1",
+		StartDate: &start,
+		EndDate:   &end,
+	})
+	if err != nil {
+		t.Fatalf("Expected no error creating sprint, got %v", err)
+	}
+	if sprint.State != models.SprintStateFuture {
+		t.Errorf("Expected future sprint, got %s", sprint.State)
+	}
+
+	next, err := sprintRepo.Create(ctx, &models.Sprint{BoardID: board.ID, ProjectID: project.ID, Name: "Sprint 2"})
+	if err != nil {
+		t.Fatalf("Expected no error creating sprint, got %v", err)
+	}
+
+	t.Run("should plan issues into a sprint", func(t *testing.T) {
+		moved, err := sprintRepo.SetIssueSprint(ctx, project.ID, []int{done.ID, open.ID}, &sprint.ID)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if moved != 2 {
+			t.Errorf("Expected 2 issues moved, got %d", moved)
+		}
+
+		backlog, err := issueRepo.List(ctx, &models.IssueFilter{ProjectID: project.ID, InBacklog: true})
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if len(backlog) != 0 {
+			t.Errorf("Expected empty backlog, got %d issues", len(backlog))
+		}
+	})
+
+	t.Run("should allow one active sprint per board", func(t *testing.T) {
+		if err := sprintRepo.Start(ctx, sprint); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		started, _ := sprintRepo.GetByID(ctx, sprint.ID)
+		if started.State != models.SprintStateActive || len(started.CommittedIssueIDs) != 2 {
+			t.Errorf("Expected active sprint with 2 committed issues, got %s with %d", started.State, len(started.CommittedIssueIDs))
+		}
+
+		next.EndDate = &end
+		if err := sprintRepo.Start(ctx, next); err != pkgerrors.ErrConflict {
+			t.Errorf("Expected ErrConflict, got %v", err)
+		}
+	})
+
+	t.Run("should move unfinished issues on completion", func(t *testing.T) {
+		report := &models.SprintReport{CommittedIssues: 2, CompletedIssues: 1, IncompleteIssues: 1}
+		if err := sprintRepo.Complete(ctx, sprint.ID, report, &next.ID); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		closed, _ := sprintRepo.GetByID(ctx, sprint.ID)
+		if closed.State != models.SprintStateClosed || closed.Report == nil || closed.Report.CompletedIssues != 1 {
+			t.Errorf("Expected closed sprint with report, got %+v", closed)
+		}
+
+		moved, _ := issueRepo.GetByID(ctx, open.ID)
+		if moved.SprintID == nil || *moved.SprintID != next.ID {
+			t.Errorf("Expected open issue in the next sprint, got %v", moved.SprintID)
+		}
+		kept, _ := issueRepo.GetByID(ctx, done.ID)
+		if kept.SprintID == nil || *kept.SprintID != sprint.ID {
+			t.Errorf("Expected done issue to stay in the closed sprint, got %v", kept.SprintID)
+		}
+	})
+}
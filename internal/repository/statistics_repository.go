@@ -23,8 +23,14 @@ func (r *StatisticsRepository) GetProjectStatistics(ctx context.Context, project
 		SELECT
 			-- Issue counts by status
 			COUNT(CASE WHEN i.deleted_at IS NULL THEN 1 END) as total_issues,
-			COUNT(CASE WHEN i.status = 'open' AND i.deleted_at IS NULL THEN 1 END) as open_issues,
-			COUNT(CASE WHEN i.status = 'closed' AND i.deleted_at IS NULL THEN 1 END) as closed_issues,
+			COUNT(CASE WHEN i.status_category = 'todo' AND i.deleted_at IS NULL THEN 1 END) as open_issues,
+			COUNT(CASE WHEN i.status_category = 'done' AND i.deleted_at IS NULL THEN 1 END) as closed_issues,
+
+			-- Estimate totals
+			COALESCE(SUM(CASE WHEN i.deleted_at IS NULL THEN i.story_points END), 0) as total_story_points,
+			COALESCE(SUM(CASE WHEN i.status_category = 'done' AND i.deleted_at IS NULL THEN i.story_points END), 0) as completed_story_points,
+			COALESCE(SUM(CASE WHEN i.deleted_at IS NULL THEN i.original_estimate_minutes END), 0) as total_estimate_minutes,
+			COALESCE(SUM(CASE WHEN i.status_category = 'done' AND i.deleted_at IS NULL THEN i.original_estimate_minutes END), 0) as completed_estimate_minutes,
 
 			-- Issue counts by priority
 			COUNT(CASE WHEN i.priority = 'critical' AND i.deleted_at IS NULL THEN 1 END) as critical_issues,
@@ -53,6 +59,10 @@ func (r *StatisticsRepository) GetProjectStatistics(ctx context.Context, project
 		&stats.TotalIssues,
 		&stats.OpenIssues,
 		&stats.ClosedIssues,
+		&stats.TotalStoryPoints,
+		&stats.CompletedStoryPoints,
+		&stats.TotalEstimateMinutes,
+		&stats.CompletedEstimateMinutes,
 		&stats.CriticalIssues,
 		&stats.HighIssues,
 		&stats.MediumIssues,
@@ -81,7 +91,12 @@ func (r *StatisticsRepository) GetIssueStatistics(ctx context.Context, projectID
 			COUNT(CASE WHEN created_at >= NOW() - INTERVAL '30 days' THEN 1 END) as created_last_30,
 
 			-- Issues closed in last 30 days
-			COUNT(CASE WHEN status = 'closed' AND updated_at >= NOW() - INTERVAL '30 days' THEN 1 END) as closed_last_30
+			COUNT(CASE WHEN status_category = 'done' AND updated_at >= NOW() - INTERVAL '30 days' THEN 1 END) as closed_last_30,
+
+			-- Votes and customer requests
+			COALESCE(SUM(upvotes), 0) as total_upvotes,
+			COALESCE(SUM(downvotes), 0) as total_downvotes,
+			COALESCE(SUM(customer_request_count), 0) as total_customer_requests
 		FROM issues
 		WHERE project_id = $1 AND deleted_at IS NULL
 	`
@@ -94,24 +109,62 @@ func (r *StatisticsRepository) GetIssueStatistics(ctx context.Context, projectID
 		&stats.AverageResolutionDays,
 		&stats.IssuesCreatedLast30Days,
 		&stats.IssuesClosedLast30Days,
+		&stats.TotalUpvotes,
+		&stats.TotalDownvotes,
+		&stats.TotalCustomerRequests,
 	)
 
 	if err != nil {
 		return nil, err
 	}
 
+	stats.IssuesByAssignee, err = r.countIssuesByAssignee(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
 	return stats, nil
 }
 
+// countIssuesByAssignee counts the open issues of a project per assignee
+// username; an issue with several assignees counts for each of them
+func (r *StatisticsRepository) countIssuesByAssignee(ctx context.Context, projectID int) (map[string]int, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT u.username, COUNT(*)
+		FROM issue_assignees ia
+		JOIN issues i ON i.id = ia.issue_id
+		JOIN users u ON u.id = ia.user_id
+		WHERE i.project_id = $1 AND i.deleted_at IS NULL AND i.status_category <> 'done'
+		GROUP BY u.username
+	`, projectID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	counts := make(map[string]int)
+	for rows.Next() {
+		var username string
+		var count int
+		if err := rows.Scan(&username, &count); err != nil {
+			return nil, err
+		}
+		counts[username] = count
+	}
+
+	return counts, rows.Err()
+}
+
 // GetUserActivityStatistics retrieves activity statistics for a user
 func (r *StatisticsRepository) GetUserActivityStatistics(ctx context.Context, userID int) (*models.UserActivityStatistics, error) {
 	query := `
 		SELECT
 			COUNT(CASE WHEN i.reporter_id = $1 THEN 1 END) as issues_created,
-			COUNT(CASE WHEN i.assignee_id = $1 THEN 1 END) as issues_assigned,
-			COUNT(CASE WHEN i.assignee_id = $1 AND i.status = 'closed' THEN 1 END) as issues_closed,
+			COUNT(CASE WHEN ia.user_id IS NOT NULL THEN 1 END) as issues_assigned,
+			COUNT(CASE WHEN ia.user_id IS NOT NULL AND i.status_category = 'done' THEN 1 END) as issues_closed,
 			(SELECT COUNT(*) FROM comments WHERE user_id = $1) as comments_posted
 		FROM issues i
+		LEFT JOIN issue_assignees ia ON ia.issue_id = i.id AND ia.user_id = $1
 		WHERE i.deleted_at IS NULL
 	`
 
@@ -0,0 +1,348 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/lib/pq"
+	"github.com/yourusername/issue-tracker/internal/models"
+	pkgerrors "github.com/yourusername/issue-tracker/pkg/errors"
+)
+
+// TeamRepository handles team, team member and project team data access
+type TeamRepository struct {
+	db *sql.DB
+}
+
+// NewTeamRepository creates a new team repository
+func NewTeamRepository(db *sql.DB) *TeamRepository {
+	return &TeamRepository{db: db}
+}
+
+const teamColumns = `
+	t.id, t.organization_id, t.name, t.slug, t.description, t.created_by,
+	(SELECT COUNT(*) FROM team_members tm WHERE tm.team_id = t.id) AS member_count,
+	t.created_at, t.updated_at
+`
+
+// scanTeam scans the teamColumns (plus any extra destinations)
+func scanTeam(row rowScanner, team *models.Team, extra ...interface{}) error {
+	dest := []interface{}{
+		&team.ID,
+		&team.OrganizationID,
+		&team.Name,
+		&team.Slug,
+		&team.Description,
+		&team.CreatedBy,
+		&team.MemberCount,
+		&team.CreatedAt,
+		&team.UpdatedAt,
+	}
+	return row.Scan(append(dest, extra...)...)
+}
+
+// Create creates a new team
+func (r *TeamRepository) Create(ctx context.Context, team *models.Team) (*models.Team, error) {
+	var created models.Team
+	row := r.db.QueryRowContext(ctx, `
+		INSERT INTO teams AS t (organization_id, name, slug, description, created_by)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING `+teamColumns, team.OrganizationID, team.Name, team.Slug, team.Description, team.CreatedBy)
+	if err := scanTeam(row, &created); err != nil {
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code == "23505" {
+			return nil, pkgerrors.ErrConflict
+		}
+		return nil, err
+	}
+
+	return &created, nil
+}
+
+// GetByID retrieves a team by ID
+func (r *TeamRepository) GetByID(ctx context.Context, id int) (*models.Team, error) {
+	var team models.Team
+	row := r.db.QueryRowContext(ctx, `SELECT `+teamColumns+` FROM teams t WHERE t.id = $1`, id)
+	if err := scanTeam(row, &team); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, pkgerrors.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return &team, nil
+}
+
+// GetBySlug retrieves a team of an organization by its slug
+func (r *TeamRepository) GetBySlug(ctx context.Context, orgID int, slug string) (*models.Team, error) {
+	var team models.Team
+	row := r.db.QueryRowContext(ctx, `
+		SELECT `+teamColumns+` FROM teams t WHERE t.organization_id = $1 AND t.slug = $2
+	`, orgID, slug)
+	if err := scanTeam(row, &team); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, pkgerrors.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return &team, nil
+}
+
+// GetBySlugForProject retrieves a team by slug from the organization of a project
+// Returns ErrNotFound for personal projects
+func (r *TeamRepository) GetBySlugForProject(ctx context.Context, projectID int, slug string) (*models.Team, error) {
+	var team models.Team
+	row := r.db.QueryRowContext(ctx, `
+		SELECT `+teamColumns+`
+		FROM teams t
+		JOIN projects p ON p.organization_id = t.organization_id
+		WHERE p.id = $1 AND t.slug = $2
+	`, projectID, slug)
+	if err := scanTeam(row, &team); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, pkgerrors.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return &team, nil
+}
+
+// ListByOrganizationID retrieves all teams of an organization
+func (r *TeamRepository) ListByOrganizationID(ctx context.Context, orgID int) ([]*models.Team, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT `+teamColumns+` FROM teams t WHERE t.organization_id = $1 ORDER BY t.name
+	`, orgID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	teams := make([]*models.Team, 0)
+	for rows.Next() {
+		var team models.Team
+		if err := scanTeam(rows, &team); err != nil {
+			return nil, err
+		}
+		teams = append(teams, &team)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return teams, nil
+}
+
+// Update updates the name and description of a team
+func (r *TeamRepository) Update(ctx context.Context, team *models.Team) error {
+	result, err := r.db.ExecContext(ctx, `
+		UPDATE teams SET name = $1, description = $2, updated_at = NOW() WHERE id = $3
+	`, team.Name, team.Description, team.ID)
+	if err != nil {
+		return err
+	}
+
+	return requireRowsAffected(result)
+}
+
+// Delete deletes a team
+// Project grants and memberships cascade; assigned issues are unassigned
+func (r *TeamRepository) Delete(ctx context.Context, id int) error {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM teams WHERE id = $1`, id)
+	if err != nil {
+		return err
+	}
+
+	return requireRowsAffected(result)
+}
+
+// AddMember adds a user to a team
+func (r *TeamRepository) AddMember(ctx context.Context, member *models.TeamMember) error {
+	_, err := r.db.ExecContext(ctx, `
+		INSERT INTO team_members (team_id, user_id, added_by)
+		VALUES ($1, $2, $3)
+	`, member.TeamID, member.UserID, member.AddedBy)
+	if err != nil {
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code == "23505" {
+			return pkgerrors.ErrConflict
+		}
+		return err
+	}
+
+	return nil
+}
+
+// RemoveMember removes a user from a team
+func (r *TeamRepository) RemoveMember(ctx context.Context, teamID, userID int) error {
+	result, err := r.db.ExecContext(ctx, `
+		DELETE FROM team_members WHERE team_id = $1 AND user_id = $2
+	`, teamID, userID)
+	if err != nil {
+		return err
+	}
+
+	return requireRowsAffected(result)
+}
+
+// ListMembers retrieves all members of a team with their user information
+func (r *TeamRepository) ListMembers(ctx context.Context, teamID int) ([]*models.TeamMember, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT tm.team_id, tm.user_id, tm.added_at, tm.added_by,
+		       u.id, u.email, u.username, u.name, u.is_bot, u.created_at, u.updated_at
+		FROM team_members tm
+		JOIN users u ON tm.user_id = u.id
+		WHERE tm.team_id = $1
+		ORDER BY u.username
+	`, teamID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	members := make([]*models.TeamMember, 0)
+	for rows.Next() {
+		member := &models.TeamMember{
+			User: &models.User{},
+		}
+
+		err := rows.Scan(
+			&member.TeamID,
+			&member.UserID,
+			&member.AddedAt,
+			&member.AddedBy,
+			&member.User.ID,
+			&member.User.Email,
+			&member.User.Username,
+			&member.User.Name,
+			&member.User.IsBot,
+			&member.User.CreatedAt,
+			&member.User.UpdatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		members = append(members, member)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return members, nil
+}
+
+// ListMemberIDs retrieves the user IDs of a team's members
+func (r *TeamRepository) ListMemberIDs(ctx context.Context, teamID int) ([]int, error) {
+	rows, err := r.db.QueryContext(ctx, `SELECT user_id FROM team_members WHERE team_id = $1`, teamID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	userIDs := make([]int, 0)
+	for rows.Next() {
+		var userID int
+		if err := rows.Scan(&userID); err != nil {
+			return nil, err
+		}
+		userIDs = append(userIDs, userID)
+	}
+
+	return userIDs, rows.Err()
+}
+
+// AddProject grants a team a role in a project
+func (r *TeamRepository) AddProject(ctx context.Context, projectTeam *models.ProjectTeam) error {
+	_, err := r.db.ExecContext(ctx, `
+		INSERT INTO project_teams (project_id, team_id, role, added_by)
+		VALUES ($1, $2, $3, $4)
+	`, projectTeam.ProjectID, projectTeam.TeamID, projectTeam.Role, projectTeam.AddedBy)
+	if err != nil {
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code == "23505" {
+			return pkgerrors.ErrConflict
+		}
+		return err
+	}
+
+	return nil
+}
+
+// UpdateProjectRole changes the role a team has in a project
+func (r *TeamRepository) UpdateProjectRole(ctx context.Context, projectID, teamID int, role models.ProjectRole) error {
+	result, err := r.db.ExecContext(ctx, `
+		UPDATE project_teams SET role = $1 WHERE project_id = $2 AND team_id = $3
+	`, role, projectID, teamID)
+	if err != nil {
+		return err
+	}
+
+	return requireRowsAffected(result)
+}
+
+// RemoveProject revokes a team's project role
+func (r *TeamRepository) RemoveProject(ctx context.Context, projectID, teamID int) error {
+	result, err := r.db.ExecContext(ctx, `
+		DELETE FROM project_teams WHERE project_id = $1 AND team_id = $2
+	`, projectID, teamID)
+	if err != nil {
+		return err
+	}
+
+	return requireRowsAffected(result)
+}
+
+// ListByProjectID retrieves the teams granted a role in a project
+func (r *TeamRepository) ListByProjectID(ctx context.Context, projectID int) ([]*models.ProjectTeam, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT pt.project_id, pt.team_id, pt.role, pt.added_at, pt.added_by, `+teamColumns+`
+		FROM project_teams pt
+		JOIN teams t ON t.id = pt.team_id
+		WHERE pt.project_id = $1
+		ORDER BY t.name
+	`, projectID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	projectTeams := make([]*models.ProjectTeam, 0)
+	for rows.Next() {
+		projectTeam := &models.ProjectTeam{
+			Team: &models.Team{},
+		}
+
+		err := rows.Scan(
+			&projectTeam.ProjectID,
+			&projectTeam.TeamID,
+			&projectTeam.Role,
+			&projectTeam.AddedAt,
+			&projectTeam.AddedBy,
+			&projectTeam.Team.ID,
+			&projectTeam.Team.OrganizationID,
+			&projectTeam.Team.Name,
+			&projectTeam.Team.Slug,
+			&projectTeam.Team.Description,
+			&projectTeam.Team.CreatedBy,
+			&projectTeam.Team.MemberCount,
+			&projectTeam.Team.CreatedAt,
+			&projectTeam.Team.UpdatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		projectTeams = append(projectTeams, projectTeam)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return projectTeams, nil
+}
@@ -0,0 +1,85 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/yourusername/issue-tracker/internal/models"
+	pkgerrors "github.com/yourusername/issue-tracker/pkg/errors"
+)
+
+// TwoFactorRepository handles two-factor authentication data access
+type TwoFactorRepository struct {
+	db *sql.DB
+}
+
+// NewTwoFactorRepository creates a new two-factor repository
+func NewTwoFactorRepository(db *sql.DB) *TwoFactorRepository {
+	return &TwoFactorRepository{db: db}
+}
+
+// Get retrieves the two-factor authentication of a user
+func (r *TwoFactorRepository) Get(ctx context.Context, userID int) (*models.TwoFactor, error) {
+	tf := &models.TwoFactor{}
+	err := r.db.QueryRowContext(ctx, `
+		SELECT user_id, secret, enabled_at, created_at FROM user_two_factor WHERE user_id = $1
+	`, userID).Scan(&tf.UserID, &tf.Secret, &tf.EnabledAt, &tf.CreatedAt)
+	if err == sql.ErrNoRows {
+		return nil, pkgerrors.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return tf, nil
+}
+
+// IsEnabled reports whether the user has confirmed two-factor authentication
+func (r *TwoFactorRepository) IsEnabled(ctx context.Context, userID int) (bool, error) {
+	var enabled bool
+	err := r.db.QueryRowContext(ctx, `
+		SELECT EXISTS (SELECT 1 FROM user_two_factor WHERE user_id = $1 AND enabled_at IS NOT NULL)
+	`, userID).Scan(&enabled)
+	return enabled, err
+}
+
+// SavePending stores a new secret awaiting confirmation, replacing an earlier
+// pending one; it returns ErrConflict when two-factor is already enabled
+func (r *TwoFactorRepository) SavePending(ctx context.Context, userID int, secret string) error {
+	result, err := r.db.ExecContext(ctx, `
+		INSERT INTO user_two_factor (user_id, secret) VALUES ($1, $2)
+		ON CONFLICT (user_id) DO UPDATE SET secret = EXCLUDED.secret, created_at = NOW()
+		WHERE user_two_factor.enabled_at IS NULL
+	`, userID, secret)
+	if err != nil {
+		return err
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return pkgerrors.ErrConflict
+	}
+	return nil
+}
+
+// Enable confirms the pending secret of a user
+func (r *TwoFactorRepository) Enable(ctx context.Context, userID int) error {
+	result, err := r.db.ExecContext(ctx, `
+		UPDATE user_two_factor SET enabled_at = NOW() WHERE user_id = $1 AND enabled_at IS NULL
+	`, userID)
+	if err != nil {
+		return err
+	}
+	return requireRowsAffected(result)
+}
+
+// Delete removes the two-factor authentication of a user
+func (r *TwoFactorRepository) Delete(ctx context.Context, userID int) error {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM user_two_factor WHERE user_id = $1`, userID)
+	if err != nil {
+		return err
+	}
+	return requireRowsAffected(result)
+}
@@ -23,15 +23,16 @@ func NewUserRepository(db *sql.DB) *UserRepository {
 // Create creates a new user
 func (r *UserRepository) Create(ctx context.Context, user *models.User) (*models.User, error) {
 	query := `
-		INSERT INTO users (email, username, password_hash, name, avatar_url, external_id, external_provider)
-		VALUES ($1, $2, $3, $4, $5, $6, $7)
-		RETURNING id, email, username, name, password_hash, avatar_url, external_id, external_provider, created_at, updated_at
+		INSERT INTO users (email, username, password_hash, name, avatar_url, external_id, external_provider, is_bot, bot_project_id)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		RETURNING id, email, username, name, password_hash, avatar_url, external_id, external_provider, is_bot, bot_project_id, created_at, updated_at
 	`
 
 	var createdUser models.User
 	err := r.db.QueryRowContext(ctx, query,
 		user.Email, user.Username, user.PasswordHash,
 		user.Name, user.AvatarURL, user.ExternalID, user.ExternalProvider,
+		user.IsBot, user.BotProjectID,
 	).Scan(
 		&createdUser.ID,
 		&createdUser.Email,
@@ -41,6 +42,8 @@ func (r *UserRepository) Create(ctx context.Context, user *models.User) (*models
 		&createdUser.AvatarURL,
 		&createdUser.ExternalID,
 		&createdUser.ExternalProvider,
+		&createdUser.IsBot,
+		&createdUser.BotProjectID,
 		&createdUser.CreatedAt,
 		&createdUser.UpdatedAt,
 	)
@@ -52,6 +55,10 @@ func (r *UserRepository) Create(ctx context.Context, user *models.User) (*models
 			if pqErr.Code == "23505" {
 				return nil, pkgerrors.ErrConflict
 			}
+			// 23514 is a check violation: the username is reserved
+			if pqErr.Code == "23514" {
+				return nil, pkgerrors.ErrValidation
+			}
 		}
 		return nil, err
 	}
@@ -62,7 +69,7 @@ func (r *UserRepository) Create(ctx context.Context, user *models.User) (*models
 // GetByEmail retrieves a user by email
 func (r *UserRepository) GetByEmail(ctx context.Context, email string) (*models.User, error) {
 	query := `
-		SELECT id, email, username, name, password_hash, avatar_url, external_id, external_provider, created_at, updated_at
+		SELECT id, email, username, name, password_hash, avatar_url, external_id, external_provider, is_bot, bot_project_id, created_at, updated_at
 		FROM users
 		WHERE email = $1
 	`
@@ -77,6 +84,8 @@ func (r *UserRepository) GetByEmail(ctx context.Context, email string) (*models.
 		&user.AvatarURL,
 		&user.ExternalID,
 		&user.ExternalProvider,
+		&user.IsBot,
+		&user.BotProjectID,
 		&user.CreatedAt,
 		&user.UpdatedAt,
 	)
@@ -94,7 +103,7 @@ func (r *UserRepository) GetByEmail(ctx context.Context, email string) (*models.
 // GetByID retrieves a user by ID
 func (r *UserRepository) GetByID(ctx context.Context, id int) (*models.User, error) {
 	query := `
-		SELECT id, email, username, name, password_hash, avatar_url, external_id, external_provider, created_at, updated_at
+		SELECT id, email, username, name, password_hash, avatar_url, external_id, external_provider, is_bot, bot_project_id, created_at, updated_at
 		FROM users
 		WHERE id = $1
 	`
@@ -109,6 +118,8 @@ func (r *UserRepository) GetByID(ctx context.Context, id int) (*models.User, err
 		&user.AvatarURL,
 		&user.ExternalID,
 		&user.ExternalProvider,
+		&user.IsBot,
+		&user.BotProjectID,
 		&user.CreatedAt,
 		&user.UpdatedAt,
 	)
@@ -126,7 +137,7 @@ func (r *UserRepository) GetByID(ctx context.Context, id int) (*models.User, err
 // GetByExternalID retrieves a user by external ID and provider
 func (r *UserRepository) GetByExternalID(ctx context.Context, externalID, provider string) (*models.User, error) {
 	query := `
-		SELECT id, email, username, name, password_hash, avatar_url, external_id, external_provider, created_at, updated_at
+		SELECT id, email, username, name, password_hash, avatar_url, external_id, external_provider, is_bot, bot_project_id, created_at, updated_at
 		FROM users
 		WHERE external_id = $1 AND external_provider = $2
 	`
@@ -141,6 +152,8 @@ func (r *UserRepository) GetByExternalID(ctx context.Context, externalID, provid
 		&user.AvatarURL,
 		&user.ExternalID,
 		&user.ExternalProvider,
+		&user.IsBot,
+		&user.BotProjectID,
 		&user.CreatedAt,
 		&user.UpdatedAt,
 	)
@@ -158,7 +171,7 @@ func (r *UserRepository) GetByExternalID(ctx context.Context, externalID, provid
 // GetByUsername retrieves a user by username
 func (r *UserRepository) GetByUsername(ctx context.Context, username string) (*models.User, error) {
 	query := `
-		SELECT id, email, username, password_hash, avatar_url, created_at, updated_at
+		SELECT id, email, username, password_hash, avatar_url, is_bot, bot_project_id, created_at, updated_at
 		FROM users
 		WHERE username = $1
 	`
@@ -170,6 +183,8 @@ func (r *UserRepository) GetByUsername(ctx context.Context, username string) (*m
 		&user.Username,
 		&user.PasswordHash,
 		&user.AvatarURL,
+		&user.IsBot,
+		&user.BotProjectID,
 		&user.CreatedAt,
 		&user.UpdatedAt,
 	)
@@ -193,6 +208,40 @@ func (r *UserRepository) Update(ctx context.Context, user *models.User) error {
 	`
 
 	result, err := r.db.ExecContext(ctx, query, user.Email, user.Username, user.AvatarURL, user.ID)
+	if err != nil {
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) {
+			if pqErr.Code == "23505" {
+				return pkgerrors.ErrConflict
+			}
+			if pqErr.Code == "23514" {
+				return pkgerrors.ErrValidation
+			}
+		}
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return pkgerrors.ErrNotFound
+	}
+
+	return nil
+}
+
+// LinkExternalID links an existing user to an external identity
+func (r *UserRepository) LinkExternalID(ctx context.Context, userID int, externalID, provider string) error {
+	query := `
+		UPDATE users
+		SET external_id = $1, external_provider = $2, updated_at = NOW()
+		WHERE id = $3
+	`
+
+	result, err := r.db.ExecContext(ctx, query, externalID, provider, userID)
 	if err != nil {
 		var pqErr *pq.Error
 		if errors.As(err, &pqErr) {
@@ -216,17 +265,19 @@ func (r *UserRepository) Update(ctx context.Context, user *models.User) error {
 }
 
 // Search searches for users by email or username
-func (r *UserRepository) Search(ctx context.Context, query string, limit int) ([]*models.User, error) {
+// Service accounts are only included when includeBots is true
+func (r *UserRepository) Search(ctx context.Context, query string, limit int, includeBots bool) ([]*models.User, error) {
 	searchQuery := `
-		SELECT id, email, username, avatar_url, created_at, updated_at
+		SELECT id, email, username, avatar_url, is_bot, bot_project_id, created_at, updated_at
 		FROM users
-		WHERE email ILIKE $1 OR username ILIKE $1
+		WHERE (email ILIKE $1 OR username ILIKE $1)
+		  AND ($3 OR is_bot = false)
 		ORDER BY username
 		LIMIT $2
 	`
 
 	searchPattern := "%" + query + "%"
-	rows, err := r.db.QueryContext(ctx, searchQuery, searchPattern, limit)
+	rows, err := r.db.QueryContext(ctx, searchQuery, searchPattern, limit, includeBots)
 	if err != nil {
 		return nil, err
 	}
@@ -240,6 +291,8 @@ func (r *UserRepository) Search(ctx context.Context, query string, limit int) ([
 			&user.Email,
 			&user.Username,
 			&user.AvatarURL,
+			&user.IsBot,
+			&user.BotProjectID,
 			&user.CreatedAt,
 			&user.UpdatedAt,
 		)
@@ -0,0 +1,422 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/lib/pq"
+	"github.com/yourusername/issue-tracker/internal/models"
+	pkgerrors "github.com/yourusername/issue-tracker/pkg/errors"
+)
+
+// WorkflowRepository handles workflow data access
+type WorkflowRepository struct {
+	db *sql.DB
+}
+
+// NewWorkflowRepository creates a new workflow repository
+func NewWorkflowRepository(db *sql.DB) *WorkflowRepository {
+	return &WorkflowRepository{db: db}
+}
+
+// Create creates a workflow with its statuses, transitions and issue types
+func (r *WorkflowRepository) Create(ctx context.Context, workflow *models.Workflow) (*models.Workflow, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	if workflow.IsDefault {
+		if err := clearDefaultWorkflow(ctx, tx, workflow.ProjectID); err != nil {
+			return nil, err
+		}
+	}
+
+	err = tx.QueryRowContext(ctx, `
+		INSERT INTO workflows (project_id, name, is_default)
+		VALUES ($1, $2, $3)
+		RETURNING id
+	`, workflow.ProjectID, workflow.Name, workflow.IsDefault).Scan(&workflow.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := insertWorkflowDefinition(ctx, tx, workflow); err != nil {
+		return nil, err
+	}
+
+	if err := syncStatusCategories(ctx, tx, workflow.ProjectID); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return r.GetByID(ctx, workflow.ID)
+}
+
+// Replace replaces the name, statuses, transitions and issue types of a workflow
+func (r *WorkflowRepository) Replace(ctx context.Context, workflow *models.Workflow) (*models.Workflow, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	if workflow.IsDefault {
+		if err := clearDefaultWorkflow(ctx, tx, workflow.ProjectID); err != nil {
+			return nil, err
+		}
+	}
+
+	result, err := tx.ExecContext(ctx, `
+		UPDATE workflows SET name = $1, is_default = $2, updated_at = NOW() WHERE id = $3
+	`, workflow.Name, workflow.IsDefault, workflow.ID)
+	if err != nil {
+		return nil, err
+	}
+	if err := requireRowsAffected(result); err != nil {
+		return nil, err
+	}
+
+	for _, stmt := range []string{
+		`DELETE FROM workflow_issue_types WHERE workflow_id = $1`,
+		`DELETE FROM workflow_transitions WHERE workflow_id = $1`,
+		`DELETE FROM workflow_statuses WHERE workflow_id = $1`,
+	} {
+		if _, err := tx.ExecContext(ctx, stmt, workflow.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := insertWorkflowDefinition(ctx, tx, workflow); err != nil {
+		return nil, err
+	}
+
+	if err := syncStatusCategories(ctx, tx, workflow.ProjectID); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return r.GetByID(ctx, workflow.ID)
+}
+
+// clearDefaultWorkflow unsets the current default workflow of a project
+func clearDefaultWorkflow(ctx context.Context, tx *sql.Tx, projectID int) error {
+	_, err := tx.ExecContext(ctx, `
+		UPDATE workflows SET is_default = false, updated_at = NOW()
+		WHERE project_id = $1 AND is_default
+	`, projectID)
+	return err
+}
+
+// syncStatusCategories recomputes the status category of a project's issues
+// from the workflows in effect, so a changed category of a status applies to
+// the issues already in it. Issues leaving the done category lose their
+// resolution; issues in a status their workflow doesn't know are left alone
+func syncStatusCategories(ctx context.Context, tx *sql.Tx, projectID int) error {
+	defaults := models.DefaultWorkflow(projectID).Statuses
+	keys := make([]string, len(defaults))
+	categories := make([]string, len(defaults))
+	for i, status := range defaults {
+		keys[i] = string(status.Key)
+		categories[i] = string(status.Category)
+	}
+
+	_, err := tx.ExecContext(ctx, `
+		WITH effective AS (
+			SELECT i.id, i.status, (
+				SELECT w.id
+				FROM workflows w
+				LEFT JOIN workflow_issue_types wit ON wit.workflow_id = w.id AND wit.issue_type = i.issue_type
+				WHERE w.project_id = i.project_id AND (wit.issue_type IS NOT NULL OR w.is_default)
+				ORDER BY (wit.issue_type IS NOT NULL) DESC
+				LIMIT 1
+			) AS workflow_id
+			FROM issues i
+			WHERE i.project_id = $1
+		),
+		target AS (
+			SELECT e.id, ws.category
+			FROM effective e
+			JOIN workflow_statuses ws ON ws.workflow_id = e.workflow_id AND ws.key = e.status
+			UNION ALL
+			SELECT e.id, d.category
+			FROM effective e
+			JOIN unnest($2::text[], $3::text[]) AS d(key, category) ON d.key = e.status
+			WHERE e.workflow_id IS NULL
+		)
+		UPDATE issues i
+		SET status_category = t.category,
+		    resolution = CASE WHEN t.category = $4 THEN i.resolution END
+		FROM target t
+		WHERE i.id = t.id AND i.status_category <> t.category
+	`, projectID, pq.Array(keys), pq.Array(categories), models.StatusCategoryDone)
+	return err
+}
+
+// insertWorkflowDefinition inserts the statuses, transitions and issue types of a workflow
+func insertWorkflowDefinition(ctx context.Context, tx *sql.Tx, workflow *models.Workflow) error {
+	for _, status := range workflow.Statuses {
+		_, err := tx.ExecContext(ctx, `
+			INSERT INTO workflow_statuses (workflow_id, key, name, category, position, is_initial)
+			VALUES ($1, $2, $3, $4, $5, $6)
+		`, workflow.ID, status.Key, status.Name, status.Category, status.Position, status.IsInitial)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, transition := range workflow.Transitions {
+		var allowedRoles interface{}
+		if len(transition.AllowedRoles) > 0 {
+			roles := make([]string, len(transition.AllowedRoles))
+			for i, role := range transition.AllowedRoles {
+				roles[i] = string(role)
+			}
+			allowedRoles = pq.Array(roles)
+		}
+
+		_, err := tx.ExecContext(ctx, `
+			INSERT INTO workflow_transitions (workflow_id, name, from_status, to_status, allowed_roles, required_fields)
+			VALUES ($1, $2, $3, $4, $5, $6)
+		`, workflow.ID, transition.Name, transition.FromStatus, transition.ToStatus, allowedRoles, pq.Array(transition.RequiredFields))
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, issueType := range workflow.IssueTypes {
+		_, err := tx.ExecContext(ctx, `
+			INSERT INTO workflow_issue_types (project_id, issue_type, workflow_id)
+			VALUES ($1, $2, $3)
+		`, workflow.ProjectID, issueType, workflow.ID)
+		if err != nil {
+			var pqErr *pq.Error
+			if errors.As(err, &pqErr) && pqErr.Code == "23505" { // Issue type already has a workflow
+				return pkgerrors.ErrConflict
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetByID retrieves a workflow with its statuses, transitions and issue types
+func (r *WorkflowRepository) GetByID(ctx context.Context, id int) (*models.Workflow, error) {
+	workflow := &models.Workflow{}
+	err := r.db.QueryRowContext(ctx, `
+		SELECT id, project_id, name, is_default, created_at, updated_at
+		FROM workflows
+		WHERE id = $1
+	`, id).Scan(
+		&workflow.ID,
+		&workflow.ProjectID,
+		&workflow.Name,
+		&workflow.IsDefault,
+		&workflow.CreatedAt,
+		&workflow.UpdatedAt,
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, pkgerrors.ErrNotFound
+		}
+		return nil, err
+	}
+
+	if err := r.loadDefinition(ctx, workflow); err != nil {
+		return nil, err
+	}
+
+	return workflow, nil
+}
+
+// GetForIssueType retrieves the workflow an issue type uses in a project:
+// its own workflow, otherwise the project's default workflow
+// Returns ErrNotFound when the project has neither
+func (r *WorkflowRepository) GetForIssueType(ctx context.Context, projectID int, issueType models.IssueType) (*models.Workflow, error) {
+	var id int
+	err := r.db.QueryRowContext(ctx, `
+		SELECT w.id
+		FROM workflows w
+		LEFT JOIN workflow_issue_types wit ON wit.workflow_id = w.id AND wit.issue_type = $2
+		WHERE w.project_id = $1 AND (wit.issue_type IS NOT NULL OR w.is_default)
+		ORDER BY (wit.issue_type IS NOT NULL) DESC
+		LIMIT 1
+	`, projectID, issueType).Scan(&id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, pkgerrors.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return r.GetByID(ctx, id)
+}
+
+// ListByProjectID retrieves all workflows of a project
+func (r *WorkflowRepository) ListByProjectID(ctx context.Context, projectID int) ([]*models.Workflow, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT id, project_id, name, is_default, created_at, updated_at
+		FROM workflows
+		WHERE project_id = $1
+		ORDER BY is_default DESC, name
+	`, projectID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	workflows := make([]*models.Workflow, 0)
+	for rows.Next() {
+		workflow := &models.Workflow{}
+		err := rows.Scan(
+			&workflow.ID,
+			&workflow.ProjectID,
+			&workflow.Name,
+			&workflow.IsDefault,
+			&workflow.CreatedAt,
+			&workflow.UpdatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		workflows = append(workflows, workflow)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	for _, workflow := range workflows {
+		if err := r.loadDefinition(ctx, workflow); err != nil {
+			return nil, err
+		}
+	}
+
+	return workflows, nil
+}
+
+// Delete deletes a workflow; its issue types fall back to the default workflow
+func (r *WorkflowRepository) Delete(ctx context.Context, id int) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	var projectID int
+	err = tx.QueryRowContext(ctx, `DELETE FROM workflows WHERE id = $1 RETURNING project_id`, id).Scan(&projectID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return pkgerrors.ErrNotFound
+		}
+		return err
+	}
+
+	if err := syncStatusCategories(ctx, tx, projectID); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// loadDefinition loads the statuses, transitions and issue types of a workflow
+func (r *WorkflowRepository) loadDefinition(ctx context.Context, workflow *models.Workflow) error {
+	statusRows, err := r.db.QueryContext(ctx, `
+		SELECT id, workflow_id, key, name, category, position, is_initial
+		FROM workflow_statuses
+		WHERE workflow_id = $1
+		ORDER BY position, id
+	`, workflow.ID)
+	if err != nil {
+		return err
+	}
+	defer statusRows.Close()
+
+	workflow.Statuses = make([]*models.WorkflowStatus, 0)
+	for statusRows.Next() {
+		status := &models.WorkflowStatus{}
+		err := statusRows.Scan(
+			&status.ID,
+			&status.WorkflowID,
+			&status.Key,
+			&status.Name,
+			&status.Category,
+			&status.Position,
+			&status.IsInitial,
+		)
+		if err != nil {
+			return err
+		}
+		workflow.Statuses = append(workflow.Statuses, status)
+	}
+	if err := statusRows.Err(); err != nil {
+		return err
+	}
+
+	transitionRows, err := r.db.QueryContext(ctx, `
+		SELECT id, workflow_id, name, from_status, to_status, allowed_roles, required_fields
+		FROM workflow_transitions
+		WHERE workflow_id = $1
+		ORDER BY id
+	`, workflow.ID)
+	if err != nil {
+		return err
+	}
+	defer transitionRows.Close()
+
+	workflow.Transitions = make([]*models.WorkflowTransition, 0)
+	for transitionRows.Next() {
+		transition := &models.WorkflowTransition{}
+		var allowedRoles, requiredFields pq.StringArray
+		err := transitionRows.Scan(
+			&transition.ID,
+			&transition.WorkflowID,
+			&transition.Name,
+			&transition.FromStatus,
+			&transition.ToStatus,
+			&allowedRoles,
+			&requiredFields,
+		)
+		if err != nil {
+			return err
+		}
+		for _, role := range allowedRoles {
+			transition.AllowedRoles = append(transition.AllowedRoles, models.ProjectRole(role))
+		}
+		transition.RequiredFields = []string(requiredFields)
+		if transition.RequiredFields == nil {
+			transition.RequiredFields = []string{}
+		}
+		workflow.Transitions = append(workflow.Transitions, transition)
+	}
+	if err := transitionRows.Err(); err != nil {
+		return err
+	}
+
+	typeRows, err := r.db.QueryContext(ctx, `
+		SELECT issue_type FROM workflow_issue_types WHERE workflow_id = $1 ORDER BY issue_type
+	`, workflow.ID)
+	if err != nil {
+		return err
+	}
+	defer typeRows.Close()
+
+	workflow.IssueTypes = make([]models.IssueType, 0)
+	for typeRows.Next() {
+		var issueType models.IssueType
+		if err := typeRows.Scan(&issueType); err != nil {
+			return err
+		}
+		workflow.IssueTypes = append(workflow.IssueTypes, issueType)
+	}
+
+	return typeRows.Err()
+}
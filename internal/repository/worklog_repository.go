@@ -0,0 +1,263 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/yourusername/issue-tracker/internal/models"
+	pkgerrors "github.com/yourusername/issue-tracker/pkg/errors"
+)
+
+// WorklogRepository handles worklog data access
+type WorklogRepository struct {
+	db *sql.DB
+}
+
+// NewWorklogRepository creates a new worklog repository
+func NewWorklogRepository(db *sql.DB) *WorklogRepository {
+	return &WorklogRepository{db: db}
+}
+
+// worklogColumns lists the worklog columns read by scanWorklog, in order
+const worklogColumns = `w.id, w.issue_id, w.user_id, w.time_spent_minutes, w.started_at, w.description,
+			w.created_at, w.updated_at, u.username, u.name`
+
+// scanWorklog scans a row selected with worklogColumns into a worklog
+func scanWorklog(row rowScanner, worklog *models.Worklog) error {
+	user := &models.User{}
+	err := row.Scan(
+		&worklog.ID,
+		&worklog.IssueID,
+		&worklog.UserID,
+		&worklog.TimeSpentMinutes,
+		&worklog.StartedAt,
+		&worklog.Description,
+		&worklog.CreatedAt,
+		&worklog.UpdatedAt,
+		&user.Username,
+		&user.Name,
+	)
+	if err != nil {
+		return err
+	}
+
+	user.ID = worklog.UserID
+	worklog.User = user
+	return nil
+}
+
+// Create creates a new worklog
+func (r *WorklogRepository) Create(ctx context.Context, worklog *models.Worklog) (*models.Worklog, error) {
+	var id int
+	err := r.db.QueryRowContext(ctx, `
+		INSERT INTO worklogs (issue_id, user_id, time_spent_minutes, started_at, description)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING id
+	`, worklog.IssueID, worklog.UserID, worklog.TimeSpentMinutes, worklog.StartedAt, worklog.Description).Scan(&id)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.GetByID(ctx, id)
+}
+
+// GetByID retrieves a worklog by ID
+func (r *WorklogRepository) GetByID(ctx context.Context, id int) (*models.Worklog, error) {
+	query := `
+		SELECT ` + worklogColumns + `
+		FROM worklogs w
+		JOIN users u ON u.id = w.user_id
+		WHERE w.id = $1
+	`
+
+	var worklog models.Worklog
+	err := scanWorklog(r.db.QueryRowContext(ctx, query, id), &worklog)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, pkgerrors.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return &worklog, nil
+}
+
+// ListByIssueID lists the worklogs of an issue, newest first
+func (r *WorklogRepository) ListByIssueID(ctx context.Context, issueID int) ([]*models.Worklog, error) {
+	query := `
+		SELECT ` + worklogColumns + `
+		FROM worklogs w
+		JOIN users u ON u.id = w.user_id
+		WHERE w.issue_id = $1
+		ORDER BY w.started_at DESC, w.id DESC
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, issueID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	worklogs := make([]*models.Worklog, 0)
+	for rows.Next() {
+		var worklog models.Worklog
+		if err := scanWorklog(rows, &worklog); err != nil {
+			return nil, err
+		}
+		worklogs = append(worklogs, &worklog)
+	}
+
+	return worklogs, rows.Err()
+}
+
+// Update updates the time, start and description of a worklog
+func (r *WorklogRepository) Update(ctx context.Context, worklog *models.Worklog) error {
+	result, err := r.db.ExecContext(ctx, `
+		UPDATE worklogs
+		SET time_spent_minutes = $1, started_at = $2, description = $3, updated_at = NOW()
+		WHERE id = $4
+	`, worklog.TimeSpentMinutes, worklog.StartedAt, worklog.Description, worklog.ID)
+	if err != nil {
+		return err
+	}
+
+	return requireRowsAffected(result)
+}
+
+// Delete deletes a worklog
+func (r *WorklogRepository) Delete(ctx context.Context, id int) error {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM worklogs WHERE id = $1`, id)
+	if err != nil {
+		return err
+	}
+
+	return requireRowsAffected(result)
+}
+
+// SumTimeSpent returns the total time logged on an issue
+func (r *WorklogRepository) SumTimeSpent(ctx context.Context, issueID int) (int, error) {
+	var total int
+	err := r.db.QueryRowContext(ctx, `
+		SELECT COALESCE(SUM(time_spent_minutes), 0) FROM worklogs WHERE issue_id = $1
+	`, issueID).Scan(&total)
+	return total, err
+}
+
+// SetRemainingEstimate sets the remaining estimate of an issue
+func (r *WorklogRepository) SetRemainingEstimate(ctx context.Context, issueID int, minutes *int) error {
+	result, err := r.db.ExecContext(ctx, `
+		UPDATE issues
+		SET remaining_estimate_minutes = $1, updated_at = NOW()
+		WHERE id = $2 AND deleted_at IS NULL
+	`, minutes, issueID)
+	if err != nil {
+		return err
+	}
+
+	return requireRowsAffected(result)
+}
+
+// GetTimeTracking sums the estimates and logged time of an issue and its
+// descendants: subtasks, and for epics the epic's issues and their subtasks
+func (r *WorklogRepository) GetTimeTracking(ctx context.Context, issue *models.Issue) (*models.TimeTracking, error) {
+	query := `
+		WITH RECURSIVE tree AS (
+			SELECT id FROM issues WHERE id = $1 AND deleted_at IS NULL
+			UNION
+			SELECT i.id
+			FROM issues i
+			JOIN tree t ON i.parent_issue_id = t.id OR i.epic_id = t.id
+			WHERE i.deleted_at IS NULL
+		)
+		SELECT
+			COUNT(*),
+			COALESCE(SUM(i.original_estimate_minutes), 0),
+			COALESCE(SUM(i.remaining_estimate_minutes), 0),
+			(SELECT COALESCE(SUM(w.time_spent_minutes), 0) FROM worklogs w WHERE w.issue_id IN (SELECT id FROM tree)),
+			(SELECT COALESCE(SUM(w.time_spent_minutes), 0) FROM worklogs w WHERE w.issue_id = $1)
+		FROM issues i
+		WHERE i.id IN (SELECT id FROM tree)
+	`
+
+	tracking := &models.TimeTracking{
+		IssueID:                  issue.ID,
+		OriginalEstimateMinutes:  issue.EstimateMinutes,
+		RemainingEstimateMinutes: issue.RemainingMinutes,
+	}
+	err := r.db.QueryRowContext(ctx, query, issue.ID).Scan(
+		&tracking.IssueCount,
+		&tracking.TotalOriginalEstimateMinutes,
+		&tracking.TotalRemainingEstimateMinutes,
+		&tracking.TotalTimeSpentMinutes,
+		&tracking.TimeSpentMinutes,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return tracking, nil
+}
+
+// ListTimesheet lists the worklogs of a period on projects the viewer can
+// access, oldest first
+func (r *WorklogRepository) ListTimesheet(ctx context.Context, filter *models.TimesheetFilter, viewerID int) ([]*models.TimesheetEntry, error) {
+	conditions := []string{
+		"w.started_at >= $1",
+		"w.started_at < $2",
+		"i.deleted_at IS NULL",
+		"(p.owner_id = $3 OR EXISTS (SELECT 1 FROM project_access pa WHERE pa.project_id = p.id AND pa.user_id = $3))",
+	}
+	args := []interface{}{filter.From, filter.To, viewerID}
+
+	if filter.UserID != nil {
+		args = append(args, *filter.UserID)
+		conditions = append(conditions, fmt.Sprintf("w.user_id = $%d", len(args)))
+	}
+	if filter.ProjectID != nil {
+		args = append(args, *filter.ProjectID)
+		conditions = append(conditions, fmt.Sprintf("p.id = $%d", len(args)))
+	}
+
+	query := `
+		SELECT w.id, w.started_at, w.user_id, u.username, p.id, p.key,
+			i.id, i.issue_number, i.title, w.time_spent_minutes, w.description
+		FROM worklogs w
+		JOIN issues i ON i.id = w.issue_id
+		JOIN projects p ON p.id = i.project_id
+		JOIN users u ON u.id = w.user_id
+		WHERE ` + strings.Join(conditions, " AND ") + `
+		ORDER BY w.started_at, w.id
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	entries := make([]*models.TimesheetEntry, 0)
+	for rows.Next() {
+		var entry models.TimesheetEntry
+		err := rows.Scan(
+			&entry.WorklogID,
+			&entry.StartedAt,
+			&entry.UserID,
+			&entry.Username,
+			&entry.ProjectID,
+			&entry.ProjectKey,
+			&entry.IssueID,
+			&entry.IssueNumber,
+			&entry.IssueTitle,
+			&entry.TimeSpentMinutes,
+			&entry.Description,
+		)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, &entry)
+	}
+
+	return entries, rows.Err()
+}
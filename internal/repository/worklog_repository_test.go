@@ -0,0 +1,124 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/yourusername/issue-tracker/internal/models"
+)
+
+func TestWorklogRepository(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	cleanup := func() {
+		db.Exec("DELETE FROM worklogs WHERE issue_id IN (SELECT i.id FROM issues i JOIN projects p ON p.id = i.project_id WHERE p.key = 'WRK')")
+		db.Exec("DELETE FROM issues WHERE project_id IN (SELECT id FROM projects WHERE key = 'WRK')")
+		db.Exec("DELETE FROM projects WHERE key = 'WRK'")
+		db.Exec("DELETE FROM users WHERE email = 'worklogtest@example.com'")
+	}
+	cleanup()
+	defer cleanup()
+
+	worklogRepo := NewWorklogRepository(db)
+	issueRepo := NewIssueRepository(db)
+	ctx := context.Background()
+
+	user, err := NewUserRepository(db).Create(ctx, &models.User{
+		Email:        "worklogtest@example.com",
+		Username:     "worklogtest",
+		PasswordHash: "hash",
+	})
+	if err != nil {
+		t.Fatalf("Failed to create user: %v", err)
+	}
+	project, err := NewProjectRepository(db).Create(ctx, &models.Project{Name: "Worklog Project", Key: "WRK", OwnerID: user.ID})
+	if err != nil {
+		t.Fatalf("Failed to create project: %v", err)
+	}
+
+	createIssue := func(issueType models.IssueType, epicID, parentID *int, estimate int) *models.Issue {
+		issue, err := issueRepo.Create(ctx, &models.Issue{
+			ProjectID:       project.ID,
+			Title:           "Tracked issue",
+			Status:          models.IssueStatusOpen,
+			StatusCategory:  models.StatusCategoryTodo,
+			Priority:        models.PriorityMedium,
+			IssueType:       issueType,
+			EpicID:          epicID,
+			ParentIssueID:   parentID,
+			ReporterID:      user.ID,
+			EstimateMinutes: &estimate,
+		})
+		if err != nil {
+			t.Fatalf("Failed to create issue: %v", err)
+		}
+		return issue
+	}
+
+	epic := createIssue(models.IssueTypeEpic, nil, nil, 0)
+	task := createIssue(models.IssueTypeTask, &epic.ID, nil, 240)
+	subtask := createIssue(models.IssueTypeSubtask, nil, &task.ID, 60)
+
+	startedAt := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	for _, issue := range []*models.Issue{task, subtask} {
+		_, err := worklogRepo.Create(ctx, &models.Worklog{
+			IssueID:          issue.ID,
+			UserID:           user.ID,
+			TimeSpentMinutes: 30,
+			StartedAt:        startedAt,
+		})
+		if err != nil {
+			t.Fatalf("Failed to create worklog: %v", err)
+		}
+	}
+
+	t.Run("should list worklogs with their author", func(t *testing.T) {
+		worklogs, err := worklogRepo.ListByIssueID(ctx, task.ID)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if len(worklogs) != 1 || worklogs[0].User == nil || worklogs[0].User.Username != "worklogtest" {
+			t.Errorf("Expected one worklog by worklogtest, got %+v", worklogs)
+		}
+	})
+
+	t.Run("should roll time up to the epic", func(t *testing.T) {
+		tracking, err := worklogRepo.GetTimeTracking(ctx, epic)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if tracking.IssueCount != 3 {
+			t.Errorf("Expected 3 issues in the rollup, got %d", tracking.IssueCount)
+		}
+		if tracking.TotalTimeSpentMinutes != 60 || tracking.TimeSpentMinutes != 0 {
+			t.Errorf("Expected 60 minutes rolled up and none on the epic, got %d and %d", tracking.TotalTimeSpentMinutes, tracking.TimeSpentMinutes)
+		}
+		if tracking.TotalOriginalEstimateMinutes != 300 {
+			t.Errorf("Expected 300 estimated minutes, got %d", tracking.TotalOriginalEstimateMinutes)
+		}
+	})
+
+	t.Run("should list timesheet entries in the period", func(t *testing.T) {
+		entries, err := worklogRepo.ListTimesheet(ctx, &models.TimesheetFilter{
+			UserID: &user.ID,
+			From:   time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC),
+			To:     time.Date(2026, 3, 3, 0, 0, 0, 0, time.UTC),
+		}, user.ID)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if len(entries) != 2 || entries[0].ProjectKey != "WRK" {
+			t.Errorf("Expected 2 entries on WRK, got %+v", entries)
+		}
+
+		entries, _ = worklogRepo.ListTimesheet(ctx, &models.TimesheetFilter{
+			From: time.Date(2026, 3, 3, 0, 0, 0, 0, time.UTC),
+			To:   time.Date(2026, 3, 4, 0, 0, 0, 0, time.UTC),
+		}, user.ID)
+		if len(entries) != 0 {
+			t.Errorf("Expected no entries outside the period, got %d", len(entries))
+		}
+	})
+}
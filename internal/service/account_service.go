@@ -0,0 +1,296 @@
+package service
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"path"
+	"strings"
+
+	"github.com/yourusername/issue-tracker/internal/models"
+	"github.com/yourusername/issue-tracker/internal/repository"
+	pkgerrors "github.com/yourusername/issue-tracker/pkg/errors"
+	"github.com/yourusername/issue-tracker/pkg/storage"
+)
+
+// AccountService handles personal data exports and account deletion
+type AccountService struct {
+	accountRepo    *repository.AccountRepository
+	userRepo       *repository.UserRepository
+	attachmentRepo *repository.AttachmentRepository
+	storage        storage.Storage
+}
+
+// NewAccountService creates a new account service
+func NewAccountService(
+	accountRepo *repository.AccountRepository,
+	userRepo *repository.UserRepository,
+	attachmentRepo *repository.AttachmentRepository,
+	storage storage.Storage,
+) *AccountService {
+	return &AccountService{
+		accountRepo:    accountRepo,
+		userRepo:       userRepo,
+		attachmentRepo: attachmentRepo,
+		storage:        storage,
+	}
+}
+
+// RequestExport creates an export job and builds the archive in the background
+func (s *AccountService) RequestExport(ctx context.Context, userID int) (*models.UserExport, error) {
+	export, err := s.accountRepo.CreateExport(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	go s.buildExport(context.Background(), export.ID, userID)
+
+	return export, nil
+}
+
+// GetExport retrieves the status of an export job
+func (s *AccountService) GetExport(ctx context.Context, userID, exportID int) (*models.UserExport, error) {
+	return s.accountRepo.GetExport(ctx, userID, exportID)
+}
+
+// DownloadExport returns the export archive; it can be downloaded only once
+// When the returned reader is closed after being read to the end, the export
+// is marked downloaded and the file is removed from storage; otherwise the
+// download can be retried
+func (s *AccountService) DownloadExport(ctx context.Context, userID, exportID int) (io.ReadCloser, error) {
+	storageKey, err := s.accountRepo.ClaimExportDownload(ctx, userID, exportID)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := s.storage.Get(storageKey)
+	if err != nil {
+		if releaseErr := s.accountRepo.ReleaseExportDownload(ctx, exportID); releaseErr != nil {
+			log.Printf("WARNING: Failed to release download of export %d: %v", exportID, releaseErr)
+		}
+		return nil, pkgerrors.NewInternalError("failed to open export archive", err)
+	}
+
+	return &exportReader{
+		ReadCloser:  file,
+		accountRepo: s.accountRepo,
+		storage:     s.storage,
+		exportID:    exportID,
+		storageKey:  storageKey,
+	}, nil
+}
+
+// exportReader finishes the download of an export once it has been streamed
+// completely, and releases it for another attempt otherwise
+type exportReader struct {
+	io.ReadCloser
+	accountRepo *repository.AccountRepository
+	storage     storage.Storage
+	exportID    int
+	storageKey  string
+	complete    bool
+}
+
+func (r *exportReader) Read(p []byte) (int, error) {
+	n, err := r.ReadCloser.Read(p)
+	if err == io.EOF {
+		r.complete = true
+	}
+	return n, err
+}
+
+func (r *exportReader) Close() error {
+	err := r.ReadCloser.Close()
+
+	// The request context may already be canceled
+	ctx := context.Background()
+	if !r.complete {
+		if releaseErr := r.accountRepo.ReleaseExportDownload(ctx, r.exportID); releaseErr != nil {
+			log.Printf("WARNING: Failed to release download of export %d: %v", r.exportID, releaseErr)
+		}
+		return err
+	}
+
+	if finishErr := r.accountRepo.FinishExportDownload(ctx, r.exportID); finishErr != nil {
+		// Keep the archive: the export can be downloaded again once the claim times out
+		log.Printf("WARNING: Failed to finish download of export %d: %v", r.exportID, finishErr)
+		return err
+	}
+	if deleteErr := r.storage.Delete(r.storageKey); deleteErr != nil {
+		log.Printf("WARNING: Failed to delete export archive %s: %v", r.storageKey, deleteErr)
+	}
+	return err
+}
+
+// buildExport writes the personal data of a user into a ZIP archive
+func (s *AccountService) buildExport(ctx context.Context, exportID, userID int) {
+	if err := s.accountRepo.UpdateExportStatus(ctx, exportID, models.ExportStatusProcessing); err != nil {
+		log.Printf("ERROR: Failed to start export %d: %v", exportID, err)
+		return
+	}
+
+	pr, pw := io.Pipe()
+	counter := &countingWriter{w: pw}
+	go func() {
+		pw.CloseWithError(s.writeExportArchive(ctx, counter, userID))
+	}()
+
+	_, storageKey, err := s.storage.Save(pr, fmt.Sprintf("export-%d.zip", exportID))
+	pr.Close()
+	if err != nil {
+		log.Printf("ERROR: Failed to build export %d: %v", exportID, err)
+		if failErr := s.accountRepo.FailExport(ctx, exportID, "failed to build export archive"); failErr != nil {
+			log.Printf("ERROR: Failed to mark export %d as failed: %v", exportID, failErr)
+		}
+		return
+	}
+
+	if err := s.accountRepo.CompleteExport(ctx, exportID, storageKey, counter.n); err != nil {
+		log.Printf("ERROR: Failed to complete export %d: %v", exportID, err)
+		if deleteErr := s.storage.Delete(storageKey); deleteErr != nil {
+			log.Printf("WARNING: Failed to delete export archive %s: %v", storageKey, deleteErr)
+		}
+	}
+}
+
+// writeExportArchive writes one JSON file per data section followed by the
+// user's uploaded attachments
+func (s *AccountService) writeExportArchive(ctx context.Context, w io.Writer, userID int) error {
+	data, err := s.accountRepo.ExportData(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	attachments, err := s.attachmentRepo.ListByUserID(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	zw := zip.NewWriter(w)
+
+	for name, doc := range data {
+		f, err := zw.Create(name + ".json")
+		if err != nil {
+			return err
+		}
+		var buf bytes.Buffer
+		if err := json.Indent(&buf, doc, "", "  "); err != nil {
+			return err
+		}
+		if _, err := buf.WriteTo(f); err != nil {
+			return err
+		}
+	}
+
+	f, err := zw.Create("attachments.json")
+	if err != nil {
+		return err
+	}
+	encoder := json.NewEncoder(f)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(attachments); err != nil {
+		return err
+	}
+
+	for _, attachment := range attachments {
+		if err := s.addAttachment(zw, attachment); err != nil {
+			return err
+		}
+	}
+
+	return zw.Close()
+}
+
+// addAttachment copies an attachment file into the archive
+// Missing files are skipped so one lost upload does not fail the export
+func (s *AccountService) addAttachment(zw *zip.Writer, attachment *models.Attachment) error {
+	file, err := s.storage.Get(attachment.StorageKey)
+	if err != nil {
+		log.Printf("WARNING: Skipping attachment %d in export: %v", attachment.ID, err)
+		return nil
+	}
+	defer file.Close()
+
+	name := path.Join("attachments", fmt.Sprintf("%d-%s", attachment.ID, path.Base(strings.ReplaceAll(attachment.OriginalFilename, "\\", "/"))))
+	f, err := zw.Create(name)
+	if err != nil {
+		return err
+	}
+
+	_, err = io.Copy(f, file)
+	return err
+}
+
+// DeleteAccount anonymizes the user's authored content and deletes the account
+// Users that still own projects must transfer ownership first
+func (s *AccountService) DeleteAccount(ctx context.Context, userID int, req *models.DeleteAccountRequest) error {
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	// Service accounts are removed by project admins instead
+	if user.IsBot {
+		return pkgerrors.ErrForbidden
+	}
+
+	if req.Confirm != user.Username {
+		return pkgerrors.NewValidationError("confirm must match your username")
+	}
+
+	owned, err := s.accountRepo.CountOwnedProjects(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if owned > 0 {
+		return &pkgerrors.AppError{
+			Message:    fmt.Sprintf("transfer ownership of %d project(s) before deleting your account", owned),
+			StatusCode: 409,
+		}
+	}
+
+	ownedOrgs, err := s.accountRepo.CountSoleOwnedOrganizations(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if ownedOrgs > 0 {
+		return &pkgerrors.AppError{
+			Message:    fmt.Sprintf("transfer ownership of %d organization(s) before deleting your account", ownedOrgs),
+			StatusCode: 409,
+		}
+	}
+
+	// Remove archives that were never downloaded; the export rows cascade
+	keys, err := s.accountRepo.ListExportStorageKeys(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.accountRepo.DeleteUser(ctx, userID); err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		if err := s.storage.Delete(key); err != nil {
+			log.Printf("WARNING: Failed to delete export archive %s: %v", key, err)
+		}
+	}
+
+	return nil
+}
+
+// countingWriter counts the bytes written through it
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	n, err := c.w.Write(p)
+	c.n += int64(n)
+	return n, err
+}
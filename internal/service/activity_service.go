@@ -127,7 +127,7 @@ func (s *ActivityService) userHasAccessToProject(ctx context.Context, userID int
 	var count int
 	err := s.db.QueryRowContext(ctx, `
 		SELECT COUNT(*)
-		FROM project_members
+		FROM project_access
 		WHERE project_id = $1 AND user_id = $2
 	`, projectID, userID).Scan(&count)
 
@@ -2,6 +2,8 @@ package service
 
 import (
 	"context"
+	"strings"
+	"time"
 
 	"golang.org/x/crypto/bcrypt"
 
@@ -13,18 +15,39 @@ import (
 
 // AuthService handles authentication business logic
 type AuthService struct {
-	userRepo   *repository.UserRepository
-	jwtManager *auth.JWTManager
+	userRepo       *repository.UserRepository
+	jwtManager     *auth.JWTManager
+	authenticators []Authenticator
+	accountRepo    *repository.ServiceAccountRepository
+	twoFactorRepo  *repository.TwoFactorRepository
 }
 
 // NewAuthService creates a new auth service
+// Login uses local password authentication unless SetAuthenticators is called
 func NewAuthService(userRepo *repository.UserRepository, jwtManager *auth.JWTManager) *AuthService {
 	return &AuthService{
-		userRepo:   userRepo,
-		jwtManager: jwtManager,
+		userRepo:       userRepo,
+		jwtManager:     jwtManager,
+		authenticators: []Authenticator{NewPasswordAuthenticator(userRepo)},
 	}
 }
 
+// SetAuthenticators sets the authenticators tried in order by Login
+func (s *AuthService) SetAuthenticators(authenticators ...Authenticator) {
+	s.authenticators = authenticators
+}
+
+// SetServiceAccountRepo enables service account token authentication
+func (s *AuthService) SetServiceAccountRepo(accountRepo *repository.ServiceAccountRepository) {
+	s.accountRepo = accountRepo
+}
+
+// SetTwoFactorRepo enables the one-time code check on login for users with
+// two-factor authentication
+func (s *AuthService) SetTwoFactorRepo(twoFactorRepo *repository.TwoFactorRepository) {
+	s.twoFactorRepo = twoFactorRepo
+}
+
 // Register registers a new user
 func (s *AuthService) Register(ctx context.Context, req *models.CreateUserRequest) (*models.User, error) {
 	// Validate password strength
@@ -32,6 +55,10 @@ func (s *AuthService) Register(ctx context.Context, req *models.CreateUserReques
 		return nil, pkgerrors.ErrValidation
 	}
 
+	if models.IsReservedUsername(req.Username) {
+		return nil, pkgerrors.ErrValidation
+	}
+
 	// Hash password
 	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(req.Password), bcrypt.DefaultCost)
 	if err != nil {
@@ -55,19 +82,14 @@ func (s *AuthService) Register(ctx context.Context, req *models.CreateUserReques
 
 // Login authenticates a user and returns tokens
 func (s *AuthService) Login(ctx context.Context, req *models.LoginRequest) (*models.LoginResponse, error) {
-	// Get user by email
-	user, err := s.userRepo.GetByEmail(ctx, req.Email)
+	// Try each authenticator in order until one accepts the credentials
+	user, err := s.authenticate(ctx, req)
 	if err != nil {
-		if err == pkgerrors.ErrNotFound {
-			return nil, pkgerrors.ErrInvalidCredentials
-		}
 		return nil, err
 	}
 
-	// Verify password
-	err = bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(req.Password))
-	if err != nil {
-		return nil, pkgerrors.ErrInvalidCredentials
+	if err := s.verifyTwoFactor(ctx, user.ID, req.OTPCode); err != nil {
+		return nil, err
 	}
 
 	// Generate tokens
@@ -94,8 +116,60 @@ func (s *AuthService) Login(ctx context.Context, req *models.LoginRequest) (*mod
 	return response, nil
 }
 
+// authenticate runs the configured authenticators in order
+// An authenticator rejecting the credentials falls through to the next one;
+// any other error aborts the login
+func (s *AuthService) authenticate(ctx context.Context, req *models.LoginRequest) (*models.User, error) {
+	for _, authenticator := range s.authenticators {
+		user, err := authenticator.Authenticate(ctx, req)
+		if err == nil {
+			return user, nil
+		}
+		if err != pkgerrors.ErrInvalidCredentials {
+			return nil, err
+		}
+	}
+
+	return nil, pkgerrors.ErrInvalidCredentials
+}
+
+// verifyTwoFactor requires a valid one-time code from users who enabled
+// two-factor authentication
+func (s *AuthService) verifyTwoFactor(ctx context.Context, userID int, code string) error {
+	if s.twoFactorRepo == nil {
+		return nil
+	}
+
+	tf, err := s.twoFactorRepo.Get(ctx, userID)
+	if err == pkgerrors.ErrNotFound {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if !tf.Enabled() {
+		return nil
+	}
+
+	if code == "" {
+		return pkgerrors.NewTwoFactorRequiredError("A two-factor authentication code is required")
+	}
+	if !auth.ValidateTOTP(tf.Secret, code, time.Now()) {
+		return pkgerrors.NewTwoFactorRequiredError("Invalid two-factor authentication code")
+	}
+	return nil
+}
+
 // ValidateAccessToken validates an access token and returns the user ID
+// Service account API tokens are accepted as well and resolve to the bot user
 func (s *AuthService) ValidateAccessToken(ctx context.Context, token string) (int, error) {
+	if strings.HasPrefix(token, models.ServiceAccountTokenPrefix) {
+		if s.accountRepo == nil {
+			return 0, pkgerrors.ErrUnauthorized
+		}
+		return s.accountRepo.Authenticate(ctx, hashServiceAccountToken(token))
+	}
+
 	claims, err := s.jwtManager.ValidateAccessToken(token)
 	if err != nil {
 		return 0, err
@@ -144,11 +218,12 @@ func (s *AuthService) GetCurrentUser(ctx context.Context, userID int) (*models.U
 }
 
 // SearchUsers searches for users by email or username
-func (s *AuthService) SearchUsers(ctx context.Context, query string, limit int) ([]*models.User, error) {
+// Service accounts are excluded unless includeBots is set
+func (s *AuthService) SearchUsers(ctx context.Context, query string, limit int, includeBots bool) ([]*models.User, error) {
 	if limit <= 0 || limit > 50 {
 		limit = 20 // Default limit
 	}
-	return s.userRepo.Search(ctx, query, limit)
+	return s.userRepo.Search(ctx, query, limit, includeBots)
 }
 
 // TokenExchange exchanges external user info for Flow tokens (SSO/OAuth integration)
@@ -0,0 +1,159 @@
+package service
+
+import (
+	"context"
+	"strings"
+
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/yourusername/issue-tracker/internal/models"
+	"github.com/yourusername/issue-tracker/internal/repository"
+	pkgerrors "github.com/yourusername/issue-tracker/pkg/errors"
+	"github.com/yourusername/issue-tracker/pkg/ldap"
+)
+
+// LDAPProvider is the external provider name stored for directory users
+const LDAPProvider = "ldap"
+
+// Authenticator verifies login credentials against a single backend.
+// Implementations return pkgerrors.ErrInvalidCredentials when the
+// credentials are not valid for their backend so the next one can be tried.
+type Authenticator interface {
+	Authenticate(ctx context.Context, req *models.LoginRequest) (*models.User, error)
+}
+
+// PasswordAuthenticator checks credentials against locally stored bcrypt hashes
+type PasswordAuthenticator struct {
+	userRepo *repository.UserRepository
+}
+
+// NewPasswordAuthenticator creates a new password authenticator
+func NewPasswordAuthenticator(userRepo *repository.UserRepository) *PasswordAuthenticator {
+	return &PasswordAuthenticator{
+		userRepo: userRepo,
+	}
+}
+
+// Authenticate verifies the email and password against the users table
+func (a *PasswordAuthenticator) Authenticate(ctx context.Context, req *models.LoginRequest) (*models.User, error) {
+	user, err := a.userRepo.GetByEmail(ctx, req.Email)
+	if err != nil {
+		if err == pkgerrors.ErrNotFound {
+			return nil, pkgerrors.ErrInvalidCredentials
+		}
+		return nil, err
+	}
+
+	// External users have no password hash, so this also rejects them
+	err = bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(req.Password))
+	if err != nil {
+		return nil, pkgerrors.ErrInvalidCredentials
+	}
+
+	return user, nil
+}
+
+// LDAPAuthenticator checks credentials with an LDAP simple bind and
+// provisions a local user on first login
+type LDAPAuthenticator struct {
+	client       *ldap.Client
+	userRepo     *repository.UserRepository
+	linkExisting bool // Link local password accounts with the same email
+}
+
+// NewLDAPAuthenticator creates a new LDAP authenticator
+// With linkExisting, a local password account whose email matches a directory
+// entry is taken over by that entry on its first login; otherwise such logins
+// fail with ErrConflict. Only enable it when the directory is trusted to own
+// every email address it holds
+func NewLDAPAuthenticator(client *ldap.Client, userRepo *repository.UserRepository, linkExisting bool) *LDAPAuthenticator {
+	return &LDAPAuthenticator{
+		client:       client,
+		userRepo:     userRepo,
+		linkExisting: linkExisting,
+	}
+}
+
+// Authenticate binds as the user and returns the linked local user,
+// creating it from the directory attributes if it does not exist yet
+func (a *LDAPAuthenticator) Authenticate(ctx context.Context, req *models.LoginRequest) (*models.User, error) {
+	entry, err := a.client.Authenticate(req.Email, req.Password)
+	if err != nil {
+		if err == ldap.ErrInvalidCredentials || err == ldap.ErrUserNotFound {
+			return nil, pkgerrors.ErrInvalidCredentials
+		}
+		return nil, err
+	}
+
+	user, err := a.userRepo.GetByExternalID(ctx, entry.ID, LDAPProvider)
+	if err == nil {
+		return user, nil
+	}
+	if err != pkgerrors.ErrNotFound {
+		return nil, err
+	}
+
+	return a.provision(ctx, entry, req.Email)
+}
+
+// provision creates a local user for a directory entry on first login
+func (a *LDAPAuthenticator) provision(ctx context.Context, entry *ldap.Entry, login string) (*models.User, error) {
+	email := entry.Email
+	if email == "" {
+		if !strings.Contains(login, "@") {
+			return nil, pkgerrors.ErrValidation // Directory entry has no mail attribute
+		}
+		email = login
+	}
+	username := entry.Username
+	if username == "" {
+		username = strings.SplitN(email, "@", 2)[0]
+	}
+
+	externalID := entry.ID
+	provider := LDAPProvider
+	newUser := &models.User{
+		Email:            email,
+		Username:         username,
+		ExternalID:       &externalID,
+		ExternalProvider: &provider,
+		PasswordHash:     "", // No password for directory users
+	}
+	if entry.Name != "" {
+		name := entry.Name
+		newUser.Name = &name
+	}
+
+	created, err := a.userRepo.Create(ctx, newUser)
+	if err == nil {
+		return created, nil
+	}
+	if err != pkgerrors.ErrConflict {
+		return nil, err
+	}
+
+	// A local account with the same email exists: link it to the directory
+	// entry only when configured to, and never when it is already bound to
+	// another external identity
+	if !a.linkExisting {
+		return nil, pkgerrors.ErrConflict
+	}
+	existing, err := a.userRepo.GetByEmail(ctx, email)
+	if err != nil {
+		if err == pkgerrors.ErrNotFound {
+			return nil, pkgerrors.ErrConflict // Username is taken by someone else
+		}
+		return nil, err
+	}
+	if existing.ExternalID != nil {
+		return nil, pkgerrors.ErrConflict
+	}
+
+	if err := a.userRepo.LinkExternalID(ctx, existing.ID, externalID, provider); err != nil {
+		return nil, err
+	}
+	existing.ExternalID = &externalID
+	existing.ExternalProvider = &provider
+
+	return existing, nil
+}
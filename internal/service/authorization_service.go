@@ -39,7 +39,7 @@ func (s *AuthorizationService) CheckProjectAccess(ctx context.Context, projectID
 	}
 
 	// Check if user is a member
-	member, err := s.memberRepo.GetMember(ctx, projectID, userID)
+	member, err := s.memberRepo.GetAccess(ctx, projectID, userID)
 	if err != nil || member == nil {
 		return errors.NewPermissionError("access denied: you are not a member of this project")
 	}
@@ -69,7 +69,7 @@ func (s *AuthorizationService) CheckWritePermission(ctx context.Context, project
 	}
 
 	// Check member role
-	member, err := s.memberRepo.GetMember(ctx, projectID, userID)
+	member, err := s.memberRepo.GetAccess(ctx, projectID, userID)
 	if err != nil || member == nil {
 		return errors.NewPermissionError("access denied: you are not a member of this project")
 	}
@@ -104,7 +104,7 @@ func (s *AuthorizationService) CheckAdminPermission(ctx context.Context, project
 	}
 
 	// Check member role
-	member, err := s.memberRepo.GetMember(ctx, projectID, userID)
+	member, err := s.memberRepo.GetAccess(ctx, projectID, userID)
 	if err != nil || member == nil {
 		return errors.NewPermissionError("access denied: you are not a member of this project")
 	}
@@ -133,7 +133,7 @@ func (s *AuthorizationService) GetUserRole(ctx context.Context, projectID int, u
 	}
 
 	// Get member role
-	member, err := s.memberRepo.GetMember(ctx, projectID, userID)
+	member, err := s.memberRepo.GetAccess(ctx, projectID, userID)
 	if err != nil || member == nil {
 		return "", errors.NewPermissionError("access denied: you are not a member of this project")
 	}
@@ -3,30 +3,56 @@ package service
 import (
 	"context"
 	"database/sql"
+	"fmt"
+	"sort"
+	"strings"
 
 	"github.com/yourusername/issue-tracker/internal/models"
 	"github.com/yourusername/issue-tracker/internal/repository"
+	pkgerrors "github.com/yourusername/issue-tracker/pkg/errors"
 )
 
 // BoardService handles board business logic
 type BoardService struct {
 	boardRepo   *repository.BoardRepository
 	projectRepo *repository.ProjectRepository
+	issueRepo   *repository.IssueRepository
+	labelRepo   *repository.LabelRepository
+	userRepo    *repository.UserRepository
 	authService *AuthorizationService
 	db          *sql.DB
+
+	workflowService *WorkflowService
 }
 
 // NewBoardService creates a new board service
-func NewBoardService(boardRepo *repository.BoardRepository, projectRepo *repository.ProjectRepository, authService *AuthorizationService, db *sql.DB) *BoardService {
+func NewBoardService(
+	boardRepo *repository.BoardRepository,
+	projectRepo *repository.ProjectRepository,
+	issueRepo *repository.IssueRepository,
+	labelRepo *repository.LabelRepository,
+	userRepo *repository.UserRepository,
+	authService *AuthorizationService,
+	db *sql.DB,
+) *BoardService {
 	return &BoardService{
 		boardRepo:   boardRepo,
 		projectRepo: projectRepo,
+		issueRepo:   issueRepo,
+		labelRepo:   labelRepo,
+		userRepo:    userRepo,
 		authService: authService,
 		db:          db,
 	}
 }
 
-// List lists all board columns for a project
+// SetWorkflowService sets the workflow service (optional, for custom workflows)
+// Without it columns can only be mapped to the default workflow statuses
+func (s *BoardService) SetWorkflowService(workflowService *WorkflowService) {
+	s.workflowService = workflowService
+}
+
+// List lists all columns of a project's default board
 func (s *BoardService) List(ctx context.Context, projectID int, userID int) ([]*models.BoardColumn, error) {
 	// Check if user has access to project
 	if err := s.authService.CheckProjectAccess(ctx, projectID, userID); err != nil {
@@ -36,17 +62,58 @@ func (s *BoardService) List(ctx context.Context, projectID int, userID int) ([]*
 	return s.boardRepo.ListByProjectID(ctx, projectID)
 }
 
-// CreateColumn creates a new board column
+// CreateColumn creates a new column on a project's default board
 func (s *BoardService) CreateColumn(ctx context.Context, projectID int, req *models.CreateBoardColumnRequest, userID int) (*models.BoardColumn, error) {
 	// Check if user has admin permission (only admins/owners can create columns)
 	if err := s.authService.CheckAdminPermission(ctx, projectID, userID); err != nil {
 		return nil, err
 	}
 
+	boardID, err := s.boardRepo.EnsureDefaultBoard(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.addColumn(ctx, projectID, boardID, req, userID)
+}
+
+// CreateBoardColumn creates a new column on a board
+func (s *BoardService) CreateBoardColumn(ctx context.Context, boardID int, req *models.CreateBoardColumnRequest, userID int) (*models.BoardColumn, error) {
+	board, err := s.boardRepo.GetBoard(ctx, boardID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check if user has admin permission (only admins/owners can create columns)
+	if err := s.authService.CheckAdminPermission(ctx, board.ProjectID, userID); err != nil {
+		return nil, err
+	}
+
+	return s.addColumn(ctx, board.ProjectID, board.ID, req, userID)
+}
+
+// addColumn validates and creates a column on a board
+func (s *BoardService) addColumn(ctx context.Context, projectID int, boardID int, req *models.CreateBoardColumnRequest, userID int) (*models.BoardColumn, error) {
+	if err := s.validateStatuses(ctx, projectID, boardID, 0, req.Statuses, userID); err != nil {
+		return nil, err
+	}
+
+	wipMode := models.WIPModeSoft
+	if req.WIPMode != "" {
+		wipMode = req.WIPMode
+	}
+	if err := validateWIPLimit(req.WIPLimit, wipMode); err != nil {
+		return nil, err
+	}
+
 	column := &models.BoardColumn{
 		ProjectID: projectID,
+		BoardID:   boardID,
 		Name:      req.Name,
 		Position:  req.Position,
+		Statuses:  req.Statuses,
+		WIPLimit:  req.WIPLimit,
+		WIPMode:   wipMode,
 	}
 
 	return s.boardRepo.Create(ctx, column)
@@ -72,6 +139,24 @@ func (s *BoardService) UpdateColumn(ctx context.Context, columnID int, req *mode
 	if req.Position != nil {
 		column.Position = *req.Position
 	}
+	if req.Statuses != nil {
+		if err := s.validateStatuses(ctx, column.ProjectID, column.BoardID, column.ID, *req.Statuses, userID); err != nil {
+			return nil, err
+		}
+		column.Statuses = *req.Statuses
+	}
+	if req.WIPLimit != nil {
+		column.WIPLimit = req.WIPLimit
+		if *req.WIPLimit == 0 {
+			column.WIPLimit = nil
+		}
+	}
+	if req.WIPMode != nil {
+		column.WIPMode = *req.WIPMode
+	}
+	if err := validateWIPLimit(column.WIPLimit, column.WIPMode); err != nil {
+		return nil, err
+	}
 
 	err = s.boardRepo.Update(ctx, column)
 	if err != nil {
@@ -96,3 +181,452 @@ func (s *BoardService) DeleteColumn(ctx context.Context, columnID int, userID in
 
 	return s.boardRepo.Delete(ctx, columnID)
 }
+
+// CreateBoard creates a board in a project
+// Without columns in the request, the columns of the default board are copied
+func (s *BoardService) CreateBoard(ctx context.Context, projectID int, req *models.CreateBoardRequest, userID int) (*models.Board, error) {
+	// Check if user has admin permission (only admins/owners can manage boards)
+	if err := s.authService.CheckAdminPermission(ctx, projectID, userID); err != nil {
+		return nil, err
+	}
+
+	board := &models.Board{
+		ProjectID:   projectID,
+		Name:        strings.TrimSpace(req.Name),
+		Description: req.Description,
+		Swimlane:    models.SwimlaneNone,
+		CreatedBy:   &userID,
+	}
+	if req.Filter != nil {
+		board.Filter = *req.Filter
+	}
+	if req.Swimlane != "" {
+		board.Swimlane = req.Swimlane
+	}
+	if err := validateBoard(board); err != nil {
+		return nil, err
+	}
+
+	var columns []*models.BoardColumn
+	if len(req.Columns) == 0 {
+		defaults, err := s.boardRepo.ListByProjectID(ctx, projectID)
+		if err != nil {
+			return nil, err
+		}
+		for _, column := range defaults {
+			columns = append(columns, &models.BoardColumn{
+				Name:     column.Name,
+				Position: column.Position,
+				Statuses: column.Statuses,
+				WIPLimit: column.WIPLimit,
+				WIPMode:  column.WIPMode,
+			})
+		}
+	} else {
+		// Statuses are checked together: a status maps to one column per board
+		var statuses []models.IssueStatus
+		for _, colReq := range req.Columns {
+			if strings.TrimSpace(colReq.Name) == "" {
+				return nil, pkgerrors.NewValidationError("column name is required")
+			}
+			wipMode := models.WIPModeSoft
+			if colReq.WIPMode != "" {
+				wipMode = colReq.WIPMode
+			}
+			if err := validateWIPLimit(colReq.WIPLimit, wipMode); err != nil {
+				return nil, err
+			}
+			statuses = append(statuses, colReq.Statuses...)
+			columns = append(columns, &models.BoardColumn{
+				Name:     colReq.Name,
+				Position: colReq.Position,
+				Statuses: colReq.Statuses,
+				WIPLimit: colReq.WIPLimit,
+				WIPMode:  wipMode,
+			})
+		}
+		if err := s.validateStatuses(ctx, projectID, 0, 0, statuses, userID); err != nil {
+			return nil, err
+		}
+	}
+
+	created, err := s.boardRepo.CreateBoard(ctx, board, columns)
+	if err != nil {
+		return nil, err
+	}
+
+	created.Columns, err = s.boardRepo.ListByBoardID(ctx, created.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+// ListBoards lists the boards of a project
+func (s *BoardService) ListBoards(ctx context.Context, projectID int, userID int) ([]*models.Board, error) {
+	// Check if user has access to project
+	if err := s.authService.CheckProjectAccess(ctx, projectID, userID); err != nil {
+		return nil, err
+	}
+
+	return s.boardRepo.ListBoards(ctx, projectID)
+}
+
+// GetBoard retrieves a board with its columns
+func (s *BoardService) GetBoard(ctx context.Context, boardID int, userID int) (*models.Board, error) {
+	board, err := s.boardRepo.GetBoard(ctx, boardID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check if user has access to project
+	if err := s.authService.CheckProjectAccess(ctx, board.ProjectID, userID); err != nil {
+		return nil, err
+	}
+
+	board.Columns, err = s.boardRepo.ListByBoardID(ctx, boardID)
+	if err != nil {
+		return nil, err
+	}
+
+	return board, nil
+}
+
+// UpdateBoard updates a board's name, filter, swimlanes or makes it the default board
+func (s *BoardService) UpdateBoard(ctx context.Context, boardID int, req *models.UpdateBoardRequest, userID int) (*models.Board, error) {
+	board, err := s.boardRepo.GetBoard(ctx, boardID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check if user has admin permission (only admins/owners can manage boards)
+	if err := s.authService.CheckAdminPermission(ctx, board.ProjectID, userID); err != nil {
+		return nil, err
+	}
+
+	if req.Name != nil {
+		board.Name = strings.TrimSpace(*req.Name)
+	}
+	if req.Description != nil {
+		board.Description = req.Description
+		if *req.Description == "" {
+			board.Description = nil
+		}
+	}
+	if req.Filter != nil {
+		board.Filter = *req.Filter
+	}
+	if req.Swimlane != nil {
+		board.Swimlane = *req.Swimlane
+	}
+	if req.IsDefault != nil {
+		if !*req.IsDefault && board.IsDefault {
+			return nil, pkgerrors.NewValidationError("make another board the default instead")
+		}
+		board.IsDefault = board.IsDefault || *req.IsDefault
+	}
+	if err := validateBoard(board); err != nil {
+		return nil, err
+	}
+
+	if err := s.boardRepo.UpdateBoard(ctx, board); err != nil {
+		return nil, err
+	}
+
+	return s.GetBoard(ctx, boardID, userID)
+}
+
+// DeleteBoard deletes a board and its columns; the default board can't be deleted
+func (s *BoardService) DeleteBoard(ctx context.Context, boardID int, userID int) error {
+	board, err := s.boardRepo.GetBoard(ctx, boardID)
+	if err != nil {
+		return err
+	}
+
+	// Check if user has admin permission (only admins/owners can manage boards)
+	if err := s.authService.CheckAdminPermission(ctx, board.ProjectID, userID); err != nil {
+		return err
+	}
+
+	if board.IsDefault {
+		return pkgerrors.NewValidationError("the default board can't be deleted")
+	}
+
+	return s.boardRepo.DeleteBoard(ctx, boardID)
+}
+
+// GetBoardView materializes a board: the issues matching its saved filter,
+// grouped by swimlane and column
+// Issues are placed by status in columns mapped to statuses, and by their
+// column otherwise; issues in no column of the board are left out
+func (s *BoardService) GetBoardView(ctx context.Context, boardID int, userID int) (*models.BoardView, error) {
+	board, err := s.GetBoard(ctx, boardID, userID)
+	if err != nil {
+		return nil, err
+	}
+	columns := board.Columns
+	board.Columns = nil
+
+	issues, err := s.issueRepo.List(ctx, board.Filter.IssueFilter(board.ProjectID))
+	if err != nil {
+		return nil, err
+	}
+
+	// Cards keep their board order, unordered cards last
+	sort.SliceStable(issues, func(i, j int) bool {
+		a, b := issues[i].ColumnPosition, issues[j].ColumnPosition
+		return a != nil && (b == nil || *a < *b)
+	})
+
+	var labels map[int][]*models.Label
+	if board.Swimlane == models.SwimlaneLabel {
+		issueIDs := make([]int, len(issues))
+		for i, issue := range issues {
+			issueIDs[i] = issue.ID
+		}
+		labels, err = s.labelRepo.ListByIssueIDs(ctx, issueIDs)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	view := newBoardViewBuilder(columns)
+	for _, issue := range issues {
+		column := boardColumnFor(issue, columns)
+		if column < 0 {
+			continue
+		}
+
+		lanes, err := s.issueLanes(ctx, board.Swimlane, issue, labels[issue.ID], view)
+		if err != nil {
+			return nil, err
+		}
+		for _, lane := range lanes {
+			lane.Cells[column].Issues = append(lane.Cells[column].Issues, issue)
+		}
+	}
+
+	return &models.BoardView{
+		Board:   board,
+		Columns: columns,
+		Lanes:   view.sortedLanes(board.Swimlane),
+	}, nil
+}
+
+// issueLanes returns the lanes of an issue for a swimlane grouping
+func (s *BoardService) issueLanes(ctx context.Context, swimlane models.Swimlane, issue *models.Issue, labels []*models.Label, view *boardViewBuilder) ([]*models.BoardLane, error) {
+	switch swimlane {
+	case models.SwimlaneAssignee:
+		if issue.AssigneeID == nil {
+			return []*models.BoardLane{view.lane(boardLaneNone, "Unassigned")}, nil
+		}
+		key := fmt.Sprintf("assignee:%d", *issue.AssigneeID)
+		if lane := view.lanes[key]; lane != nil {
+			return []*models.BoardLane{lane}, nil
+		}
+		user, err := s.userRepo.GetByID(ctx, *issue.AssigneeID)
+		if err != nil {
+			return nil, err
+		}
+		return []*models.BoardLane{view.lane(key, user.Username)}, nil
+
+	case models.SwimlaneEpic:
+		if issue.EpicID == nil {
+			return []*models.BoardLane{view.lane(boardLaneNone, "No epic")}, nil
+		}
+		key := fmt.Sprintf("epic:%d", *issue.EpicID)
+		if lane := view.lanes[key]; lane != nil {
+			return []*models.BoardLane{lane}, nil
+		}
+		epic, err := s.issueRepo.GetByID(ctx, *issue.EpicID)
+		if err != nil {
+			return nil, err
+		}
+		return []*models.BoardLane{view.lane(key, epic.Title)}, nil
+
+	case models.SwimlanePriority:
+		return []*models.BoardLane{view.lane("priority:"+string(issue.Priority), string(issue.Priority))}, nil
+
+	case models.SwimlaneLabel:
+		if len(labels) == 0 {
+			return []*models.BoardLane{view.lane(boardLaneNone, "No label")}, nil
+		}
+		lanes := make([]*models.BoardLane, len(labels))
+		for i, label := range labels {
+			lanes[i] = view.lane(fmt.Sprintf("label:%d", label.ID), label.Name)
+		}
+		return lanes, nil
+	}
+
+	return []*models.BoardLane{view.lane(boardLaneNone, "All issues")}, nil
+}
+
+// boardLaneNone is the key of the lane of cards without a swimlane value
+const boardLaneNone = "none"
+
+// boardPriorityOrder orders priority swimlanes, most urgent first
+var boardPriorityOrder = map[string]int{
+	"priority:" + string(models.PriorityUrgent): 0,
+	"priority:" + string(models.PriorityHigh):   1,
+	"priority:" + string(models.PriorityMedium): 2,
+	"priority:" + string(models.PriorityLow):    3,
+}
+
+// boardViewBuilder collects the lanes of a board view
+type boardViewBuilder struct {
+	columns []*models.BoardColumn
+	lanes   map[string]*models.BoardLane
+}
+
+func newBoardViewBuilder(columns []*models.BoardColumn) *boardViewBuilder {
+	return &boardViewBuilder{
+		columns: columns,
+		lanes:   make(map[string]*models.BoardLane),
+	}
+}
+
+// lane returns the lane with the key, adding it with one empty cell per column
+func (b *boardViewBuilder) lane(key string, name string) *models.BoardLane {
+	if lane, ok := b.lanes[key]; ok {
+		return lane
+	}
+
+	lane := &models.BoardLane{Key: key, Name: name, Cells: make([]*models.BoardCell, len(b.columns))}
+	for i, column := range b.columns {
+		lane.Cells[i] = &models.BoardCell{ColumnID: column.ID, Issues: make([]*models.Issue, 0)}
+	}
+	b.lanes[key] = lane
+	return lane
+}
+
+// sortedLanes returns the lanes by name (priorities by urgency), the lane of
+// cards without a value last
+func (b *boardViewBuilder) sortedLanes(swimlane models.Swimlane) []*models.BoardLane {
+	lanes := make([]*models.BoardLane, 0, len(b.lanes))
+	for _, lane := range b.lanes {
+		lanes = append(lanes, lane)
+	}
+	if len(lanes) == 0 {
+		lanes = append(lanes, b.lane(boardLaneNone, "All issues"))
+	}
+
+	sort.Slice(lanes, func(i, j int) bool {
+		a, c := lanes[i], lanes[j]
+		if (a.Key == boardLaneNone) != (c.Key == boardLaneNone) {
+			return c.Key == boardLaneNone
+		}
+		if swimlane == models.SwimlanePriority {
+			return boardPriorityOrder[a.Key] < boardPriorityOrder[c.Key]
+		}
+		if a.Name != c.Name {
+			return strings.ToLower(a.Name) < strings.ToLower(c.Name)
+		}
+		return a.Key < c.Key
+	})
+	return lanes
+}
+
+// boardColumnFor returns the index of the column showing an issue, or -1
+func boardColumnFor(issue *models.Issue, columns []*models.BoardColumn) int {
+	for i, column := range columns {
+		if len(column.Statuses) > 0 && columnHasStatus(column, issue.Status) {
+			return i
+		}
+	}
+	for i, column := range columns {
+		if len(column.Statuses) == 0 && issue.ColumnID != nil && *issue.ColumnID == column.ID {
+			return i
+		}
+	}
+	return -1
+}
+
+// validateBoard checks the name and swimlane grouping of a board
+func validateBoard(board *models.Board) error {
+	if board.Name == "" || len(board.Name) > 100 {
+		return pkgerrors.NewValidationError("board name must be 1 to 100 characters")
+	}
+	if !models.IsValidSwimlane(board.Swimlane) {
+		return pkgerrors.NewValidationError(fmt.Sprintf("invalid swimlane %q (none, assignee, epic, priority or label)", board.Swimlane))
+	}
+	for _, issueType := range board.Filter.IssueTypes {
+		if !isValidIssueType(issueType) {
+			return pkgerrors.NewValidationError(fmt.Sprintf("invalid issue type %q in filter", issueType))
+		}
+	}
+	return nil
+}
+
+// ListWIPOverrides lists the audited WIP limit overrides of a column
+func (s *BoardService) ListWIPOverrides(ctx context.Context, columnID int, userID int) ([]*models.WIPOverride, error) {
+	column, err := s.boardRepo.GetByID(ctx, columnID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check if user has admin permission (only admins/owners can review overrides)
+	if err := s.authService.CheckAdminPermission(ctx, column.ProjectID, userID); err != nil {
+		return nil, err
+	}
+
+	return s.boardRepo.ListWIPOverrides(ctx, columnID)
+}
+
+// validateWIPLimit checks the WIP limit and mode of a column
+func validateWIPLimit(limit *int, mode models.WIPMode) error {
+	if limit != nil && *limit < 1 {
+		return pkgerrors.NewValidationError("wip_limit must be at least 1")
+	}
+	if !models.IsValidWIPMode(mode) {
+		return pkgerrors.NewValidationError(fmt.Sprintf("invalid wip_mode %q (soft or hard)", mode))
+	}
+	return nil
+}
+
+// validateStatuses checks that the statuses mapped to a column exist in one of
+// the project's workflows and are not already mapped to another column of the
+// board, so a status change always has a single column to move the card to
+func (s *BoardService) validateStatuses(ctx context.Context, projectID int, boardID int, columnID int, statuses []models.IssueStatus, userID int) error {
+	if len(statuses) == 0 {
+		return nil
+	}
+
+	workflows := []*models.Workflow{models.DefaultWorkflow(projectID)}
+	if s.workflowService != nil {
+		var err error
+		workflows, err = s.workflowService.List(ctx, projectID, userID)
+		if err != nil {
+			return err
+		}
+	}
+
+	seen := make(map[models.IssueStatus]bool, len(statuses))
+	for _, status := range statuses {
+		if seen[status] {
+			return pkgerrors.NewValidationError(fmt.Sprintf("status %q is listed twice", status))
+		}
+		seen[status] = true
+
+		known := false
+		for _, workflow := range workflows {
+			if workflow.Status(status) != nil {
+				known = true
+				break
+			}
+		}
+		if !known {
+			return pkgerrors.NewValidationError(fmt.Sprintf("status %q is not part of any workflow of the project", status))
+		}
+
+		mapped, err := s.boardRepo.GetByStatus(ctx, boardID, status)
+		if err != nil && err != pkgerrors.ErrNotFound {
+			return err
+		}
+		if mapped != nil && mapped.ID != columnID {
+			return pkgerrors.NewValidationError(fmt.Sprintf("status %q is already mapped to column %q", status, mapped.Name))
+		}
+	}
+
+	return nil
+}
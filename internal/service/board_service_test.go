@@ -27,8 +27,11 @@ func setupBoardService(t *testing.T) (*BoardService, *repository.ProjectReposito
 	boardRepo := repository.NewBoardRepository(db)
 	projectRepo := repository.NewProjectRepository(db)
 	userRepo := repository.NewUserRepository(db)
+	issueRepo := repository.NewIssueRepository(db)
+	labelRepo := repository.NewLabelRepository(db)
+	authService := NewAuthorizationService(projectRepo, repository.NewProjectMemberRepository(db))
 
-	boardService := NewBoardService(boardRepo, projectRepo, db)
+	boardService := NewBoardService(boardRepo, projectRepo, issueRepo, labelRepo, userRepo, authService, db)
 
 	cleanup := func() {
 		db.Exec("DELETE FROM board_columns")
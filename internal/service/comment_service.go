@@ -20,6 +20,7 @@ type CommentService struct {
 	mentionService   *MentionService
 	referenceService *IssueReferenceService
 	webhookService   *WebhookService
+	revisionService  *RevisionService
 }
 
 // NewCommentService creates a new comment service
@@ -36,6 +37,12 @@ func NewCommentService(commentRepo *repository.CommentRepository, issueRepo *rep
 	}
 }
 
+// SetRevisionService sets the revision service (optional, for comment history)
+// Without it edited comments don't keep their previous text
+func (s *CommentService) SetRevisionService(revisionService *RevisionService) {
+	s.revisionService = revisionService
+}
+
 // Create creates a new comment
 func (s *CommentService) Create(ctx context.Context, issueID int, req *models.CreateCommentRequest, userID int) (*models.Comment, error) {
 	// Check if issue exists
@@ -68,7 +75,7 @@ func (s *CommentService) Create(ctx context.Context, issueID int, req *models.Cr
 
 	// Process @mentions and #issue_number references in comment content
 	if req.Content != "" {
-		mentionedUserIDs, err := s.mentionService.ProcessMentions(ctx, req.Content, "comment", created.ID, userID)
+		mentionedUserIDs, err := s.mentionService.ProcessMentions(ctx, req.Content, "comment", created.ID, issue.ProjectID, userID)
 		if err != nil {
 			// Log error but don't fail the whole operation
 			// TODO: Add proper logging
@@ -80,6 +87,9 @@ func (s *CommentService) Create(ctx context.Context, issueID int, req *models.Cr
 		// Process #issue_number references
 		_, _ = s.referenceService.ProcessReferences(ctx, req.Content, "comment", created.ID, issue.ProjectID)
 	}
+	if s.revisionService != nil {
+		s.revisionService.Record(ctx, models.RevisionEntityComment, created.ID, nil, req.Content, userID)
+	}
 
 	// Deliver webhook event (use background context since this runs async)
 	if s.webhookService != nil {
@@ -118,6 +128,7 @@ func (s *CommentService) Update(ctx context.Context, id int, req *models.UpdateC
 		return nil, pkgerrors.ErrForbidden
 	}
 
+	previous := *comment
 	comment.Content = req.Content
 
 	// Render markdown content to HTML
@@ -146,7 +157,7 @@ func (s *CommentService) Update(ctx context.Context, id int, req *models.UpdateC
 
 	// Process new mentions and references
 	if req.Content != "" {
-		mentionedUserIDs, err := s.mentionService.ProcessMentions(ctx, req.Content, "comment", id, userID)
+		mentionedUserIDs, err := s.mentionService.ProcessMentions(ctx, req.Content, "comment", id, issue.ProjectID, userID)
 		if err == nil && len(mentionedUserIDs) > 0 {
 			// Create notifications for mentioned users
 			_ = s.mentionService.CreateNotificationsForMentions(ctx, mentionedUserIDs, "comment", id, userID)
@@ -156,6 +167,16 @@ func (s *CommentService) Update(ctx context.Context, id int, req *models.UpdateC
 		_, _ = s.referenceService.ProcessReferences(ctx, req.Content, "comment", id, issue.ProjectID)
 	}
 
+	// Keep the replaced text; the first edit of a comment written before
+	// revisions were recorded also saves it as the first revision
+	if s.revisionService != nil && previous.Content != req.Content {
+		s.revisionService.Record(ctx, models.RevisionEntityComment, id, &models.ContentRevision{
+			Content:   &previous.Content,
+			AuthorID:  &previous.UserID,
+			CreatedAt: previous.UpdatedAt,
+		}, req.Content, userID)
+	}
+
 	// Get updated comment
 	updated, err := s.commentRepo.GetByID(ctx, id)
 	if err != nil {
@@ -192,6 +213,7 @@ func (s *CommentService) Delete(ctx context.Context, id int, userID int) error {
 		if err := s.commentRepo.Delete(ctx, id); err != nil {
 			return err
 		}
+		s.deleteHistory(ctx, id)
 		// Deliver webhook event (use background context since this runs async)
 		if s.webhookService != nil {
 			go s.webhookService.DeliverEvent(context.Background(), issue.ProjectID, models.EventCommentDeleted, userID, &deletedComment)
@@ -207,6 +229,7 @@ func (s *CommentService) Delete(ctx context.Context, id int, userID int) error {
 	if err := s.commentRepo.Delete(ctx, id); err != nil {
 		return err
 	}
+	s.deleteHistory(ctx, id)
 
 	// Deliver webhook event (use background context since this runs async)
 	if s.webhookService != nil {
@@ -216,12 +239,19 @@ func (s *CommentService) Delete(ctx context.Context, id int, userID int) error {
 	return nil
 }
 
+// deleteHistory deletes the revisions of a deleted comment
+func (s *CommentService) deleteHistory(ctx context.Context, id int) {
+	if s.revisionService != nil {
+		s.revisionService.DeleteHistory(ctx, models.RevisionEntityComment, id)
+	}
+}
+
 // userHasAccess checks if user has any access to the project
 func (s *CommentService) userHasAccess(ctx context.Context, userID int, projectID int) (bool, error) {
 	var count int
 	err := s.db.QueryRowContext(ctx, `
 		SELECT COUNT(*)
-		FROM project_members
+		FROM project_access
 		WHERE project_id = $1 AND user_id = $2
 	`, projectID, userID).Scan(&count)
 
@@ -237,7 +267,7 @@ func (s *CommentService) userHasPermission(ctx context.Context, userID int, proj
 	var role string
 	err := s.db.QueryRowContext(ctx, `
 		SELECT role
-		FROM project_members
+		FROM project_access
 		WHERE project_id = $1 AND user_id = $2
 	`, projectID, userID).Scan(&role)
 
@@ -0,0 +1,447 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/yourusername/issue-tracker/internal/models"
+	"github.com/yourusername/issue-tracker/internal/repository"
+	pkgerrors "github.com/yourusername/issue-tracker/pkg/errors"
+)
+
+// customFieldKeyPattern matches custom field keys, e.g. affected_version
+var customFieldKeyPattern = regexp.MustCompile(`^[a-z][a-z0-9_]{0,49}$`)
+
+// maxCustomFieldTextLength is the longest text value a custom field holds
+const maxCustomFieldTextLength = 10000
+
+// CustomFieldService handles custom field business logic
+type CustomFieldService struct {
+	fieldRepo       *repository.CustomFieldRepository
+	userRepo        *repository.UserRepository
+	authService     *AuthorizationService
+	activityService *ActivityService
+}
+
+// NewCustomFieldService creates a new custom field service
+func NewCustomFieldService(
+	fieldRepo *repository.CustomFieldRepository,
+	userRepo *repository.UserRepository,
+	authService *AuthorizationService,
+	activityService *ActivityService,
+) *CustomFieldService {
+	return &CustomFieldService{
+		fieldRepo:       fieldRepo,
+		userRepo:        userRepo,
+		authService:     authService,
+		activityService: activityService,
+	}
+}
+
+// Create defines a custom field on a project (admins only)
+func (s *CustomFieldService) Create(ctx context.Context, projectID int, req *models.CreateCustomFieldRequest, userID int) (*models.CustomField, error) {
+	if err := s.authService.CheckAdminPermission(ctx, projectID, userID); err != nil {
+		return nil, err
+	}
+
+	if !customFieldKeyPattern.MatchString(req.Key) {
+		return nil, pkgerrors.NewValidationError("key must start with a lowercase letter and contain only lowercase letters, digits and underscores")
+	}
+	if !req.FieldType.IsValid() {
+		return nil, pkgerrors.NewValidationError(fmt.Sprintf("invalid field type %q", req.FieldType))
+	}
+
+	field := &models.CustomField{
+		ProjectID:  projectID,
+		Key:        req.Key,
+		Name:       strings.TrimSpace(req.Name),
+		FieldType:  req.FieldType,
+		Options:    req.Options,
+		IssueTypes: req.IssueTypes,
+		Required:   req.Required,
+	}
+	if req.Position != nil {
+		field.Position = *req.Position
+	} else {
+		existing, err := s.fieldRepo.ListByProjectID(ctx, projectID)
+		if err != nil {
+			return nil, err
+		}
+		field.Position = len(existing)
+	}
+	if err := validateCustomField(field); err != nil {
+		return nil, err
+	}
+
+	created, err := s.fieldRepo.Create(ctx, field)
+	if err != nil {
+		return nil, err
+	}
+
+	slog.Info("custom field created", "id", created.ID, "project_id", projectID, "key", created.Key)
+	return created, nil
+}
+
+// List lists the custom fields of a project
+func (s *CustomFieldService) List(ctx context.Context, projectID int, userID int) ([]*models.CustomField, error) {
+	if err := s.authService.CheckProjectAccess(ctx, projectID, userID); err != nil {
+		return nil, err
+	}
+
+	return s.fieldRepo.ListByProjectID(ctx, projectID)
+}
+
+// Update changes a custom field (admins only)
+// Values chosen from removed options are kept on existing issues
+func (s *CustomFieldService) Update(ctx context.Context, id int, req *models.UpdateCustomFieldRequest, userID int) (*models.CustomField, error) {
+	field, err := s.fieldRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.authService.CheckAdminPermission(ctx, field.ProjectID, userID); err != nil {
+		return nil, err
+	}
+
+	if req.Name != nil {
+		field.Name = strings.TrimSpace(*req.Name)
+	}
+	if req.Options != nil {
+		field.Options = *req.Options
+	}
+	if req.IssueTypes != nil {
+		field.IssueTypes = *req.IssueTypes
+	}
+	if req.Required != nil {
+		field.Required = *req.Required
+	}
+	if req.Position != nil {
+		field.Position = *req.Position
+	}
+	if err := validateCustomField(field); err != nil {
+		return nil, err
+	}
+
+	if err := s.fieldRepo.Update(ctx, field); err != nil {
+		return nil, err
+	}
+
+	return s.fieldRepo.GetByID(ctx, id)
+}
+
+// Delete removes a custom field and its values from every issue (admins only)
+func (s *CustomFieldService) Delete(ctx context.Context, id int, userID int) error {
+	field, err := s.fieldRepo.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if err := s.authService.CheckAdminPermission(ctx, field.ProjectID, userID); err != nil {
+		return err
+	}
+
+	if err := s.fieldRepo.Delete(ctx, id); err != nil {
+		return err
+	}
+
+	slog.Info("custom field deleted", "id", id, "project_id", field.ProjectID, "key", field.Key)
+	return nil
+}
+
+// validateCustomField checks a field definition and normalizes its options
+// and issue types
+func validateCustomField(field *models.CustomField) error {
+	if field.Name == "" {
+		return pkgerrors.NewValidationError("name is required")
+	}
+
+	if field.FieldType.HasOptions() {
+		options := make([]string, 0, len(field.Options))
+		seen := make(map[string]bool)
+		for _, option := range field.Options {
+			option = strings.TrimSpace(option)
+			if option == "" || seen[option] {
+				return pkgerrors.NewValidationError("options must be unique and not empty")
+			}
+			seen[option] = true
+			options = append(options, option)
+		}
+		if len(options) == 0 {
+			return pkgerrors.NewValidationError("select fields need at least one option")
+		}
+		field.Options = options
+	} else {
+		field.Options = []string{}
+	}
+
+	seenTypes := make(map[models.IssueType]bool)
+	for _, issueType := range field.IssueTypes {
+		if !isValidIssueType(issueType) || seenTypes[issueType] {
+			return pkgerrors.NewValidationError(fmt.Sprintf("invalid issue type %q", issueType))
+		}
+		seenTypes[issueType] = true
+	}
+	if field.IssueTypes == nil {
+		field.IssueTypes = []models.IssueType{}
+	}
+
+	return nil
+}
+
+// customFieldChange is a validated change of one custom field value; a nil
+// New clears the value
+type customFieldChange struct {
+	field *models.CustomField
+	old   json.RawMessage
+	new   json.RawMessage
+}
+
+// customFieldChanges are the validated custom field changes of an issue
+type customFieldChanges []*customFieldChange
+
+// PrepareValues validates the custom field values set on a new or updated
+// issue; issue.ID is 0 for new issues
+// New issues must set every required field of their type, and updates can't
+// clear a required field
+func (s *CustomFieldService) PrepareValues(ctx context.Context, issue *models.Issue, values models.CustomFieldValues) (customFieldChanges, error) {
+	isNew := issue.ID == 0
+	if len(values) == 0 && !isNew {
+		return nil, nil
+	}
+
+	fields, err := s.fieldRepo.ListByProjectID(ctx, issue.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+	byKey := make(map[string]*models.CustomField, len(fields))
+	for _, field := range fields {
+		byKey[field.Key] = field
+	}
+
+	existing := map[int]json.RawMessage{}
+	if !isNew {
+		if existing, err = s.fieldRepo.GetValues(ctx, issue.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	var changes customFieldChanges
+	for key, raw := range values {
+		field, ok := byKey[key]
+		if !ok {
+			return nil, pkgerrors.NewValidationError(fmt.Sprintf("unknown custom field %q", key))
+		}
+		if !field.AppliesTo(issue.IssueType) {
+			return nil, pkgerrors.NewValidationError(fmt.Sprintf("custom field %q does not apply to %s issues", key, issue.IssueType))
+		}
+
+		value, err := s.normalizeValue(ctx, field, raw)
+		if err != nil {
+			return nil, err
+		}
+		if value == nil && field.Required {
+			return nil, pkgerrors.NewValidationError(fmt.Sprintf("custom field %q is required", key))
+		}
+
+		old := existing[field.ID]
+		if bytes.Equal(old, value) {
+			continue
+		}
+		changes = append(changes, &customFieldChange{field: field, old: old, new: value})
+	}
+
+	if isNew {
+		for _, field := range fields {
+			if field.Required && field.AppliesTo(issue.IssueType) && !changes.sets(field.ID) {
+				return nil, pkgerrors.NewValidationError(fmt.Sprintf("custom field %q is required", field.Key))
+			}
+		}
+	}
+
+	return changes, nil
+}
+
+// sets reports whether the changes give a field a value
+func (c customFieldChanges) sets(fieldID int) bool {
+	for _, change := range c {
+		if change.field.ID == fieldID && change.new != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// normalizeValue validates a raw value for a field and returns its stored
+// JSON form, or nil when the value clears the field
+func (s *CustomFieldService) normalizeValue(ctx context.Context, field *models.CustomField, raw json.RawMessage) (json.RawMessage, error) {
+	invalid := func(expected string) error {
+		return pkgerrors.NewValidationError(fmt.Sprintf("custom field %q must be %s", field.Key, expected))
+	}
+
+	if len(raw) == 0 || string(raw) == "null" {
+		return nil, nil
+	}
+
+	var value interface{}
+	switch field.FieldType {
+	case models.CustomFieldNumber:
+		var number float64
+		if err := json.Unmarshal(raw, &number); err != nil {
+			return nil, invalid("a number")
+		}
+		value = number
+
+	case models.CustomFieldUser:
+		var id int
+		if err := json.Unmarshal(raw, &id); err != nil {
+			return nil, invalid("a user ID")
+		}
+		if _, err := s.userRepo.GetByID(ctx, id); err != nil {
+			if err == pkgerrors.ErrNotFound {
+				return nil, invalid("an existing user")
+			}
+			return nil, err
+		}
+		value = id
+
+	case models.CustomFieldMultiSelect:
+		var chosen []string
+		if err := json.Unmarshal(raw, &chosen); err != nil {
+			return nil, invalid("a list of options")
+		}
+		unique := make([]string, 0, len(chosen))
+		seen := make(map[string]bool)
+		for _, option := range chosen {
+			if !field.HasOption(option) {
+				return nil, invalid("a list of: " + strings.Join(field.Options, ", "))
+			}
+			if !seen[option] {
+				seen[option] = true
+				unique = append(unique, option)
+			}
+		}
+		if len(unique) == 0 {
+			return nil, nil
+		}
+		value = unique
+
+	default:
+		var text string
+		if err := json.Unmarshal(raw, &text); err != nil {
+			return nil, invalid("a string")
+		}
+		text = strings.TrimSpace(text)
+		if text == "" {
+			return nil, nil
+		}
+
+		switch field.FieldType {
+		case models.CustomFieldText:
+			if len(text) > maxCustomFieldTextLength {
+				return nil, invalid(fmt.Sprintf("at most %d characters", maxCustomFieldTextLength))
+			}
+		case models.CustomFieldDate:
+			date, err := time.Parse(time.DateOnly, text)
+			if err != nil {
+				return nil, invalid("a date (YYYY-MM-DD)")
+			}
+			text = date.Format(time.DateOnly)
+		case models.CustomFieldSingleSelect:
+			if !field.HasOption(text) {
+				return nil, invalid("one of: " + strings.Join(field.Options, ", "))
+			}
+		case models.CustomFieldURL:
+			u, err := url.ParseRequestURI(text)
+			if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+				return nil, invalid("an http or https URL")
+			}
+		}
+		value = text
+	}
+
+	return json.Marshal(value)
+}
+
+// values returns the new values of the changes by field ID, nil clearing a
+// value, to be written with the issue
+func (c customFieldChanges) values() map[int]json.RawMessage {
+	if len(c) == 0 {
+		return nil
+	}
+	values := make(map[int]json.RawMessage, len(c))
+	for _, change := range c {
+		values[change.field.ID] = change.new
+	}
+	return values
+}
+
+// LogChanges records each stored custom field change of an issue with its
+// old and new value in the activity log
+func (s *CustomFieldService) LogChanges(ctx context.Context, issue *models.Issue, changes customFieldChanges, userID int) {
+	for _, change := range changes {
+		s.logChange(ctx, issue, change, userID)
+	}
+}
+
+// logChange records a custom field change in the issue's activity log
+func (s *CustomFieldService) logChange(ctx context.Context, issue *models.Issue, change *customFieldChange, userID int) {
+	if s.activityService == nil {
+		return
+	}
+
+	projectID, issueID := issue.ProjectID, issue.ID
+	_, _ = s.activityService.LogActivity(ctx, &models.CreateActivityRequest{
+		ProjectID:  &projectID,
+		IssueID:    &issueID,
+		UserID:     userID,
+		Action:     "custom_field_updated",
+		EntityType: string(models.EntityTypeIssue),
+		EntityID:   &issueID,
+		FieldName:  strPtr(change.field.Key),
+		OldValue:   displayCustomFieldValue(change.old),
+		NewValue:   displayCustomFieldValue(change.new),
+	})
+}
+
+// displayCustomFieldValue formats a stored value for the activity log:
+// strings unquoted, lists comma separated
+func displayCustomFieldValue(raw json.RawMessage) *string {
+	if raw == nil {
+		return nil
+	}
+
+	var text string
+	if err := json.Unmarshal(raw, &text); err == nil {
+		return &text
+	}
+	var list []string
+	if err := json.Unmarshal(raw, &list); err == nil {
+		return strPtr(strings.Join(list, ", "))
+	}
+	return strPtr(string(raw))
+}
+
+// AttachValues sets the custom field values of issues
+func (s *CustomFieldService) AttachValues(ctx context.Context, issues ...*models.Issue) error {
+	issueIDs := make([]int, len(issues))
+	for i, issue := range issues {
+		issueIDs[i] = issue.ID
+	}
+
+	values, err := s.fieldRepo.ListValuesByIssueIDs(ctx, issueIDs)
+	if err != nil {
+		return err
+	}
+
+	for _, issue := range issues {
+		issue.CustomFields = values[issue.ID]
+	}
+	return nil
+}
@@ -0,0 +1,307 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/yourusername/issue-tracker/internal/models"
+	"github.com/yourusername/issue-tracker/internal/repository"
+)
+
+// DependencyGraphService builds blocking dependency graphs of project issues
+type DependencyGraphService struct {
+	linkRepo    *repository.IssueLinkRepository
+	projectRepo *repository.ProjectRepository
+	authService *AuthorizationService
+}
+
+// NewDependencyGraphService creates a new dependency graph service
+func NewDependencyGraphService(linkRepo *repository.IssueLinkRepository, projectRepo *repository.ProjectRepository, authService *AuthorizationService) *DependencyGraphService {
+	return &DependencyGraphService{
+		linkRepo:    linkRepo,
+		projectRepo: projectRepo,
+		authService: authService,
+	}
+}
+
+// GetGraph returns the dependency graph of a project's issues, with its
+// critical path
+func (s *DependencyGraphService) GetGraph(ctx context.Context, filter *models.DependencyGraphFilter, userID int) (*models.DependencyGraph, error) {
+	if err := s.authService.CheckProjectAccess(ctx, filter.ProjectID, userID); err != nil {
+		return nil, err
+	}
+
+	settings, err := s.projectRepo.GetEstimationSettings(ctx, filter.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+
+	nodes, edges, err := s.linkRepo.GetDependencyGraph(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	graph := &models.DependencyGraph{
+		ProjectID:      filter.ProjectID,
+		EstimationType: settings.EstimationType,
+		Nodes:          nodes,
+		Edges:          edges,
+		CriticalPath:   []int{},
+	}
+	for _, node := range nodes {
+		node.Weight = remainingWork(node, settings.EstimationType)
+	}
+	markCriticalPath(graph)
+
+	return graph, nil
+}
+
+// remainingWork is the work left on an issue in the project's estimation
+// unit: story points, or the remaining (else original) estimate in minutes
+func remainingWork(node *models.DependencyNode, estimationType models.EstimationType) float64 {
+	if node.StatusCategory == models.StatusCategoryDone {
+		return 0
+	}
+
+	if estimationType == models.EstimationTypeTime {
+		if node.RemainingMinutes != nil {
+			return float64(*node.RemainingMinutes)
+		}
+		if node.EstimateMinutes != nil {
+			return float64(*node.EstimateMinutes)
+		}
+		return 0
+	}
+
+	if node.StoryPoints != nil {
+		return *node.StoryPoints
+	}
+	return 0
+}
+
+// markCriticalPath finds the blocking chain with the most remaining work,
+// preferring longer chains, then earlier due dates on ties, and marks its
+// nodes and edges
+// Issues caught in a cycle (which links created through the API can't
+// form) are left out
+func markCriticalPath(graph *models.DependencyGraph) {
+	if len(graph.Edges) == 0 {
+		return
+	}
+
+	nodes := make(map[int]*models.DependencyNode, len(graph.Nodes))
+	for _, node := range graph.Nodes {
+		nodes[node.IssueID] = node
+	}
+
+	outgoing := make(map[int][]*models.DependencyEdge)
+	inDegree := make(map[int]int)
+	for _, edge := range graph.Edges {
+		outgoing[edge.From] = append(outgoing[edge.From], edge)
+		inDegree[edge.To]++
+	}
+
+	// Topological order (Kahn), seeded in node order for stable results
+	order := make([]int, 0, len(graph.Nodes))
+	for _, node := range graph.Nodes {
+		if inDegree[node.IssueID] == 0 {
+			order = append(order, node.IssueID)
+		}
+	}
+	for i := 0; i < len(order); i++ {
+		for _, edge := range outgoing[order[i]] {
+			inDegree[edge.To]--
+			if inDegree[edge.To] == 0 {
+				order = append(order, edge.To)
+			}
+		}
+	}
+
+	// Longest path ending at each issue
+	weight := make(map[int]float64, len(order))
+	length := make(map[int]int, len(order))
+	via := make(map[int]*models.DependencyEdge, len(order))
+	deadline := make(map[int]*time.Time, len(order)) // Earliest due date on the chain
+	for _, id := range order {
+		weight[id] = nodes[id].Weight
+		length[id] = 1
+		deadline[id] = nodes[id].DueDate
+	}
+	for _, id := range order {
+		for _, edge := range outgoing[id] {
+			candidate := weight[id] + nodes[edge.To].Weight
+			candidateDeadline := earliestDue(deadline[id], nodes[edge.To].DueDate)
+			better := candidate > weight[edge.To] ||
+				(candidate == weight[edge.To] && length[id]+1 > length[edge.To]) ||
+				(candidate == weight[edge.To] && length[id]+1 == length[edge.To] && dueBefore(candidateDeadline, deadline[edge.To]))
+			if better {
+				weight[edge.To] = candidate
+				length[edge.To] = length[id] + 1
+				via[edge.To] = edge
+				deadline[edge.To] = candidateDeadline
+			}
+		}
+	}
+
+	end := 0
+	for _, id := range order {
+		if length[id] < 2 {
+			continue
+		}
+		if end == 0 || weight[id] > weight[end] ||
+			(weight[id] == weight[end] && length[id] > length[end]) ||
+			(weight[id] == weight[end] && length[id] == length[end] && dueBefore(deadline[id], deadline[end])) {
+			end = id
+		}
+	}
+	if end == 0 {
+		return
+	}
+
+	path := []int{end}
+	nodes[end].Critical = true
+	for edge := via[end]; edge != nil; edge = via[edge.From] {
+		edge.Critical = true
+		nodes[edge.From].Critical = true
+		path = append(path, edge.From)
+	}
+	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+		path[i], path[j] = path[j], path[i]
+	}
+
+	graph.CriticalPath = path
+	graph.CriticalPathWeight = weight[end]
+	graph.CriticalPathDueDate = deadline[end]
+}
+
+// earliestDue returns the earlier of two optional due dates
+func earliestDue(a, b *time.Time) *time.Time {
+	if dueBefore(b, a) {
+		return b
+	}
+	return a
+}
+
+// dueBefore reports whether due date a comes before b; a missing date
+// comes after any date
+func dueBefore(a, b *time.Time) bool {
+	if a == nil {
+		return false
+	}
+	return b == nil || a.Before(*b)
+}
+
+// RenderDOT renders a dependency graph in Graphviz DOT
+func RenderDOT(graph *models.DependencyGraph) string {
+	keys := nodeKeys(graph)
+
+	var b strings.Builder
+	b.WriteString("digraph dependencies {\n")
+	b.WriteString("  rankdir=LR;\n")
+	b.WriteString("  node [shape=box];\n")
+	for _, node := range graph.Nodes {
+		attrs := []string{"label=" + dotQuote(nodeLabel(node, "\n"))}
+		var styles []string
+		if node.StatusCategory == models.StatusCategoryDone {
+			styles = append(styles, "filled")
+			attrs = append(attrs, `fillcolor="#e6f4ea"`)
+		}
+		if node.External {
+			styles = append(styles, "dashed")
+		}
+		if len(styles) > 0 {
+			attrs = append(attrs, "style="+dotQuote(strings.Join(styles, ",")))
+		}
+		if node.Critical {
+			attrs = append(attrs, `color=red`, `penwidth=2`)
+		}
+		fmt.Fprintf(&b, "  %s [%s];\n", dotQuote(node.Key), strings.Join(attrs, ", "))
+	}
+	for _, edge := range graph.Edges {
+		fmt.Fprintf(&b, "  %s -> %s", dotQuote(keys[edge.From]), dotQuote(keys[edge.To]))
+		if edge.Critical {
+			b.WriteString(" [color=red, penwidth=2]")
+		}
+		b.WriteString(";\n")
+	}
+	b.WriteString("}\n")
+	return b.String()
+}
+
+// RenderMermaid renders a dependency graph as a Mermaid flowchart
+func RenderMermaid(graph *models.DependencyGraph) string {
+	var b strings.Builder
+	b.WriteString("flowchart LR\n")
+
+	var done, external, critical []string
+	for _, node := range graph.Nodes {
+		id := mermaidID(node.IssueID)
+		label := strings.NewReplacer(`"`, "#quot;", "\n", " ").Replace(nodeLabel(node, "<br/>"))
+		fmt.Fprintf(&b, "  %s[\"%s\"]\n", id, label)
+
+		if node.StatusCategory == models.StatusCategoryDone {
+			done = append(done, id)
+		}
+		if node.External {
+			external = append(external, id)
+		}
+		if node.Critical {
+			critical = append(critical, id)
+		}
+	}
+
+	var criticalLinks []string
+	for i, edge := range graph.Edges {
+		fmt.Fprintf(&b, "  %s --> %s\n", mermaidID(edge.From), mermaidID(edge.To))
+		if edge.Critical {
+			criticalLinks = append(criticalLinks, fmt.Sprint(i))
+		}
+	}
+
+	b.WriteString("  classDef done fill:#e6f4ea\n")
+	b.WriteString("  classDef external stroke-dasharray:4\n")
+	b.WriteString("  classDef critical stroke:#d00,stroke-width:2px\n")
+	for _, class := range []struct {
+		name string
+		ids  []string
+	}{{"done", done}, {"external", external}, {"critical", critical}} {
+		if len(class.ids) > 0 {
+			fmt.Fprintf(&b, "  class %s %s\n", strings.Join(class.ids, ","), class.name)
+		}
+	}
+	if len(criticalLinks) > 0 {
+		fmt.Fprintf(&b, "  linkStyle %s stroke:#d00,stroke-width:2px\n", strings.Join(criticalLinks, ","))
+	}
+	return b.String()
+}
+
+// nodeLabel returns the label of an issue node, with its due date on a
+// separate line
+func nodeLabel(node *models.DependencyNode, lineBreak string) string {
+	label := node.Key + ": " + node.Title
+	if node.DueDate != nil {
+		label += lineBreak + "due " + node.DueDate.Format("2006-01-02")
+	}
+	return label
+}
+
+// nodeKeys maps issue IDs to their keys
+func nodeKeys(graph *models.DependencyGraph) map[int]string {
+	keys := make(map[int]string, len(graph.Nodes))
+	for _, node := range graph.Nodes {
+		keys[node.IssueID] = node.Key
+	}
+	return keys
+}
+
+// dotQuote quotes a DOT identifier or label
+func dotQuote(s string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(s) + `"`
+}
+
+// mermaidID returns the Mermaid node ID of an issue
+func mermaidID(issueID int) string {
+	return fmt.Sprintf("i%d", issueID)
+}
@@ -0,0 +1,234 @@
+package service
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/yourusername/issue-tracker/internal/models"
+)
+
+// testGraph builds a graph of nodes with the given weights and blocking edges
+func testGraph(weights map[int]float64, order []int, edges [][2]int) *models.DependencyGraph {
+	graph := &models.DependencyGraph{}
+	for _, id := range order {
+		graph.Nodes = append(graph.Nodes, &models.DependencyNode{IssueID: id, Key: fmt.Sprintf("DEP-%d", id), Weight: weights[id]})
+	}
+	for i, edge := range edges {
+		graph.Edges = append(graph.Edges, &models.DependencyEdge{LinkID: i + 1, From: edge[0], To: edge[1]})
+	}
+	return graph
+}
+
+func TestMarkCriticalPath(t *testing.T) {
+	due := func(day int) *time.Time {
+		d := time.Date(2026, 3, day, 0, 0, 0, 0, time.UTC)
+		return &d
+	}
+
+	tests := []struct {
+		name    string
+		weights map[int]float64
+		order   []int
+		edges   [][2]int
+		due     map[int]*time.Time
+		path    []int
+		weight  float64
+	}{
+		{
+			name:    "linear chain",
+			weights: map[int]float64{1: 1, 2: 2, 3: 3},
+			order:   []int{1, 2, 3},
+			edges:   [][2]int{{1, 2}, {2, 3}},
+			path:    []int{1, 2, 3},
+			weight:  6,
+		},
+		{
+			name:    "heavier branch wins",
+			weights: map[int]float64{1: 1, 2: 1, 3: 5, 4: 1},
+			order:   []int{1, 2, 3, 4},
+			edges:   [][2]int{{1, 2}, {1, 3}, {2, 4}},
+			path:    []int{1, 3},
+			weight:  6,
+		},
+		{
+			name:    "equal weight prefers the longer chain",
+			weights: map[int]float64{1: 2, 2: 2, 3: 1, 4: 1, 5: 2},
+			order:   []int{1, 2, 3, 4, 5},
+			edges:   [][2]int{{1, 2}, {3, 4}, {4, 5}},
+			path:    []int{3, 4, 5},
+			weight:  4,
+		},
+		{
+			name:    "equal weight and length prefers the earlier due date",
+			weights: map[int]float64{1: 1, 2: 2, 3: 2, 4: 1},
+			order:   []int{1, 2, 3, 4},
+			edges:   [][2]int{{1, 2}, {3, 4}},
+			due:     map[int]*time.Time{2: due(20), 4: due(10)},
+			path:    []int{3, 4},
+			weight:  3,
+		},
+		{
+			name:    "full tie keeps the first chain in node order",
+			weights: map[int]float64{1: 1, 2: 2, 3: 2, 4: 1},
+			order:   []int{1, 3, 2, 4},
+			edges:   [][2]int{{1, 2}, {3, 4}},
+			path:    []int{1, 2},
+			weight:  3,
+		},
+		{
+			name:    "disconnected nodes are not a path",
+			weights: map[int]float64{1: 1, 2: 1, 3: 10},
+			order:   []int{3, 1, 2},
+			edges:   [][2]int{{1, 2}},
+			path:    []int{1, 2},
+			weight:  2,
+		},
+		{
+			name:    "no edges",
+			weights: map[int]float64{1: 5, 2: 3},
+			order:   []int{1, 2},
+			path:    nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			graph := testGraph(tt.weights, tt.order, tt.edges)
+			for _, node := range graph.Nodes {
+				node.DueDate = tt.due[node.IssueID]
+			}
+
+			markCriticalPath(graph)
+
+			if !reflect.DeepEqual(graph.CriticalPath, tt.path) {
+				t.Fatalf("Expected path %v, got %v", tt.path, graph.CriticalPath)
+			}
+			if graph.CriticalPathWeight != tt.weight {
+				t.Errorf("Expected weight %v, got %v", tt.weight, graph.CriticalPathWeight)
+			}
+
+			onPath := make(map[int]bool)
+			for _, id := range tt.path {
+				onPath[id] = true
+			}
+			for _, node := range graph.Nodes {
+				if node.Critical != onPath[node.IssueID] {
+					t.Errorf("Expected node %d critical=%v, got %v", node.IssueID, onPath[node.IssueID], node.Critical)
+				}
+			}
+			for _, edge := range graph.Edges {
+				want := false
+				for i := 0; i+1 < len(tt.path); i++ {
+					if tt.path[i] == edge.From && tt.path[i+1] == edge.To {
+						want = true
+					}
+				}
+				if edge.Critical != want {
+					t.Errorf("Expected edge %d->%d critical=%v, got %v", edge.From, edge.To, want, edge.Critical)
+				}
+			}
+		})
+	}
+
+	t.Run("should report the earliest due date on the path", func(t *testing.T) {
+		graph := testGraph(map[int]float64{1: 1, 2: 1, 3: 1}, []int{1, 2, 3}, [][2]int{{1, 2}, {2, 3}})
+		graph.Nodes[0].DueDate = due(15)
+		graph.Nodes[1].DueDate = due(5)
+
+		markCriticalPath(graph)
+
+		if graph.CriticalPathDueDate == nil || !graph.CriticalPathDueDate.Equal(*due(5)) {
+			t.Errorf("Expected due date %v, got %v", due(5), graph.CriticalPathDueDate)
+		}
+	})
+}
+
+func TestRenderDependencyGraph(t *testing.T) {
+	dueDate := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		node    *models.DependencyNode
+		dot     string
+		mermaid string
+	}{
+		{
+			name:    "plain title",
+			node:    &models.DependencyNode{IssueID: 1, Key: "PROJ-1", Title: "Ship it"},
+			dot:     `"PROJ-1" [label="PROJ-1: Ship it"];`,
+			mermaid: `i1["PROJ-1: Ship it"]`,
+		},
+		{
+			name:    "double quotes",
+			node:    &models.DependencyNode{IssueID: 1, Key: "PROJ-1", Title: `Say "hi"`},
+			dot:     `"PROJ-1" [label="PROJ-1: Say \"hi\""];`,
+			mermaid: `i1["PROJ-1: Say #quot;hi#quot;"]`,
+		},
+		{
+			name:    "backslash",
+			node:    &models.DependencyNode{IssueID: 1, Key: "PROJ-1", Title: `C:\temp`},
+			dot:     `"PROJ-1" [label="PROJ-1: C:\\temp"];`,
+			mermaid: `i1["PROJ-1: C:\temp"]`,
+		},
+		{
+			name:    "newline in title",
+			node:    &models.DependencyNode{IssueID: 1, Key: "PROJ-1", Title: "First\nSecond"},
+			dot:     `"PROJ-1" [label="PROJ-1: First\nSecond"];`,
+			mermaid: `i1["PROJ-1: First Second"]`,
+		},
+		{
+			name:    "due date on its own line",
+			node:    &models.DependencyNode{IssueID: 1, Key: "PROJ-1", Title: "Ship it", DueDate: &dueDate},
+			dot:     `"PROJ-1" [label="PROJ-1: Ship it\ndue 2026-03-01"];`,
+			mermaid: `i1["PROJ-1: Ship it<br/>due 2026-03-01"]`,
+		},
+		{
+			name:    "done, external and critical",
+			node:    &models.DependencyNode{IssueID: 1, Key: "PROJ-1", Title: "Ship it", StatusCategory: models.StatusCategoryDone, External: true, Critical: true},
+			dot:     `"PROJ-1" [label="PROJ-1: Ship it", fillcolor="#e6f4ea", style="filled,dashed", color=red, penwidth=2];`,
+			mermaid: "  class i1 done\n  class i1 external\n  class i1 critical\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			graph := &models.DependencyGraph{Nodes: []*models.DependencyNode{tt.node}}
+
+			if dot := RenderDOT(graph); !strings.Contains(dot, tt.dot) {
+				t.Errorf("Expected DOT to contain %s, got:\n%s", tt.dot, dot)
+			}
+			if mermaid := RenderMermaid(graph); !strings.Contains(mermaid, tt.mermaid) {
+				t.Errorf("Expected Mermaid to contain %s, got:\n%s", tt.mermaid, mermaid)
+			}
+		})
+	}
+
+	t.Run("should render edges and highlight the critical path", func(t *testing.T) {
+		graph := testGraph(map[int]float64{1: 1, 2: 1, 3: 1}, []int{1, 2, 3}, [][2]int{{1, 2}, {3, 2}})
+		graph.Edges[1].Critical = true
+
+		dot := RenderDOT(graph)
+		for _, want := range []string{
+			"  \"DEP-1\" -> \"DEP-2\";\n",
+			"  \"DEP-3\" -> \"DEP-2\" [color=red, penwidth=2];\n",
+		} {
+			if !strings.Contains(dot, want) {
+				t.Errorf("Expected DOT to contain %q, got:\n%s", want, dot)
+			}
+		}
+
+		mermaid := RenderMermaid(graph)
+		for _, want := range []string{
+			"  i1 --> i2\n",
+			"  i3 --> i2\n",
+			"  linkStyle 1 stroke:#d00,stroke-width:2px\n",
+		} {
+			if !strings.Contains(mermaid, want) {
+				t.Errorf("Expected Mermaid to contain %q, got:\n%s", want, mermaid)
+			}
+		}
+	})
+}
@@ -19,6 +19,7 @@ import (
 type IntegrationService struct {
 	integrationRepo *repository.IntegrationRepository
 	authService     *AuthorizationService
+	orgRepo         *repository.OrganizationRepository
 	httpClient      *http.Client
 }
 
@@ -33,6 +34,29 @@ func NewIntegrationService(integrationRepo *repository.IntegrationRepository, au
 	}
 }
 
+// SetOrganizationRepo sets the organization repository (optional, for org-wide allowed integrations)
+func (s *IntegrationService) SetOrganizationRepo(orgRepo *repository.OrganizationRepository) {
+	s.orgRepo = orgRepo
+}
+
+// isAllowedByOrganization checks the integration type against the settings
+// of the project's organization; personal projects allow every type
+func (s *IntegrationService) isAllowedByOrganization(ctx context.Context, projectID int, integrationType string) (bool, error) {
+	if s.orgRepo == nil {
+		return true, nil
+	}
+
+	org, err := s.orgRepo.GetByProjectID(ctx, projectID)
+	if err != nil {
+		if err == pkgerrors.ErrNotFound {
+			return true, nil
+		}
+		return false, err
+	}
+
+	return org.Settings.AllowsIntegration(integrationType), nil
+}
+
 // Create creates a new integration
 func (s *IntegrationService) Create(ctx context.Context, projectID int, req *models.CreateIntegrationRequest, userID int) (*models.Integration, error) {
 	// Check admin permission
@@ -45,6 +69,14 @@ func (s *IntegrationService) Create(ctx context.Context, projectID int, req *mod
 		return nil, pkgerrors.ErrValidation
 	}
 
+	allowed, err := s.isAllowedByOrganization(ctx, projectID, req.Type)
+	if err != nil {
+		return nil, err
+	}
+	if !allowed {
+		return nil, pkgerrors.NewPermissionError("integration type is not allowed by the organization")
+	}
+
 	// Validate events
 	if err := s.validateEvents(req.Events); err != nil {
 		return nil, err
@@ -179,6 +211,10 @@ func (s *IntegrationService) SendEvent(ctx context.Context, projectID int, event
 
 	// Send to each integration asynchronously
 	for _, integration := range integrations {
+		// Skip integrations the organization has disallowed since they were created
+		if allowed, err := s.isAllowedByOrganization(ctx, projectID, integration.Type); err != nil || !allowed {
+			continue
+		}
 		go s.sendToIntegration(context.Background(), integration, eventType, data)
 	}
 
@@ -311,11 +347,17 @@ func (s *IntegrationService) formatGenericMessage(eventType string, data interfa
 func (s *IntegrationService) getEventDetails(eventType string, data interface{}) (title, description, color string) {
 	color = "#36a64f" // Default green
 
+	// Bulk changes are reported in one message for the whole batch
+	if result, ok := data.(*models.BulkIssueResult); ok {
+		return s.getBulkEventDetails(eventType, result)
+	}
+
 	switch eventType {
 	case models.EventIssueCreated:
 		if issue, ok := data.(*models.Issue); ok {
 			title = fmt.Sprintf("New Issue: %s", issue.Title)
 			description = fmt.Sprintf("Issue #%d was created", issue.IssueNumber)
+			description += assigneeLine(issue)
 			if issue.Description != nil && *issue.Description != "" {
 				desc := *issue.Description
 				if len(desc) > 200 {
@@ -330,6 +372,7 @@ func (s *IntegrationService) getEventDetails(eventType string, data interface{})
 		if issue, ok := data.(*models.Issue); ok {
 			title = fmt.Sprintf("Issue Updated: %s", issue.Title)
 			description = fmt.Sprintf("Issue #%d was updated", issue.IssueNumber)
+			description += assigneeLine(issue)
 		}
 		color = "#2196F3" // Blue
 
@@ -340,6 +383,13 @@ func (s *IntegrationService) getEventDetails(eventType string, data interface{})
 		}
 		color = "#f44336" // Red
 
+	case models.EventIssueRestored:
+		if issue, ok := data.(*models.Issue); ok {
+			title = fmt.Sprintf("Issue Restored: %s", issue.Title)
+			description = fmt.Sprintf("Issue #%d was restored from the trash", issue.IssueNumber)
+		}
+		color = "#36a64f" // Green
+
 	case models.EventIssueMoved:
 		if issue, ok := data.(*models.Issue); ok {
 			title = fmt.Sprintf("Issue Moved: %s", issue.Title)
@@ -377,6 +427,56 @@ func (s *IntegrationService) getEventDetails(eventType string, data interface{})
 	return title, description, color
 }
 
+// assigneeLine returns a line naming all assignees of an issue, or "" for
+// unassigned issues
+func assigneeLine(issue *models.Issue) string {
+	if len(issue.Assignees) == 0 {
+		return ""
+	}
+
+	names := make([]string, len(issue.Assignees))
+	for i, user := range issue.Assignees {
+		names[i] = "@" + user.Username
+	}
+	return "\nAssignees: " + strings.Join(names, ", ")
+}
+
+// maxBulkMessageIssues is the number of issues listed in a bulk message
+const maxBulkMessageIssues = 10
+
+// getBulkEventDetails returns title, description, and color for a bulk change
+func (s *IntegrationService) getBulkEventDetails(eventType string, result *models.BulkIssueResult) (title, description, color string) {
+	var numbers []string
+	for _, item := range result.Results {
+		if item.Outcome != models.BulkOutcomeUpdated && item.Outcome != models.BulkOutcomeDeleted {
+			continue
+		}
+		if len(numbers) == maxBulkMessageIssues {
+			numbers = append(numbers, fmt.Sprintf("and %d more", result.Succeeded-maxBulkMessageIssues))
+			break
+		}
+		numbers = append(numbers, fmt.Sprintf("#%d", item.IssueNumber))
+	}
+
+	if eventType == models.EventIssueDeleted {
+		title = fmt.Sprintf("Issues Deleted: %d issues", result.Succeeded)
+		description = fmt.Sprintf("%d of %d issues were moved to the trash", result.Succeeded, result.Total)
+		color = "#f44336" // Red
+	} else {
+		title = fmt.Sprintf("Issues Updated: %d issues", result.Succeeded)
+		description = fmt.Sprintf("%d of %d issues were updated (%s)", result.Succeeded, result.Total, strings.Join(result.Changes, ", "))
+		color = "#2196F3" // Blue
+	}
+	if len(numbers) > 0 {
+		description += "\n" + strings.Join(numbers, ", ")
+	}
+	if failed := result.Conflicts + result.Failed; failed > 0 {
+		description += fmt.Sprintf("\n%d issues could not be changed", failed)
+	}
+
+	return title, description, color
+}
+
 // hexToDecimal converts a hex color string to decimal
 func (s *IntegrationService) hexToDecimal(hex string) int {
 	hex = strings.TrimPrefix(hex, "#")
@@ -0,0 +1,345 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/yourusername/issue-tracker/internal/models"
+	"github.com/yourusername/issue-tracker/internal/repository"
+	pkgerrors "github.com/yourusername/issue-tracker/pkg/errors"
+)
+
+// bulkJobThreshold is the number of issues above which a bulk change runs as
+// a background job instead of within the request
+const bulkJobThreshold = 50
+
+// IssueBulkService handles changing many issues of a project at once
+type IssueBulkService struct {
+	bulkRepo           *repository.IssueBulkRepository
+	issueRepo          *repository.IssueRepository
+	labelRepo          *repository.LabelRepository
+	issueService       *IssueService
+	labelService       *LabelService
+	authService        *AuthorizationService
+	integrationService *IntegrationService
+}
+
+// NewIssueBulkService creates a new issue bulk service
+func NewIssueBulkService(
+	bulkRepo *repository.IssueBulkRepository,
+	issueRepo *repository.IssueRepository,
+	labelRepo *repository.LabelRepository,
+	issueService *IssueService,
+	labelService *LabelService,
+	authService *AuthorizationService,
+	integrationService *IntegrationService,
+) *IssueBulkService {
+	return &IssueBulkService{
+		bulkRepo:           bulkRepo,
+		issueRepo:          issueRepo,
+		labelRepo:          labelRepo,
+		issueService:       issueService,
+		labelService:       labelService,
+		authService:        authService,
+		integrationService: integrationService,
+	}
+}
+
+// Apply applies a bulk change to the selected issues of a project
+// Each issue goes through the same checks as a single change and its
+// outcome is reported separately; webhooks are delivered per issue while
+// integrations get one message for the batch. Changes of more than
+// bulkJobThreshold issues return a job that runs in the background instead
+func (s *IssueBulkService) Apply(ctx context.Context, projectID int, req *models.BulkIssueRequest, userID int) (*models.BulkIssueResult, *models.IssueBulkJob, error) {
+	if err := s.authService.CheckWritePermission(ctx, projectID, userID); err != nil {
+		return nil, nil, err
+	}
+	if req.Delete {
+		if err := s.authService.CheckAdminPermission(ctx, projectID, userID); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	changes, err := s.validate(ctx, projectID, req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	issueIDs, err := s.selectIssues(ctx, projectID, req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := &models.BulkIssueResult{
+		ProjectID: projectID,
+		Changes:   changes,
+		Total:     len(issueIDs),
+		Results:   make([]*models.BulkIssueItemResult, 0, len(issueIDs)),
+	}
+
+	if len(issueIDs) > bulkJobThreshold {
+		job, err := s.bulkRepo.CreateJob(ctx, &models.IssueBulkJob{
+			ProjectID:   projectID,
+			UserID:      userID,
+			TotalIssues: len(issueIDs),
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+
+		go s.runJob(withBulkOperation(context.Background()), job.ID, issueIDs, req, result, userID)
+
+		return nil, job, nil
+	}
+
+	s.apply(withBulkOperation(ctx), issueIDs, req, result, userID, nil)
+	return result, nil, nil
+}
+
+// GetJob retrieves the progress of a bulk job started by the user
+func (s *IssueBulkService) GetJob(ctx context.Context, jobID int, userID int) (*models.IssueBulkJob, error) {
+	return s.bulkRepo.GetJob(ctx, userID, jobID)
+}
+
+// validate checks a bulk request and returns the names of the changed fields
+func (s *IssueBulkService) validate(ctx context.Context, projectID int, req *models.BulkIssueRequest) ([]string, error) {
+	if (len(req.IssueIDs) == 0) == (req.Filter == nil) {
+		return nil, pkgerrors.NewValidationError("either issue_ids or filter is required")
+	}
+	if len(req.IssueIDs) > models.MaxBulkIssues {
+		return nil, pkgerrors.NewValidationError(fmt.Sprintf("at most %d issues can be changed at once", models.MaxBulkIssues))
+	}
+	if req.AssigneeID != nil && req.ClearAssignee {
+		return nil, pkgerrors.NewValidationError("assignee_id and clear_assignee are mutually exclusive")
+	}
+	if req.MilestoneID != nil && req.ClearMilestone {
+		return nil, pkgerrors.NewValidationError("milestone_id and clear_milestone are mutually exclusive")
+	}
+	if req.Status != nil && req.ColumnID != nil {
+		return nil, pkgerrors.NewValidationError("status and column_id are mutually exclusive, the column sets the status")
+	}
+
+	var changes []string
+	if req.Status != nil {
+		changes = append(changes, "status")
+	}
+	if req.Priority != nil {
+		changes = append(changes, "priority")
+	}
+	if req.AssigneeID != nil || req.ClearAssignee {
+		changes = append(changes, "assignee")
+	}
+	if req.MilestoneID != nil || req.ClearMilestone {
+		changes = append(changes, "milestone")
+	}
+	if len(req.AddLabelIDs) > 0 || len(req.RemoveLabelIDs) > 0 {
+		changes = append(changes, "labels")
+	}
+	if req.ColumnID != nil {
+		changes = append(changes, "column")
+	}
+
+	if req.Delete {
+		if len(changes) > 0 {
+			return nil, pkgerrors.NewValidationError("delete cannot be combined with other changes")
+		}
+		return []string{"deleted"}, nil
+	}
+	if len(changes) == 0 {
+		return nil, pkgerrors.NewValidationError("no changes requested")
+	}
+
+	labelIDs := append(append([]int{}, req.AddLabelIDs...), req.RemoveLabelIDs...)
+	for _, labelID := range labelIDs {
+		label, err := s.labelRepo.GetByID(ctx, labelID)
+		if err != nil && err != pkgerrors.ErrNotFound {
+			return nil, err
+		}
+		if err != nil || label.ProjectID != projectID {
+			return nil, pkgerrors.NewValidationError(fmt.Sprintf("label %d is not a label of this project", labelID))
+		}
+	}
+
+	if req.ColumnID != nil {
+		column, err := s.issueService.boardColumn(ctx, *req.ColumnID)
+		if err != nil && err != pkgerrors.ErrNotFound {
+			return nil, err
+		}
+		if err != nil || column.ProjectID != projectID {
+			return nil, pkgerrors.NewValidationError(fmt.Sprintf("column %d is not a board column of this project", *req.ColumnID))
+		}
+	}
+
+	return changes, nil
+}
+
+// selectIssues returns the IDs of the issues to change, without duplicates
+func (s *IssueBulkService) selectIssues(ctx context.Context, projectID int, req *models.BulkIssueRequest) ([]int, error) {
+	if req.Filter == nil {
+		seen := make(map[int]bool, len(req.IssueIDs))
+		issueIDs := make([]int, 0, len(req.IssueIDs))
+		for _, id := range req.IssueIDs {
+			if !seen[id] {
+				seen[id] = true
+				issueIDs = append(issueIDs, id)
+			}
+		}
+		return issueIDs, nil
+	}
+
+	issues, err := s.issueRepo.List(ctx, req.Filter.IssueFilter(projectID))
+	if err != nil {
+		return nil, err
+	}
+	if len(issues) > models.MaxBulkIssues {
+		return nil, pkgerrors.NewValidationError(fmt.Sprintf("the filter matches more than %d issues", models.MaxBulkIssues))
+	}
+
+	issueIDs := make([]int, len(issues))
+	for i, issue := range issues {
+		issueIDs[i] = issue.ID
+	}
+	return issueIDs, nil
+}
+
+// runJob runs a bulk change in the background and records its progress
+func (s *IssueBulkService) runJob(ctx context.Context, jobID int, issueIDs []int, req *models.BulkIssueRequest, result *models.BulkIssueResult, userID int) {
+	if err := s.bulkRepo.StartJob(ctx, jobID); err != nil {
+		slog.Error("failed to start bulk job", "error", err, "job_id", jobID)
+		return
+	}
+
+	progress := func(processed int) {
+		if err := s.bulkRepo.UpdateJobProgress(ctx, jobID, processed); err != nil {
+			slog.Warn("failed to record bulk job progress", "error", err, "job_id", jobID)
+		}
+	}
+
+	s.apply(ctx, issueIDs, req, result, userID, progress)
+
+	if err := s.bulkRepo.CompleteJob(ctx, jobID, result); err != nil {
+		slog.Error("failed to complete bulk job", "error", err, "job_id", jobID)
+	}
+}
+
+// apply changes the issues one by one and sends the aggregated integration
+// message; progress, when set, is called after each issue
+func (s *IssueBulkService) apply(ctx context.Context, issueIDs []int, req *models.BulkIssueRequest, result *models.BulkIssueResult, userID int, progress func(processed int)) {
+	for i, id := range issueIDs {
+		result.Add(s.applyOne(ctx, result.ProjectID, id, req, userID))
+		if progress != nil {
+			progress(i + 1)
+		}
+	}
+
+	slog.Info("bulk issue change applied", "project_id", result.ProjectID, "user_id", userID, "changes", result.Changes,
+		"succeeded", result.Succeeded, "conflicts", result.Conflicts, "failed", result.Failed)
+
+	if s.integrationService != nil && result.Succeeded > 0 {
+		event := models.EventIssueUpdated
+		if req.Delete {
+			event = models.EventIssueDeleted
+		}
+		go s.integrationService.SendEvent(context.Background(), result.ProjectID, event, result)
+	}
+}
+
+// applyOne applies the bulk change to one issue
+// Changes are applied in order column, fields, labels; a failure stops the
+// remaining changes of the issue
+func (s *IssueBulkService) applyOne(ctx context.Context, projectID int, id int, req *models.BulkIssueRequest, userID int) *models.BulkIssueItemResult {
+	item := &models.BulkIssueItemResult{IssueID: id}
+
+	issue, err := s.issueRepo.GetByID(ctx, id)
+	if err == nil && issue.ProjectID != projectID {
+		err = pkgerrors.ErrNotFound
+	}
+	if err != nil {
+		return bulkFailure(item, err)
+	}
+	item.IssueNumber = issue.IssueNumber
+	item.Version = issue.Version
+
+	if version, ok := req.Versions[id]; ok && version != issue.Version {
+		item.Outcome = models.BulkOutcomeConflict
+		item.Error = fmt.Sprintf("the issue was changed since version %d", version)
+		return item
+	}
+
+	if req.Delete {
+		if err := s.issueService.Delete(ctx, id, userID); err != nil {
+			return bulkFailure(item, err)
+		}
+		item.Outcome = models.BulkOutcomeDeleted
+		return item
+	}
+
+	if req.ColumnID != nil {
+		moved, err := s.issueService.MoveToColumn(ctx, id, &models.MoveIssueRequest{
+			ColumnID:   *req.ColumnID,
+			Version:    issue.Version,
+			Resolution: req.Resolution,
+		}, userID)
+		if err != nil {
+			return bulkFailure(item, err)
+		}
+		item.Version = moved.Version
+	}
+
+	if req.Status != nil || req.Priority != nil || req.AssigneeID != nil || req.ClearAssignee || req.MilestoneID != nil || req.ClearMilestone {
+		updated, err := s.issueService.Update(ctx, id, &models.UpdateIssueRequest{
+			Status:         req.Status,
+			Resolution:     req.Resolution,
+			Priority:       req.Priority,
+			AssigneeID:     req.AssigneeID,
+			ClearAssignee:  req.ClearAssignee,
+			MilestoneID:    req.MilestoneID,
+			ClearMilestone: req.ClearMilestone,
+		}, userID)
+		if err != nil {
+			return bulkFailure(item, err)
+		}
+		item.Version = updated.Version
+	}
+
+	for _, labelID := range req.AddLabelIDs {
+		if err := s.labelService.AddToIssue(ctx, id, labelID, userID); err != nil {
+			return bulkFailure(item, err)
+		}
+	}
+	for _, labelID := range req.RemoveLabelIDs {
+		if err := s.labelService.RemoveFromIssue(ctx, id, labelID, userID); err != nil {
+			return bulkFailure(item, err)
+		}
+	}
+
+	item.Outcome = models.BulkOutcomeUpdated
+	return item
+}
+
+// bulkFailure records why a bulk change failed for one issue
+func bulkFailure(item *models.BulkIssueItemResult, err error) *models.BulkIssueItemResult {
+	item.Outcome = models.BulkOutcomeFailed
+
+	if appErr, ok := err.(*pkgerrors.AppError); ok {
+		item.Error = appErr.Message
+		return item
+	}
+
+	switch err {
+	case pkgerrors.ErrNotFound:
+		item.Error = "issue not found in this project"
+	case pkgerrors.ErrConflict:
+		item.Outcome = models.BulkOutcomeConflict
+		item.Error = "the issue was changed concurrently"
+	case pkgerrors.ErrForbidden:
+		item.Error = "permission denied"
+	case pkgerrors.ErrValidation:
+		item.Error = "the change is not valid for this issue"
+	default:
+		slog.Error("bulk issue change failed", "error", err, "issue_id", item.IssueID)
+		item.Error = "internal error"
+	}
+	return item
+}
@@ -0,0 +1,117 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/yourusername/issue-tracker/internal/models"
+	"github.com/yourusername/issue-tracker/internal/repository"
+)
+
+func TestIssueBulkService_Apply(t *testing.T) {
+	issueService, userRepo, projectRepo, _, cleanup := setupIssueService(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	db := issueService.db
+	bulkService := NewIssueBulkService(repository.NewIssueBulkRepository(db), issueService.issueRepo, repository.NewLabelRepository(db), issueService, nil, issueService.authService, nil)
+
+	// Setup
+	owner, _ := userRepo.Create(ctx, &models.User{Email: "issuesvc46@example.com", Username: "issuesvc46", PasswordHash: "hash"})
+	project, _ := projectRepo.Create(ctx, &models.Project{Name: "Bulk", Key: "ISVC46", OwnerID: owner.ID})
+	otherProject, _ := projectRepo.Create(ctx, &models.Project{Name: "Bulk other", Key: "ISVC146", OwnerID: owner.ID})
+
+	create := func(projectID int, title string) *models.Issue {
+		t.Helper()
+		issue, err := issueService.Create(ctx, projectID, &models.CreateIssueRequest{Title: title}, owner.ID)
+		if err != nil {
+			t.Fatalf("Failed to create issue: %v", err)
+		}
+		return issue
+	}
+
+	t.Run("should report the outcome of each issue", func(t *testing.T) {
+		first := create(project.ID, "First")
+		stale := create(project.ID, "Stale")
+		foreign := create(otherProject.ID, "Foreign")
+		last := create(project.ID, "Last")
+
+		edited := "Edited meanwhile"
+		if _, err := issueService.Update(ctx, stale.ID, &models.UpdateIssueRequest{Title: &edited}, owner.ID); err != nil {
+			t.Fatalf("Failed to update issue: %v", err)
+		}
+
+		priority := models.PriorityHigh
+		result, job, err := bulkService.Apply(ctx, project.ID, &models.BulkIssueRequest{
+			IssueIDs: []int{first.ID, stale.ID, foreign.ID, 999999, last.ID, first.ID},
+			Versions: map[int]int{first.ID: first.Version, stale.ID: stale.Version},
+			Priority: &priority,
+		}, owner.ID)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if job != nil {
+			t.Fatalf("Expected a synchronous change, got job %d", job.ID)
+		}
+
+		if result.Total != 5 || result.Succeeded != 2 || result.Conflicts != 1 || result.Failed != 2 {
+			t.Errorf("Expected 5 total, 2 succeeded, 1 conflict and 2 failed, got %d, %d, %d and %d",
+				result.Total, result.Succeeded, result.Conflicts, result.Failed)
+		}
+
+		expected := []struct {
+			issueID int
+			outcome models.BulkIssueOutcome
+			error   string
+		}{
+			{first.ID, models.BulkOutcomeUpdated, ""},
+			{stale.ID, models.BulkOutcomeConflict, fmt.Sprintf("the issue was changed since version %d", stale.Version)},
+			{foreign.ID, models.BulkOutcomeFailed, "issue not found in this project"},
+			{999999, models.BulkOutcomeFailed, "issue not found in this project"},
+			{last.ID, models.BulkOutcomeUpdated, ""},
+		}
+		if len(result.Results) != len(expected) {
+			t.Fatalf("Expected %d results, got %d", len(expected), len(result.Results))
+		}
+		for i, want := range expected {
+			got := result.Results[i]
+			if got.IssueID != want.issueID || got.Outcome != want.outcome || got.Error != want.error {
+				t.Errorf("Expected result %d to be %+v, got %+v", i, want, got)
+			}
+		}
+
+		for _, id := range []int{first.ID, last.ID} {
+			issue, err := issueService.issueRepo.GetByID(ctx, id)
+			if err != nil {
+				t.Fatalf("Failed to get issue: %v", err)
+			}
+			if issue.Priority != priority {
+				t.Errorf("Expected issue %d to have priority %s, got %s", id, priority, issue.Priority)
+			}
+		}
+		for _, id := range []int{stale.ID, foreign.ID} {
+			issue, err := issueService.issueRepo.GetByID(ctx, id)
+			if err != nil {
+				t.Fatalf("Failed to get issue: %v", err)
+			}
+			if issue.Priority == priority {
+				t.Errorf("Expected issue %d to keep its priority", id)
+			}
+		}
+	})
+
+	t.Run("should refuse a label of another project for the whole batch", func(t *testing.T) {
+		issue := create(project.ID, "Unlabelled")
+		label, err := repository.NewLabelRepository(db).Create(ctx, &models.Label{ProjectID: otherProject.ID, Name: "foreign", Color: "#ffffff"})
+		if err != nil {
+			t.Fatalf("Failed to create label: %v", err)
+		}
+
+		_, _, err = bulkService.Apply(ctx, project.ID, &models.BulkIssueRequest{
+			IssueIDs:    []int{issue.ID},
+			AddLabelIDs: []int{label.ID},
+		}, owner.ID)
+		expectAppError(t, err, 400)
+	})
+}
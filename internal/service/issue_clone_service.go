@@ -0,0 +1,368 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/yourusername/issue-tracker/internal/models"
+	"github.com/yourusername/issue-tracker/internal/repository"
+	pkgcache "github.com/yourusername/issue-tracker/pkg/cache"
+	pkgerrors "github.com/yourusername/issue-tracker/pkg/errors"
+	"github.com/yourusername/issue-tracker/pkg/storage"
+)
+
+// cloneJobThreshold is the number of issues above which a clone runs as a
+// background job instead of within the request
+const cloneJobThreshold = 20
+
+// IssueCloneService handles cloning issues with their subtasks or epic
+// children
+type IssueCloneService struct {
+	cloneRepo       *repository.IssueCloneRepository
+	issueRepo       *repository.IssueRepository
+	projectRepo     *repository.ProjectRepository
+	attachmentRepo  *repository.AttachmentRepository
+	watcherRepo     *repository.IssueWatcherRepository
+	issueService    *IssueService
+	authService     *AuthorizationService
+	activityService *ActivityService
+	storage         storage.Storage
+	cache           pkgcache.Cache
+	webhookService  *WebhookService
+}
+
+// NewIssueCloneService creates a new issue clone service
+func NewIssueCloneService(
+	cloneRepo *repository.IssueCloneRepository,
+	issueRepo *repository.IssueRepository,
+	projectRepo *repository.ProjectRepository,
+	attachmentRepo *repository.AttachmentRepository,
+	watcherRepo *repository.IssueWatcherRepository,
+	issueService *IssueService,
+	authService *AuthorizationService,
+	activityService *ActivityService,
+	storage storage.Storage,
+	cache pkgcache.Cache,
+	webhookService *WebhookService,
+) *IssueCloneService {
+	return &IssueCloneService{
+		cloneRepo:       cloneRepo,
+		issueRepo:       issueRepo,
+		projectRepo:     projectRepo,
+		attachmentRepo:  attachmentRepo,
+		watcherRepo:     watcherRepo,
+		issueService:    issueService,
+		authService:     authService,
+		activityService: activityService,
+		storage:         storage,
+		cache:           cache,
+		webhookService:  webhookService,
+	}
+}
+
+// cloneKeys holds the issue keys of the sources, in clone order, and the
+// key of the target project, used to log clones
+type cloneKeys struct {
+	sources []string
+	target  string
+}
+
+// Clone clones an issue into the same or another project
+// Small clones return the cloned issue; clones of more than cloneJobThreshold
+// issues return a job that runs in the background instead
+func (s *IssueCloneService) Clone(ctx context.Context, id int, req *models.CloneIssueRequest, userID int) (*models.Issue, *models.IssueCloneJob, error) {
+	source, err := s.issueRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := s.authService.CheckProjectAccess(ctx, source.ProjectID, userID); err != nil {
+		return nil, nil, err
+	}
+
+	target := source.ProjectID
+	if req.TargetProjectID != nil {
+		target = *req.TargetProjectID
+	}
+	if err := s.authService.CheckWritePermission(ctx, target, userID); err != nil {
+		return nil, nil, err
+	}
+	if source.ParentIssueID != nil && target != source.ProjectID {
+		return nil, nil, pkgerrors.NewValidationError("subtasks can only be cloned within their project, clone the parent instead")
+	}
+	if req.Title != nil && strings.TrimSpace(*req.Title) == "" {
+		return nil, nil, pkgerrors.NewValidationError("title must not be empty")
+	}
+
+	sourceProject, err := s.projectRepo.GetByID(ctx, source.ProjectID)
+	if err != nil {
+		return nil, nil, err
+	}
+	targetProject, err := s.projectRepo.GetByID(ctx, target)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sources, err := s.collectSources(ctx, source, req)
+	if err != nil {
+		return nil, nil, err
+	}
+	cloned := make(map[int]bool, len(sources))
+	for _, issue := range sources {
+		cloned[issue.ID] = true
+	}
+	clones := make([]*models.IssueClone, len(sources))
+	keys := cloneKeys{sources: make([]string, len(sources)), target: targetProject.Key}
+	for i, issue := range sources {
+		planned, err := s.planClone(ctx, issue, target, cloned, userID)
+		if err != nil {
+			return nil, nil, err
+		}
+		clones[i] = &models.IssueClone{SourceID: issue.ID, Issue: planned}
+		keys.sources[i] = fmt.Sprintf("%s-%d", sourceProject.Key, issue.IssueNumber)
+	}
+	if req.Title != nil {
+		clones[0].Issue.Title = strings.TrimSpace(*req.Title)
+	}
+
+	if len(clones) > cloneJobThreshold {
+		job, err := s.cloneRepo.CreateJob(ctx, &models.IssueCloneJob{
+			SourceIssueID:   &source.ID,
+			TargetProjectID: target,
+			UserID:          userID,
+			TotalIssues:     len(clones),
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+
+		go s.runJob(context.Background(), job.ID, clones, req, keys, userID)
+
+		return nil, job, nil
+	}
+
+	if err := s.clone(ctx, clones, req, keys, userID, nil); err != nil {
+		return nil, nil, err
+	}
+
+	created, err := s.issueRepo.GetByID(ctx, clones[0].Issue.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := s.issueService.attachDetails(ctx, created); err != nil {
+		return nil, nil, err
+	}
+	return created, nil, nil
+}
+
+// GetJob retrieves the progress of a clone job started by the user
+func (s *IssueCloneService) GetJob(ctx context.Context, jobID int, userID int) (*models.IssueCloneJob, error) {
+	return s.cloneRepo.GetJob(ctx, userID, jobID)
+}
+
+// collectSources returns the issues to clone, the source issue first and
+// every parent and epic before the issues that point to it
+func (s *IssueCloneService) collectSources(ctx context.Context, source *models.Issue, req *models.CloneIssueRequest) ([]*models.Issue, error) {
+	issues := []*models.Issue{source}
+	if req.IncludeEpicChildren && source.IssueType == models.IssueTypeEpic {
+		children, err := s.issueRepo.GetEpicIssues(ctx, source.ID)
+		if err != nil {
+			return nil, err
+		}
+		issues = append(issues, children...)
+	}
+	if !req.IncludeSubtasks {
+		return issues, nil
+	}
+
+	withSubtasks := make([]*models.Issue, 0, len(issues))
+	for _, issue := range issues {
+		withSubtasks = append(withSubtasks, issue)
+		if issue.IssueType == models.IssueTypeSubtask {
+			continue
+		}
+		subtasks, err := s.issueRepo.GetSubtasks(ctx, issue.ID)
+		if err != nil {
+			return nil, err
+		}
+		withSubtasks = append(withSubtasks, subtasks...)
+	}
+	return withSubtasks, nil
+}
+
+// planClone prepares the copy of an issue in the target project
+// Clones start in the initial status of their workflow; milestone, epic and
+// team are kept within the same project, cloned parents and epics are
+// remapped to their clones on insert
+func (s *IssueCloneService) planClone(ctx context.Context, issue *models.Issue, target int, cloned map[int]bool, userID int) (*models.Issue, error) {
+	workflow, err := s.issueService.workflowFor(ctx, target, issue.IssueType)
+	if err != nil {
+		return nil, err
+	}
+	initial := workflow.InitialStatus()
+
+	clone := &models.Issue{
+		ProjectID:        target,
+		Title:            issue.Title,
+		Description:      issue.Description,
+		Status:           initial.Key,
+		StatusCategory:   initial.Category,
+		Priority:         issue.Priority,
+		IssueType:        issue.IssueType,
+		ParentIssueID:    issue.ParentIssueID,
+		EpicID:           issue.EpicID,
+		AssigneeID:       issue.AssigneeID,
+		AssigneeTeamID:   issue.AssigneeTeamID,
+		ReporterID:       userID,
+		MilestoneID:      issue.MilestoneID,
+		StoryPoints:      issue.StoryPoints,
+		EstimateMinutes:  issue.EstimateMinutes,
+		RemainingMinutes: issue.EstimateMinutes,
+		StartDate:        issue.StartDate,
+		DueDate:          issue.DueDate,
+	}
+
+	if target != issue.ProjectID {
+		clone.MilestoneID = nil
+		if clone.EpicID != nil && !cloned[*clone.EpicID] {
+			clone.EpicID = nil // Epics don't span projects
+		}
+		if clone.AssigneeTeamID != nil {
+			ok, err := s.issueService.teamInProjectOrganization(ctx, target, *clone.AssigneeTeamID)
+			if err != nil {
+				return nil, err
+			}
+			if !ok {
+				clone.AssigneeTeamID = nil // Team is not part of the target project's organization
+			}
+		}
+	}
+
+	if column, err := s.issueService.columnForStatus(ctx, target, clone.Status); err != nil {
+		return nil, err
+	} else if column != nil {
+		clone.ColumnID = &column.ID
+	}
+
+	return clone, nil
+}
+
+// runJob runs a clone in the background and records its progress
+func (s *IssueCloneService) runJob(ctx context.Context, jobID int, clones []*models.IssueClone, req *models.CloneIssueRequest, keys cloneKeys, userID int) {
+	if err := s.cloneRepo.StartJob(ctx, jobID); err != nil {
+		slog.Error("failed to start clone job", "error", err, "job_id", jobID)
+		return
+	}
+
+	progress := func(cloned int) {
+		if err := s.cloneRepo.UpdateJobProgress(ctx, jobID, cloned); err != nil {
+			slog.Warn("failed to record clone job progress", "error", err, "job_id", jobID)
+		}
+	}
+
+	if err := s.clone(ctx, clones, req, keys, userID, progress); err != nil {
+		slog.Error("clone job failed", "error", err, "job_id", jobID)
+		if failErr := s.cloneRepo.FailJob(ctx, jobID, "failed to clone issues, no issues were created"); failErr != nil {
+			slog.Error("failed to mark clone job as failed", "error", failErr, "job_id", jobID)
+		}
+		return
+	}
+
+	if err := s.cloneRepo.CompleteJob(ctx, jobID, clones[0].Issue.ID); err != nil {
+		slog.Error("failed to complete clone job", "error", err, "job_id", jobID)
+	}
+}
+
+// clone copies the attachment files and creates the clones in one
+// transaction; copied files are removed again when the clone fails
+func (s *IssueCloneService) clone(ctx context.Context, clones []*models.IssueClone, req *models.CloneIssueRequest, keys cloneKeys, userID int, progress func(cloned int)) error {
+	if req.IncludeAttachments {
+		if err := s.copyAttachments(ctx, clones); err != nil {
+			s.deleteAttachmentCopies(clones)
+			return err
+		}
+	}
+
+	if err := s.cloneRepo.CloneIssues(ctx, clones, req, userID, progress); err != nil {
+		s.deleteAttachmentCopies(clones)
+		return err
+	}
+
+	root := clones[0].Issue
+	_ = s.watcherRepo.Watch(ctx, userID, root.ID)
+	_ = pkgcache.InvalidateAllProjectCaches(ctx, s.cache, root.ProjectID)
+
+	for i, clone := range clones {
+		s.logClone(ctx, clone.Issue, keys.sources[i], fmt.Sprintf("%s-%d", keys.target, clone.Issue.IssueNumber), userID)
+
+		// Deliver webhook event (use background context since this runs async)
+		if s.webhookService != nil {
+			go s.webhookService.DeliverEvent(context.Background(), clone.Issue.ProjectID, models.EventIssueCreated, userID, clone.Issue)
+		}
+	}
+
+	slog.Info("issue cloned", "issue_id", clones[0].SourceID, "clone_id", root.ID, "project_id", root.ProjectID, "issues", len(clones))
+	return nil
+}
+
+// copyAttachments stores a copy of every attachment file of the source
+// issues; missing files are skipped so one lost upload does not fail the clone
+func (s *IssueCloneService) copyAttachments(ctx context.Context, clones []*models.IssueClone) error {
+	for _, clone := range clones {
+		attachments, err := s.attachmentRepo.ListByIssueID(ctx, clone.SourceID)
+		if err != nil {
+			return err
+		}
+
+		for _, attachment := range attachments {
+			file, err := s.storage.Get(attachment.StorageKey)
+			if err != nil {
+				slog.Warn("skipping missing attachment file in clone", "error", err, "attachment_id", attachment.ID)
+				continue
+			}
+			_, storageKey, err := s.storage.Save(file, attachment.OriginalFilename)
+			file.Close()
+			if err != nil {
+				return pkgerrors.NewInternalError("failed to copy attachment", err)
+			}
+
+			duplicate := *attachment
+			duplicate.StorageKey = storageKey
+			clone.Attachments = append(clone.Attachments, &duplicate)
+		}
+	}
+	return nil
+}
+
+// deleteAttachmentCopies removes the copied files of a failed clone
+func (s *IssueCloneService) deleteAttachmentCopies(clones []*models.IssueClone) {
+	for _, clone := range clones {
+		for _, attachment := range clone.Attachments {
+			if err := s.storage.Delete(attachment.StorageKey); err != nil {
+				slog.Warn("failed to delete attachment copy of failed clone", "error", err, "storage_key", attachment.StorageKey)
+			}
+		}
+		clone.Attachments = nil
+	}
+}
+
+// logClone records the source key of a clone in its activity log
+func (s *IssueCloneService) logClone(ctx context.Context, issue *models.Issue, sourceKey string, key string, userID int) {
+	if s.activityService == nil {
+		return
+	}
+
+	projectID, issueID := issue.ProjectID, issue.ID
+	_, _ = s.activityService.LogActivity(ctx, &models.CreateActivityRequest{
+		ProjectID:  &projectID,
+		IssueID:    &issueID,
+		UserID:     userID,
+		Action:     "cloned",
+		EntityType: string(models.EntityTypeIssue),
+		EntityID:   &issueID,
+		FieldName:  strPtr("key"),
+		OldValue:   &sourceKey,
+		NewValue:   &key,
+	})
+}
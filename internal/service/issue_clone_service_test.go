@@ -0,0 +1,116 @@
+package service
+
+import (
+	"context"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/yourusername/issue-tracker/internal/models"
+	"github.com/yourusername/issue-tracker/internal/repository"
+	"github.com/yourusername/issue-tracker/pkg/storage"
+)
+
+func TestIssueCloneService_Clone(t *testing.T) {
+	issueService, userRepo, projectRepo, _, cleanup := setupIssueService(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	db := issueService.db
+	storageDir := t.TempDir()
+	localStorage, err := storage.NewLocalStorage(storageDir)
+	if err != nil {
+		t.Fatalf("Failed to create storage: %v", err)
+	}
+	attachmentRepo := repository.NewAttachmentRepository(db)
+	cloneService := NewIssueCloneService(repository.NewIssueCloneRepository(db), issueService.issueRepo, projectRepo, attachmentRepo,
+		repository.NewIssueWatcherRepository(db), issueService, issueService.authService, nil, localStorage, nil, nil)
+
+	// Setup
+	owner, _ := userRepo.Create(ctx, &models.User{Email: "issuesvc45@example.com", Username: "issuesvc45", PasswordHash: "hash"})
+	project, _ := projectRepo.Create(ctx, &models.Project{Name: "Clone", Key: "ISVC45", OwnerID: owner.ID})
+
+	parent, err := issueService.Create(ctx, project.ID, &models.CreateIssueRequest{Title: "Release checklist"}, owner.ID)
+	if err != nil {
+		t.Fatalf("Failed to create parent: %v", err)
+	}
+	subtask, err := issueService.Create(ctx, project.ID, &models.CreateIssueRequest{Title: "Tag the release", ParentIssueID: &parent.ID}, owner.ID)
+	if err != nil {
+		t.Fatalf("Failed to create subtask: %v", err)
+	}
+
+	_, storageKey, err := localStorage.Save(strings.NewReader("notes"), "notes.txt")
+	if err != nil {
+		t.Fatalf("Failed to save file: %v", err)
+	}
+	if _, err := attachmentRepo.Create(ctx, &models.Attachment{IssueID: parent.ID, UserID: owner.ID, StorageKey: storageKey, OriginalFilename: "notes.txt", FileSize: 5, ContentType: "text/plain"}); err != nil {
+		t.Fatalf("Failed to create attachment: %v", err)
+	}
+
+	countIssues := func() int {
+		t.Helper()
+		var count int
+		if err := db.QueryRowContext(ctx, "SELECT COUNT(*) FROM issues WHERE project_id = $1", project.ID).Scan(&count); err != nil {
+			t.Fatalf("Failed to count issues: %v", err)
+		}
+		return count
+	}
+	countFiles := func() int {
+		t.Helper()
+		entries, err := os.ReadDir(storageDir)
+		if err != nil {
+			t.Fatalf("Failed to read storage: %v", err)
+		}
+		return len(entries)
+	}
+
+	t.Run("should roll back a clone that fails partway", func(t *testing.T) {
+		req := &models.CloneIssueRequest{IncludeSubtasks: true, IncludeAttachments: true, LinkToSource: true}
+		clones := make([]*models.IssueClone, 0, 2)
+		for _, source := range []*models.Issue{parent, subtask} {
+			planned, err := cloneService.planClone(ctx, source, project.ID, map[int]bool{parent.ID: true, subtask.ID: true}, owner.ID)
+			if err != nil {
+				t.Fatalf("Failed to plan clone: %v", err)
+			}
+			clones = append(clones, &models.IssueClone{SourceID: source.ID, Issue: planned})
+		}
+		missingUser := 999999
+		clones[1].Issue.AssigneeID = &missingUser // Fails the insert of the second clone
+
+		keys := cloneKeys{sources: []string{"ISVC45-1", "ISVC45-2"}, target: project.Key}
+		if err := cloneService.clone(ctx, clones, req, keys, owner.ID, nil); err == nil {
+			t.Fatal("Expected the clone to fail")
+		}
+
+		if count := countIssues(); count != 2 {
+			t.Errorf("Expected the first clone to be rolled back, got %d issues", count)
+		}
+		if count := countFiles(); count != 1 {
+			t.Errorf("Expected the attachment copies to be removed, got %d files", count)
+		}
+		if clones[0].Attachments != nil {
+			t.Errorf("Expected no attachment copies to be kept, got %d", len(clones[0].Attachments))
+		}
+	})
+
+	t.Run("should clone the issue with its subtasks and attachments", func(t *testing.T) {
+		cloned, job, err := cloneService.Clone(ctx, parent.ID, &models.CloneIssueRequest{IncludeSubtasks: true, IncludeAttachments: true}, owner.ID)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if job != nil {
+			t.Fatalf("Expected a synchronous clone, got job %d", job.ID)
+		}
+
+		// The failed clone did not use up issue numbers
+		if cloned.IssueNumber != 3 {
+			t.Errorf("Expected issue number 3, got %d", cloned.IssueNumber)
+		}
+		if count := countIssues(); count != 4 {
+			t.Errorf("Expected 4 issues, got %d", count)
+		}
+		if count := countFiles(); count != 2 {
+			t.Errorf("Expected the attachment to be copied, got %d files", count)
+		}
+	})
+}
@@ -0,0 +1,190 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/yourusername/issue-tracker/internal/models"
+	"github.com/yourusername/issue-tracker/internal/repository"
+	pkgerrors "github.com/yourusername/issue-tracker/pkg/errors"
+)
+
+// IssueLinkService handles typed issue link business logic
+type IssueLinkService struct {
+	linkRepo     *repository.IssueLinkRepository
+	issueRepo    *repository.IssueRepository
+	watcherRepo  *repository.IssueWatcherRepository
+	issueService *IssueService
+	authService  *AuthorizationService
+}
+
+// NewIssueLinkService creates a new issue link service
+func NewIssueLinkService(
+	linkRepo *repository.IssueLinkRepository,
+	issueRepo *repository.IssueRepository,
+	watcherRepo *repository.IssueWatcherRepository,
+	issueService *IssueService,
+	authService *AuthorizationService,
+) *IssueLinkService {
+	return &IssueLinkService{
+		linkRepo:     linkRepo,
+		issueRepo:    issueRepo,
+		watcherRepo:  watcherRepo,
+		issueService: issueService,
+		authService:  authService,
+	}
+}
+
+// Create links an issue to another one
+// Marking an issue as a duplicate closes it with the duplicate resolution
+// and copies its watchers to the canonical issue
+func (s *IssueLinkService) Create(ctx context.Context, issueID int, req *models.CreateIssueLinkRequest, userID int) (*models.IssueLink, error) {
+	linkType, inward, ok := models.ParseIssueLinkRelation(req.Relation)
+	if !ok {
+		return nil, pkgerrors.NewValidationError("Invalid relation")
+	}
+	if req.TargetIssueID == issueID {
+		return nil, pkgerrors.NewValidationError("An issue cannot be linked to itself")
+	}
+
+	issue, err := s.issueRepo.GetByID(ctx, issueID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.authService.CheckWritePermission(ctx, issue.ProjectID, userID); err != nil {
+		return nil, err
+	}
+
+	other, err := s.issueRepo.GetByID(ctx, req.TargetIssueID)
+	if err != nil {
+		if err == pkgerrors.ErrNotFound {
+			return nil, pkgerrors.NewNotFoundError("Target issue not found")
+		}
+		return nil, err
+	}
+	if err := s.authService.CheckProjectAccess(ctx, other.ProjectID, userID); err != nil {
+		return nil, err
+	}
+
+	// Links are stored once, in the outward direction; relates links are
+	// symmetric and always stored from the lower issue ID
+	source, target := issue, other
+	if inward || (linkType == models.LinkTypeRelates && other.ID < issue.ID) {
+		source, target = other, issue
+	}
+
+	// The duplicate is closed, so its project must be writable too
+	if linkType == models.LinkTypeDuplicates && source.ProjectID != issue.ProjectID {
+		if err := s.authService.CheckWritePermission(ctx, source.ProjectID, userID); err != nil {
+			return nil, err
+		}
+	}
+
+	if linkType == models.LinkTypeBlocks {
+		cycle, err := s.linkRepo.BlockingPathExists(ctx, target.ID, source.ID)
+		if err != nil {
+			return nil, err
+		}
+		if cycle {
+			return nil, pkgerrors.NewDependencyCycleError("Link would create a blocking cycle")
+		}
+	}
+
+	created, err := s.linkRepo.Create(ctx, &models.IssueLink{
+		SourceIssueID: source.ID,
+		TargetIssueID: target.ID,
+		LinkType:      linkType,
+		CreatedBy:     &userID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if linkType == models.LinkTypeDuplicates {
+		// A duplicate link only exists with its issue closed, so the link is
+		// removed again when the issue can't be closed
+		if _, err := s.issueService.CloseAsDuplicate(ctx, source, userID); err != nil {
+			if deleteErr := s.linkRepo.Delete(ctx, created.ID); deleteErr != nil {
+				slog.Error("failed to remove duplicate link", "error", deleteErr, "link_id", created.ID)
+			}
+			return nil, err
+		}
+		s.copyWatchers(ctx, source.ID, target.ID)
+	}
+
+	created.Direction = models.LinkDirectionOutward
+	if created.TargetIssueID == issueID && linkType != models.LinkTypeRelates {
+		created.Direction = models.LinkDirectionInward
+	}
+	created.Relation = models.IssueLinkRelation(linkType, created.Direction)
+	created.Issue = other
+
+	slog.Info("issue link created", "id", created.ID, "source_issue_id", source.ID, "target_issue_id", target.ID, "type", linkType)
+	return created, nil
+}
+
+// copyWatchers makes the watchers of a duplicate watch the canonical issue
+func (s *IssueLinkService) copyWatchers(ctx context.Context, fromIssueID, toIssueID int) {
+	userIDs, err := s.watcherRepo.GetWatcherUserIDs(ctx, fromIssueID)
+	if err != nil {
+		slog.Error("failed to list watchers of duplicate issue", "error", err, "issue_id", fromIssueID)
+		return
+	}
+
+	for _, watcherID := range userIDs {
+		if err := s.watcherRepo.Watch(ctx, watcherID, toIssueID); err != nil {
+			slog.Error("failed to copy watcher", "error", err, "user_id", watcherID, "issue_id", toIssueID)
+		}
+	}
+}
+
+// ListByIssueID lists the links of an issue
+func (s *IssueLinkService) ListByIssueID(ctx context.Context, issueID int, userID int) ([]*models.IssueLink, error) {
+	issue, err := s.issueRepo.GetByID(ctx, issueID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.authService.CheckProjectAccess(ctx, issue.ProjectID, userID); err != nil {
+		return nil, err
+	}
+
+	return s.linkRepo.ListByIssueID(ctx, issueID)
+}
+
+// Delete removes a link; write permission on either linked issue is enough
+// Removing a duplicates link doesn't reopen the duplicate
+func (s *IssueLinkService) Delete(ctx context.Context, id int, userID int) error {
+	link, err := s.linkRepo.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if err := s.checkWriteEither(ctx, link, userID); err != nil {
+		return err
+	}
+
+	if err := s.linkRepo.Delete(ctx, id); err != nil {
+		return err
+	}
+
+	slog.Info("issue link deleted", "id", id, "user_id", userID)
+	return nil
+}
+
+// checkWriteEither checks that the user can write to the project of either
+// linked issue
+func (s *IssueLinkService) checkWriteEither(ctx context.Context, link *models.IssueLink, userID int) error {
+	var lastErr error
+	for _, issueID := range []int{link.SourceIssueID, link.TargetIssueID} {
+		issue, err := s.issueRepo.GetByID(ctx, issueID)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		if lastErr = s.authService.CheckWritePermission(ctx, issue.ProjectID, userID); lastErr == nil {
+			return nil
+		}
+	}
+	return lastErr
+}
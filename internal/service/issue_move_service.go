@@ -0,0 +1,266 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/yourusername/issue-tracker/internal/models"
+	"github.com/yourusername/issue-tracker/internal/repository"
+	pkgcache "github.com/yourusername/issue-tracker/pkg/cache"
+	pkgerrors "github.com/yourusername/issue-tracker/pkg/errors"
+)
+
+// IssueMoveService handles moving issues between projects
+type IssueMoveService struct {
+	issueRepo       *repository.IssueRepository
+	projectRepo     *repository.ProjectRepository
+	labelRepo       *repository.LabelRepository
+	milestoneRepo   *repository.MilestoneRepository
+	issueService    *IssueService
+	authService     *AuthorizationService
+	activityService *ActivityService
+	cache           pkgcache.Cache
+	webhookService  *WebhookService
+}
+
+// NewIssueMoveService creates a new issue move service
+func NewIssueMoveService(
+	issueRepo *repository.IssueRepository,
+	projectRepo *repository.ProjectRepository,
+	labelRepo *repository.LabelRepository,
+	milestoneRepo *repository.MilestoneRepository,
+	issueService *IssueService,
+	authService *AuthorizationService,
+	activityService *ActivityService,
+	cache pkgcache.Cache,
+	webhookService *WebhookService,
+) *IssueMoveService {
+	return &IssueMoveService{
+		issueRepo:       issueRepo,
+		projectRepo:     projectRepo,
+		labelRepo:       labelRepo,
+		milestoneRepo:   milestoneRepo,
+		issueService:    issueService,
+		authService:     authService,
+		activityService: activityService,
+		cache:           cache,
+		webhookService:  webhookService,
+	}
+}
+
+// MoveToProject moves an issue and its subtasks to another project
+// Comments, attachments, reactions, watchers, links and worklogs stay with
+// the issue; the old key keeps resolving to it
+func (s *IssueMoveService) MoveToProject(ctx context.Context, id int, req *models.MoveIssueProjectRequest, userID int) (*models.Issue, error) {
+	issue, err := s.issueRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	source := issue.ProjectID
+
+	if err := s.authService.CheckWritePermission(ctx, source, userID); err != nil {
+		return nil, err
+	}
+	if req.TargetProjectID == source {
+		return nil, pkgerrors.NewValidationError("the issue is already in this project")
+	}
+	if err := s.authService.CheckWritePermission(ctx, req.TargetProjectID, userID); err != nil {
+		return nil, err
+	}
+	if issue.ParentIssueID != nil {
+		return nil, pkgerrors.NewValidationError("subtasks move with their parent issue, move the parent instead")
+	}
+
+	sourceProject, err := s.projectRepo.GetByID(ctx, source)
+	if err != nil {
+		return nil, err
+	}
+	targetProject, err := s.projectRepo.GetByID(ctx, req.TargetProjectID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.checkMapping(ctx, req); err != nil {
+		return nil, err
+	}
+
+	subtasks, err := s.issueRepo.GetSubtasks(ctx, issue.ID)
+	if err != nil {
+		return nil, err
+	}
+	issues := append([]*models.Issue{issue}, subtasks...)
+	oldKeys := make([]string, len(issues))
+	for i, moved := range issues {
+		oldKeys[i] = fmt.Sprintf("%s-%d", sourceProject.Key, moved.IssueNumber)
+		if err := s.planMove(ctx, moved, req); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.issueRepo.MoveToProject(ctx, issues, req.TargetProjectID, req.LabelMapping); err != nil {
+		return nil, err
+	}
+
+	for i, moved := range issues {
+		s.logMove(ctx, moved, oldKeys[i], fmt.Sprintf("%s-%d", targetProject.Key, moved.IssueNumber), userID)
+	}
+
+	_ = pkgcache.InvalidateAllProjectCaches(ctx, s.cache, source)
+	_ = pkgcache.InvalidateAllProjectCaches(ctx, s.cache, req.TargetProjectID)
+
+	updated, err := s.issueRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.issueService.attachDetails(ctx, updated); err != nil {
+		return nil, err
+	}
+
+	// Deliver webhook events to both projects (use background context since this runs async)
+	if s.webhookService != nil {
+		go s.webhookService.DeliverEvent(context.Background(), source, models.EventIssueUpdated, userID, updated)
+		go s.webhookService.DeliverEvent(context.Background(), req.TargetProjectID, models.EventIssueUpdated, userID, updated)
+	}
+
+	slog.Info("issue moved to another project", "issue_id", id, "from", oldKeys[0], "to_project_id", req.TargetProjectID, "subtasks", len(subtasks))
+	return updated, nil
+}
+
+// checkMapping checks that mapped labels, milestones and columns belong to
+// the target project
+func (s *IssueMoveService) checkMapping(ctx context.Context, req *models.MoveIssueProjectRequest) error {
+	for _, labelID := range req.LabelMapping {
+		label, err := s.labelRepo.GetByID(ctx, labelID)
+		if err != nil && err != pkgerrors.ErrNotFound {
+			return err
+		}
+		if err != nil || label.ProjectID != req.TargetProjectID {
+			return pkgerrors.NewValidationError(fmt.Sprintf("label %d is not a label of the target project", labelID))
+		}
+	}
+
+	for _, milestoneID := range req.MilestoneMapping {
+		milestone, err := s.milestoneRepo.GetByID(ctx, milestoneID)
+		if err != nil && err != pkgerrors.ErrNotFound {
+			return err
+		}
+		if err != nil || milestone.ProjectID != req.TargetProjectID {
+			return pkgerrors.NewValidationError(fmt.Sprintf("milestone %d is not a milestone of the target project", milestoneID))
+		}
+	}
+
+	for _, columnID := range req.ColumnMapping {
+		column, err := s.issueService.boardColumn(ctx, columnID)
+		if err != nil && err != pkgerrors.ErrNotFound {
+			return err
+		}
+		if err != nil || column.ProjectID != req.TargetProjectID {
+			return pkgerrors.NewValidationError(fmt.Sprintf("column %d is not a board column of the target project", columnID))
+		}
+	}
+
+	return nil
+}
+
+// planMove sets the status, column, milestone, epic and team an issue gets in
+// the target project
+// The status is kept when the target workflow has it, otherwise the first
+// status of the same category is used, falling back to the initial status
+func (s *IssueMoveService) planMove(ctx context.Context, issue *models.Issue, req *models.MoveIssueProjectRequest) error {
+	target := req.TargetProjectID
+
+	workflow, err := s.issueService.workflowFor(ctx, target, issue.IssueType)
+	if err != nil {
+		return err
+	}
+	if workflow.Status(issue.Status) == nil {
+		status := workflow.InitialStatus()
+		for _, candidate := range workflow.Statuses {
+			if candidate.Category == issue.StatusCategory {
+				status = candidate
+				break
+			}
+		}
+		issue.Status = status.Key
+		issue.StatusCategory = status.Category
+	}
+
+	var column *models.BoardColumn
+	if issue.ColumnID != nil {
+		if columnID, ok := req.ColumnMapping[*issue.ColumnID]; ok {
+			if column, err = s.issueService.boardColumn(ctx, columnID); err != nil {
+				return err
+			}
+		}
+	}
+	issue.ProjectID = target // Column statuses are checked against the target workflow
+	if column != nil {
+		status, err := s.issueService.statusForColumn(ctx, issue, column)
+		if err != nil {
+			return err
+		}
+		if status != nil {
+			workflowStatus := workflow.Status(*status)
+			issue.Status = workflowStatus.Key
+			issue.StatusCategory = workflowStatus.Category
+		}
+	} else if column, err = s.issueService.columnForStatus(ctx, target, issue.Status); err != nil {
+		return err
+	}
+	issue.ColumnID = nil
+	if column != nil {
+		issue.ColumnID = &column.ID
+	}
+	if issue.StatusCategory != models.StatusCategoryDone {
+		issue.Resolution = nil
+	}
+
+	if issue.MilestoneID != nil {
+		if milestoneID, ok := req.MilestoneMapping[*issue.MilestoneID]; ok {
+			issue.MilestoneID = &milestoneID
+		} else {
+			issue.MilestoneID = nil
+		}
+	}
+
+	// Epics don't span projects
+	if issue.EpicID != nil {
+		if epic, err := s.issueRepo.GetByID(ctx, *issue.EpicID); err != nil || epic.ProjectID != target {
+			issue.EpicID = nil
+		}
+	}
+
+	if issue.AssigneeTeamID != nil {
+		ok, err := s.issueService.teamInProjectOrganization(ctx, target, *issue.AssigneeTeamID)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			issue.AssigneeTeamID = nil // Team is not part of the target project's organization
+		}
+	}
+
+	return nil
+}
+
+// logMove records the move with the old and new key in the activity log of
+// the target project
+func (s *IssueMoveService) logMove(ctx context.Context, issue *models.Issue, oldKey string, newKey string, userID int) {
+	if s.activityService == nil {
+		return
+	}
+
+	projectID, issueID := issue.ProjectID, issue.ID
+	_, _ = s.activityService.LogActivity(ctx, &models.CreateActivityRequest{
+		ProjectID:  &projectID,
+		IssueID:    &issueID,
+		UserID:     userID,
+		Action:     "moved_project",
+		EntityType: string(models.EntityTypeIssue),
+		EntityID:   &issueID,
+		FieldName:  strPtr("key"),
+		OldValue:   &oldKey,
+		NewValue:   &newKey,
+	})
+}
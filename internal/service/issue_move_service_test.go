@@ -0,0 +1,149 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/yourusername/issue-tracker/internal/models"
+	"github.com/yourusername/issue-tracker/internal/repository"
+)
+
+func TestIssueMoveService_MoveToProject(t *testing.T) {
+	issueService, userRepo, projectRepo, boardRepo, cleanup := setupIssueService(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	db := issueService.db
+	issueService.SetBoardRepository(boardRepo)
+	labelRepo := repository.NewLabelRepository(db)
+	milestoneRepo := repository.NewMilestoneRepository(db)
+	moveService := NewIssueMoveService(issueService.issueRepo, projectRepo, labelRepo, milestoneRepo, issueService, issueService.authService, nil, nil, nil)
+
+	// Setup
+	owner, _ := userRepo.Create(ctx, &models.User{Email: "issuesvc43@example.com", Username: "issuesvc43", PasswordHash: "hash"})
+	member, _ := userRepo.Create(ctx, &models.User{Email: "issuesvc44@example.com", Username: "issuesvc44", PasswordHash: "hash"})
+	outsider, _ := userRepo.Create(ctx, &models.User{Email: "issuesvc42@example.com", Username: "issuesvc42", PasswordHash: "hash"})
+	source, _ := projectRepo.Create(ctx, &models.Project{Name: "Move source", Key: "ISVC43", OwnerID: owner.ID})
+	target, _ := projectRepo.Create(ctx, &models.Project{Name: "Move target", Key: "ISVC44", OwnerID: owner.ID})
+	db.ExecContext(ctx, "INSERT INTO project_members (project_id, user_id, role) VALUES ($1, $2, $3)", source.ID, member.ID, models.RoleMember)
+	db.ExecContext(ctx, "INSERT INTO project_members (project_id, user_id, role) VALUES ($1, $2, $3)", target.ID, member.ID, models.RoleViewer)
+	db.ExecContext(ctx, "INSERT INTO project_members (project_id, user_id, role) VALUES ($1, $2, $3)", target.ID, outsider.ID, models.RoleMember)
+
+	for _, project := range []*models.Project{source, target} {
+		if err := boardRepo.CreateDefaultColumns(ctx, project.ID); err != nil {
+			t.Fatalf("Failed to create default columns: %v", err)
+		}
+	}
+	sourceColumns, err := boardRepo.ListByProjectID(ctx, source.ID)
+	if err != nil || len(sourceColumns) == 0 {
+		t.Fatalf("Failed to list source columns: %v", err)
+	}
+	targetColumns, err := boardRepo.ListByProjectID(ctx, target.ID)
+	if err != nil || len(targetColumns) == 0 {
+		t.Fatalf("Failed to list target columns: %v", err)
+	}
+
+	sourceLabel, err := labelRepo.Create(ctx, &models.Label{ProjectID: source.ID, Name: "bug", Color: "#ff0000"})
+	if err != nil {
+		t.Fatalf("Failed to create label: %v", err)
+	}
+	targetLabel, err := labelRepo.Create(ctx, &models.Label{ProjectID: target.ID, Name: "defect", Color: "#ff0000"})
+	if err != nil {
+		t.Fatalf("Failed to create label: %v", err)
+	}
+	sourceMilestone, err := milestoneRepo.Create(ctx, &models.Milestone{ProjectID: source.ID, Title: "v1", Status: models.MilestoneStatusOpen})
+	if err != nil {
+		t.Fatalf("Failed to create milestone: %v", err)
+	}
+	targetMilestone, err := milestoneRepo.Create(ctx, &models.Milestone{ProjectID: target.ID, Title: "v2", Status: models.MilestoneStatusOpen})
+	if err != nil {
+		t.Fatalf("Failed to create milestone: %v", err)
+	}
+
+	issue, err := issueService.Create(ctx, source.ID, &models.CreateIssueRequest{
+		Title:       "Wrong project",
+		MilestoneID: &sourceMilestone.ID,
+	}, owner.ID)
+	if err != nil {
+		t.Fatalf("Failed to create issue: %v", err)
+	}
+	if err := labelRepo.AddToIssue(ctx, issue.ID, sourceLabel.ID); err != nil {
+		t.Fatalf("Failed to label issue: %v", err)
+	}
+
+	t.Run("should require write permission on both projects", func(t *testing.T) {
+		tests := []struct {
+			name   string
+			userID int
+		}{
+			{"viewer of the target project", member.ID},
+			{"not a member of the source project", outsider.ID},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				_, err := moveService.MoveToProject(ctx, issue.ID, &models.MoveIssueProjectRequest{TargetProjectID: target.ID}, tt.userID)
+				expectAppError(t, err, 403)
+			})
+		}
+	})
+
+	t.Run("should refuse mappings outside the target project", func(t *testing.T) {
+		tests := []struct {
+			name string
+			req  *models.MoveIssueProjectRequest
+		}{
+			{"label of the source project", &models.MoveIssueProjectRequest{LabelMapping: map[int]int{sourceLabel.ID: sourceLabel.ID}}},
+			{"unknown label", &models.MoveIssueProjectRequest{LabelMapping: map[int]int{sourceLabel.ID: 999999}}},
+			{"milestone of the source project", &models.MoveIssueProjectRequest{MilestoneMapping: map[int]int{sourceMilestone.ID: sourceMilestone.ID}}},
+			{"unknown milestone", &models.MoveIssueProjectRequest{MilestoneMapping: map[int]int{sourceMilestone.ID: 999999}}},
+			{"column of the source project", &models.MoveIssueProjectRequest{ColumnMapping: map[int]int{sourceColumns[0].ID: sourceColumns[1].ID}}},
+			{"unknown column", &models.MoveIssueProjectRequest{ColumnMapping: map[int]int{sourceColumns[0].ID: 999999}}},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				tt.req.TargetProjectID = target.ID
+				_, err := moveService.MoveToProject(ctx, issue.ID, tt.req, owner.ID)
+				expectAppError(t, err, 400)
+			})
+		}
+
+		unmoved, err := issueService.issueRepo.GetByID(ctx, issue.ID)
+		if err != nil {
+			t.Fatalf("Failed to get issue: %v", err)
+		}
+		if unmoved.ProjectID != source.ID {
+			t.Errorf("Expected the issue to stay in project %d, got %d", source.ID, unmoved.ProjectID)
+		}
+	})
+
+	t.Run("should apply the mappings", func(t *testing.T) {
+		moved, err := moveService.MoveToProject(ctx, issue.ID, &models.MoveIssueProjectRequest{
+			TargetProjectID:  target.ID,
+			LabelMapping:     map[int]int{sourceLabel.ID: targetLabel.ID},
+			MilestoneMapping: map[int]int{sourceMilestone.ID: targetMilestone.ID},
+		}, owner.ID)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		if moved.ProjectID != target.ID {
+			t.Errorf("Expected project %d, got %d", target.ID, moved.ProjectID)
+		}
+		if moved.MilestoneID == nil || *moved.MilestoneID != targetMilestone.ID {
+			t.Errorf("Expected milestone %d, got %v", targetMilestone.ID, moved.MilestoneID)
+		}
+		if moved.ColumnID == nil || *moved.ColumnID != targetColumns[0].ID {
+			t.Errorf("Expected column %d, got %v", targetColumns[0].ID, moved.ColumnID)
+		}
+
+		labels, err := labelRepo.ListByIssueID(ctx, issue.ID)
+		if err != nil {
+			t.Fatalf("Failed to list labels: %v", err)
+		}
+		if len(labels) != 1 || labels[0].ID != targetLabel.ID {
+			t.Errorf("Expected label %d, got %v", targetLabel.ID, labels)
+		}
+	})
+}
@@ -0,0 +1,116 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/yourusername/issue-tracker/internal/models"
+	"github.com/yourusername/issue-tracker/internal/repository"
+	pkgcache "github.com/yourusername/issue-tracker/pkg/cache"
+	pkgerrors "github.com/yourusername/issue-tracker/pkg/errors"
+)
+
+// IssuePinService handles pinning issues to the top of their project's issue
+// list
+type IssuePinService struct {
+	issueRepo       *repository.IssueRepository
+	issueService    *IssueService
+	authService     *AuthorizationService
+	activityService *ActivityService
+	cache           pkgcache.Cache
+}
+
+// NewIssuePinService creates a new issue pin service
+func NewIssuePinService(
+	issueRepo *repository.IssueRepository,
+	issueService *IssueService,
+	authService *AuthorizationService,
+	activityService *ActivityService,
+	cache pkgcache.Cache,
+) *IssuePinService {
+	return &IssuePinService{
+		issueRepo:       issueRepo,
+		issueService:    issueService,
+		authService:     authService,
+		activityService: activityService,
+		cache:           cache,
+	}
+}
+
+// Pin pins an issue; pinning a pinned issue changes nothing
+// A project can have at most models.MaxPinnedIssues pinned issues
+func (s *IssuePinService) Pin(ctx context.Context, id int, userID int) (*models.Issue, error) {
+	issue, err := s.issueRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.authService.CheckWritePermission(ctx, issue.ProjectID, userID); err != nil {
+		return nil, err
+	}
+	if issue.IsPinned {
+		return s.withDetails(ctx, issue)
+	}
+
+	if err := s.issueRepo.Pin(ctx, issue, userID, models.MaxPinnedIssues); err != nil {
+		if err == pkgerrors.ErrConflict {
+			return nil, pkgerrors.NewValidationError(fmt.Sprintf("a project can have at most %d pinned issues, unpin one first", models.MaxPinnedIssues))
+		}
+		return nil, err
+	}
+
+	s.logPin(ctx, issue, "pinned", userID)
+	_ = pkgcache.InvalidateAllProjectCaches(ctx, s.cache, issue.ProjectID)
+
+	slog.Info("issue pinned", "issue_id", id, "project_id", issue.ProjectID, "user_id", userID)
+	return s.withDetails(ctx, issue)
+}
+
+// Unpin unpins an issue; unpinning an issue that isn't pinned changes nothing
+func (s *IssuePinService) Unpin(ctx context.Context, id int, userID int) (*models.Issue, error) {
+	issue, err := s.issueRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.authService.CheckWritePermission(ctx, issue.ProjectID, userID); err != nil {
+		return nil, err
+	}
+	if !issue.IsPinned {
+		return s.withDetails(ctx, issue)
+	}
+
+	if err := s.issueRepo.Unpin(ctx, issue); err != nil {
+		return nil, err
+	}
+
+	s.logPin(ctx, issue, "unpinned", userID)
+	_ = pkgcache.InvalidateAllProjectCaches(ctx, s.cache, issue.ProjectID)
+
+	slog.Info("issue unpinned", "issue_id", id, "project_id", issue.ProjectID, "user_id", userID)
+	return s.withDetails(ctx, issue)
+}
+
+// withDetails returns an issue with its assignees and custom field values
+func (s *IssuePinService) withDetails(ctx context.Context, issue *models.Issue) (*models.Issue, error) {
+	if err := s.issueService.attachDetails(ctx, issue); err != nil {
+		return nil, err
+	}
+	return issue, nil
+}
+
+// logPin records pinning or unpinning in the activity log of the project
+func (s *IssuePinService) logPin(ctx context.Context, issue *models.Issue, action string, userID int) {
+	if s.activityService == nil {
+		return
+	}
+
+	projectID, issueID := issue.ProjectID, issue.ID
+	_, _ = s.activityService.LogActivity(ctx, &models.CreateActivityRequest{
+		ProjectID:  &projectID,
+		IssueID:    &issueID,
+		UserID:     userID,
+		Action:     action,
+		EntityType: string(models.EntityTypeIssue),
+		EntityID:   &issueID,
+	})
+}
@@ -0,0 +1,82 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/yourusername/issue-tracker/internal/models"
+)
+
+func TestIssuePinService_Pin(t *testing.T) {
+	issueService, userRepo, projectRepo, _, cleanup := setupIssueService(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	db := issueService.db
+	pinService := NewIssuePinService(issueService.issueRepo, issueService, issueService.authService, nil, nil)
+
+	// Setup
+	owner, _ := userRepo.Create(ctx, &models.User{Email: "issuesvc49@example.com", Username: "issuesvc49", PasswordHash: "hash"})
+	viewer, _ := userRepo.Create(ctx, &models.User{Email: "issuesvc50@example.com", Username: "issuesvc50", PasswordHash: "hash"})
+	project, _ := projectRepo.Create(ctx, &models.Project{Name: "Pins", Key: "ISVC49", OwnerID: owner.ID})
+	db.ExecContext(ctx, "INSERT INTO project_members (project_id, user_id, role) VALUES ($1, $2, $3)", project.ID, viewer.ID, models.RoleViewer)
+
+	issues := make([]*models.Issue, models.MaxPinnedIssues+1)
+	for i := range issues {
+		issue, err := issueService.Create(ctx, project.ID, &models.CreateIssueRequest{Title: "Known outage"}, owner.ID)
+		if err != nil {
+			t.Fatalf("Failed to create issue: %v", err)
+		}
+		issues[i] = issue
+	}
+
+	t.Run("should refuse viewers", func(t *testing.T) {
+		_, err := pinService.Pin(ctx, issues[0].ID, viewer.ID)
+		expectAppError(t, err, 403)
+	})
+
+	t.Run("should pin up to the per-project cap", func(t *testing.T) {
+		for _, issue := range issues[:models.MaxPinnedIssues] {
+			pinned, err := pinService.Pin(ctx, issue.ID, owner.ID)
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if !pinned.IsPinned || pinned.PinnedByUserID == nil || *pinned.PinnedByUserID != owner.ID {
+				t.Errorf("Expected issue %d to be pinned by %d, got %+v", issue.ID, owner.ID, pinned)
+			}
+		}
+
+		_, err := pinService.Pin(ctx, issues[models.MaxPinnedIssues].ID, owner.ID)
+		expectAppError(t, err, 400)
+	})
+
+	t.Run("should treat pinning a pinned issue as a no-op at the cap", func(t *testing.T) {
+		if _, err := pinService.Pin(ctx, issues[0].ID, owner.ID); err != nil {
+			t.Errorf("Expected no error, got %v", err)
+		}
+	})
+
+	t.Run("should free a slot when an issue is unpinned", func(t *testing.T) {
+		unpinned, err := pinService.Unpin(ctx, issues[0].ID, owner.ID)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if unpinned.IsPinned {
+			t.Error("Expected the issue to be unpinned")
+		}
+
+		if _, err := pinService.Pin(ctx, issues[models.MaxPinnedIssues].ID, owner.ID); err != nil {
+			t.Errorf("Expected no error, got %v", err)
+		}
+	})
+
+	t.Run("should free a slot when a pinned issue is trashed", func(t *testing.T) {
+		if err := issueService.Delete(ctx, issues[1].ID, owner.ID); err != nil {
+			t.Fatalf("Failed to trash issue: %v", err)
+		}
+
+		if _, err := pinService.Pin(ctx, issues[0].ID, owner.ID); err != nil {
+			t.Errorf("Expected no error, got %v", err)
+		}
+	})
+}
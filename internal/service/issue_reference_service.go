@@ -7,12 +7,14 @@ import (
 
 	"github.com/yourusername/issue-tracker/internal/models"
 	"github.com/yourusername/issue-tracker/internal/repository"
+	pkgerrors "github.com/yourusername/issue-tracker/pkg/errors"
 )
 
 // IssueReferenceService handles issue reference business logic
 type IssueReferenceService struct {
 	referenceRepo *repository.IssueReferenceRepository
 	issueRepo     *repository.IssueRepository
+	projectRepo   *repository.ProjectRepository
 	db            *sql.DB
 }
 
@@ -29,12 +31,22 @@ func NewIssueReferenceService(
 	}
 }
 
-// ProcessReferences parses text for #issue_number references and creates reference records
-// Only creates references to issues within the same project
+// SetProjectRepository sets the project repository (optional, for PROJ-123 references)
+// Without it only #issue_number references are recognized
+func (s *IssueReferenceService) SetProjectRepository(projectRepo *repository.ProjectRepository) {
+	s.projectRepo = projectRepo
+}
+
+// ProcessReferences parses text for #issue_number and PROJ-123 references and creates reference records
+// Only creates references to issues within the same project; PROJ-123
+// references may use the project's current or any former key
 // Returns the list of referenced issue IDs
 func (s *IssueReferenceService) ProcessReferences(ctx context.Context, text string, sourceType string, sourceID int, projectID int) ([]int, error) {
 	// Parse issue numbers from text
-	issueNumbers := models.ParseIssueReferences(text)
+	issueNumbers, err := s.parseIssueNumbers(ctx, text, projectID)
+	if err != nil {
+		return nil, err
+	}
 	if len(issueNumbers) == 0 {
 		return nil, nil
 	}
@@ -47,7 +59,7 @@ func (s *IssueReferenceService) ProcessReferences(ctx context.Context, text stri
 		// Find issue by project_id and issue_number
 		issue, err := s.issueRepo.GetByProjectAndNumber(ctx, projectID, issueNumber)
 		if err != nil {
-			if err == sql.ErrNoRows {
+			if err == sql.ErrNoRows || err == pkgerrors.ErrNotFound {
 				// Issue doesn't exist in this project, skip
 				continue
 			}
@@ -81,6 +93,43 @@ func (s *IssueReferenceService) ProcessReferences(ctx context.Context, text stri
 	return referencedIssueIDs, nil
 }
 
+// parseIssueNumbers returns the unique issue numbers referenced in text as
+// #123 or with one of the project's keys as PROJ-123
+func (s *IssueReferenceService) parseIssueNumbers(ctx context.Context, text string, projectID int) ([]int, error) {
+	issueNumbers := models.ParseIssueReferences(text)
+
+	keyReferences := models.ParseIssueKeyReferences(text)
+	if len(keyReferences) == 0 || s.projectRepo == nil {
+		return issueNumbers, nil
+	}
+
+	project, err := s.projectRepo.GetByID(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	aliases, err := s.projectRepo.ListKeyAliases(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	keys := map[string]bool{project.Key: true}
+	for _, alias := range aliases {
+		keys[alias] = true
+	}
+
+	seen := make(map[int]bool, len(issueNumbers))
+	for _, issueNumber := range issueNumbers {
+		seen[issueNumber] = true
+	}
+	for _, reference := range keyReferences {
+		if keys[reference.ProjectKey] && !seen[reference.IssueNumber] {
+			seen[reference.IssueNumber] = true
+			issueNumbers = append(issueNumbers, reference.IssueNumber)
+		}
+	}
+
+	return issueNumbers, nil
+}
+
 // GetReferencesFromSource retrieves all references made by a source (issue or comment)
 func (s *IssueReferenceService) GetReferencesFromSource(ctx context.Context, sourceType string, sourceID int) ([]*models.IssueReference, error) {
 	return s.referenceRepo.GetBySource(ctx, sourceType, sourceID)
@@ -3,6 +3,12 @@ package service
 import (
 	"context"
 	"database/sql"
+	"fmt"
+	"log"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/yourusername/issue-tracker/internal/models"
 	"github.com/yourusername/issue-tracker/internal/repository"
@@ -23,6 +29,12 @@ type IssueService struct {
 	referenceService   *IssueReferenceService
 	webhookService     *WebhookService
 	integrationService *IntegrationService
+	workflowService    *WorkflowService
+	boardRepo          *repository.BoardRepository
+	projectRepo        *repository.ProjectRepository
+	linkRepo           *repository.IssueLinkRepository
+	customFieldService *CustomFieldService
+	revisionService    *RevisionService
 }
 
 // NewIssueService creates a new issue service
@@ -41,6 +53,149 @@ func NewIssueService(issueRepo *repository.IssueRepository, watcherRepo *reposit
 	}
 }
 
+// SetWorkflowService sets the workflow service (optional, for custom workflows)
+// Without it issues use the built-in default workflow
+func (s *IssueService) SetWorkflowService(workflowService *WorkflowService) {
+	s.workflowService = workflowService
+}
+
+// SetBoardRepository sets the board repository (optional, for column status mapping)
+// Without it moving a card doesn't change the issue status and status
+// changes don't move cards
+func (s *IssueService) SetBoardRepository(boardRepo *repository.BoardRepository) {
+	s.boardRepo = boardRepo
+}
+
+// SetProjectRepository sets the project repository (optional, for estimation settings)
+// Without it story points are only checked to be non-negative
+func (s *IssueService) SetProjectRepository(projectRepo *repository.ProjectRepository) {
+	s.projectRepo = projectRepo
+}
+
+// SetIssueLinkRepository sets the issue link repository (optional, for typed links)
+// Without it issues are returned without links and closing an issue
+// doesn't check for open blockers
+func (s *IssueService) SetIssueLinkRepository(linkRepo *repository.IssueLinkRepository) {
+	s.linkRepo = linkRepo
+}
+
+// SetCustomFieldService sets the custom field service (optional, for custom fields)
+// Without it custom field values are neither validated nor returned
+func (s *IssueService) SetCustomFieldService(customFieldService *CustomFieldService) {
+	s.customFieldService = customFieldService
+}
+
+// SetRevisionService sets the revision service (optional, for description history)
+// Without it overwritten descriptions are not kept
+func (s *IssueService) SetRevisionService(revisionService *RevisionService) {
+	s.revisionService = revisionService
+}
+
+// bulkOperationKey marks contexts of bulk changes, which send one aggregated
+// integration message instead of one per issue
+type bulkOperationKey struct{}
+
+// withBulkOperation returns a context whose issue changes send no
+// integration messages of their own
+func withBulkOperation(ctx context.Context) context.Context {
+	return context.WithValue(ctx, bulkOperationKey{}, true)
+}
+
+// isBulkOperation reports whether ctx belongs to a bulk change
+func isBulkOperation(ctx context.Context) bool {
+	bulk, _ := ctx.Value(bulkOperationKey{}).(bool)
+	return bulk
+}
+
+// attachDetails sets the assignees of issues and, when custom fields are
+// enabled, their custom field values
+func (s *IssueService) attachDetails(ctx context.Context, issues ...*models.Issue) error {
+	if len(issues) == 0 {
+		return nil
+	}
+	if err := s.attachAssignees(ctx, issues...); err != nil {
+		return err
+	}
+	return s.attachCustomFields(ctx, issues...)
+}
+
+// attachAssignees sets all assignees of issues, the primary one first
+func (s *IssueService) attachAssignees(ctx context.Context, issues ...*models.Issue) error {
+	issueIDs := make([]int, len(issues))
+	for i, issue := range issues {
+		issueIDs[i] = issue.ID
+	}
+
+	assignees, err := s.issueRepo.ListAssigneesByIssueIDs(ctx, issueIDs)
+	if err != nil {
+		return err
+	}
+
+	for _, issue := range issues {
+		issue.Assignees = assignees[issue.ID]
+		issue.AssigneeIDs = make([]int, len(issue.Assignees))
+		for i, user := range issue.Assignees {
+			issue.AssigneeIDs[i] = user.ID
+		}
+	}
+	return nil
+}
+
+// attachCustomFields sets the custom field values of issues when custom
+// fields are enabled
+func (s *IssueService) attachCustomFields(ctx context.Context, issues ...*models.Issue) error {
+	if s.customFieldService == nil || len(issues) == 0 {
+		return nil
+	}
+	return s.customFieldService.AttachValues(ctx, issues...)
+}
+
+// blockerWarning returns a warning when an issue that has just been closed
+// is still blocked by unfinished issues
+func (s *IssueService) blockerWarning(ctx context.Context, issue *models.Issue, previous *models.Issue) *string {
+	if s.linkRepo == nil || issue.StatusCategory != models.StatusCategoryDone || previous.StatusCategory == models.StatusCategoryDone {
+		return nil
+	}
+
+	blockers, err := s.linkRepo.ListOpenBlockers(ctx, issue.ID)
+	if err != nil {
+		log.Printf("WARNING: Failed to check blockers of issue %d: %v", issue.ID, err)
+		return nil
+	}
+	if len(blockers) == 0 {
+		return nil
+	}
+
+	warning := fmt.Sprintf("Issue was closed while still blocked by %s", strings.Join(blockers, ", "))
+	return &warning
+}
+
+// workflowFor returns the workflow of an issue type in a project
+func (s *IssueService) workflowFor(ctx context.Context, projectID int, issueType models.IssueType) (*models.Workflow, error) {
+	if s.workflowService == nil {
+		return models.DefaultWorkflow(projectID), nil
+	}
+	return s.workflowService.WorkflowFor(ctx, projectID, issueType)
+}
+
+// applyStatus moves an issue to a status, enforcing the workflow transitions
+func (s *IssueService) applyStatus(ctx context.Context, issue *models.Issue, status models.IssueStatus, userID int) error {
+	if s.workflowService != nil {
+		return s.workflowService.ApplyTransition(ctx, issue, status, userID)
+	}
+
+	target := models.DefaultWorkflow(issue.ProjectID).Status(status)
+	if target == nil {
+		return pkgerrors.ErrValidation
+	}
+	issue.Status = target.Key
+	issue.StatusCategory = target.Category
+	if target.Category != models.StatusCategoryDone {
+		issue.Resolution = nil
+	}
+	return nil
+}
+
 // Create creates a new issue
 func (s *IssueService) Create(ctx context.Context, projectID int, req *models.CreateIssueRequest, userID int) (*models.Issue, error) {
 	// Check if user has write permission (blocks viewers)
@@ -64,19 +219,69 @@ func (s *IssueService) Create(ctx context.Context, projectID int, req *models.Cr
 		return nil, err
 	}
 
+	assigneeID, assigneeIDs := assigneeSet(req.AssigneeID, req.AssigneeIDs)
+	if err := s.validateAssignees(ctx, assigneeIDs); err != nil {
+		return nil, err
+	}
+	if err := s.validateAssigneeTeam(ctx, projectID, req.AssigneeTeamID); err != nil {
+		return nil, err
+	}
+	if err := s.validateEstimate(ctx, projectID, req.StoryPoints, req.EstimateMinutes); err != nil {
+		return nil, err
+	}
+
+	// New issues start in the initial status of their workflow
+	workflow, err := s.workflowFor(ctx, projectID, issueType)
+	if err != nil {
+		return nil, err
+	}
+	initial := workflow.InitialStatus()
+
 	issue := &models.Issue{
-		ProjectID:     projectID,
-		Title:         req.Title,
-		Description:   req.Description,
-		Status:        models.IssueStatusOpen,
-		Priority:      priority,
-		IssueType:     issueType,
-		ParentIssueID: req.ParentIssueID,
-		EpicID:        req.EpicID,
-		AssigneeID:    req.AssigneeID,
-		ReporterID:    userID,
-		ColumnID:      req.ColumnID,
-		MilestoneID:   req.MilestoneID,
+		ProjectID:       projectID,
+		Title:           req.Title,
+		Description:     req.Description,
+		Status:          initial.Key,
+		StatusCategory:  initial.Category,
+		Priority:        priority,
+		IssueType:       issueType,
+		ParentIssueID:   req.ParentIssueID,
+		EpicID:          req.EpicID,
+		AssigneeID:      assigneeID,
+		AssigneeTeamID:  req.AssigneeTeamID,
+		ReporterID:      userID,
+		ColumnID:        req.ColumnID,
+		MilestoneID:     req.MilestoneID,
+		StoryPoints:     req.StoryPoints,
+		EstimateMinutes: req.EstimateMinutes,
+		StartDate:       dateOnly(req.StartDate),
+		DueDate:         dateOnly(req.DueDate),
+	}
+	if err := validateDates(issue); err != nil {
+		return nil, err
+	}
+
+	var customFields customFieldChanges
+	if s.customFieldService != nil {
+		if customFields, err = s.customFieldService.PrepareValues(ctx, issue, req.CustomFields); err != nil {
+			return nil, err
+		}
+	} else if len(req.CustomFields) > 0 {
+		return nil, pkgerrors.NewValidationError("custom fields are not enabled")
+	}
+
+	// Without an explicit column, new issues go to the column of their status
+	if issue.ColumnID == nil {
+		if column, err := s.columnForStatus(ctx, projectID, issue.Status); err != nil {
+			return nil, err
+		} else if column != nil {
+			issue.ColumnID = &column.ID
+		}
+	}
+
+	wip, err := s.checkWIPLimit(ctx, issue, nil, req.WIPOverrideReason, userID)
+	if err != nil {
+		return nil, err
 	}
 
 	// Render markdown description to HTML
@@ -85,14 +290,26 @@ func (s *IssueService) Create(ctx context.Context, projectID int, req *models.Cr
 		issue.DescriptionHTML = &html
 	}
 
-	created, err := s.issueRepo.Create(ctx, issue)
+	// The primary assignee is added with the issue
+	if len(assigneeIDs) <= 1 {
+		assigneeIDs = nil
+	}
+	created, err := s.issueRepo.CreateWithDetails(ctx, issue, assigneeIDs, customFields.values())
 	if err != nil {
 		return nil, err
 	}
+	s.recordWIPOverride(ctx, wip, created.ID)
+
+	if len(customFields) > 0 {
+		s.customFieldService.LogChanges(ctx, created, customFields, userID)
+	}
+	if err := s.attachDetails(ctx, created); err != nil {
+		return nil, err
+	}
 
 	// Process @mentions in description
 	if req.Description != nil && *req.Description != "" {
-		mentionedUserIDs, err := s.mentionService.ProcessMentions(ctx, *req.Description, "issue", created.ID, userID)
+		mentionedUserIDs, err := s.mentionService.ProcessMentions(ctx, *req.Description, "issue", created.ID, projectID, userID)
 		if err != nil {
 			// Log error but don't fail the whole operation
 			// TODO: Add proper logging
@@ -104,6 +321,9 @@ func (s *IssueService) Create(ctx context.Context, projectID int, req *models.Cr
 		// Process #issue_number references
 		_, _ = s.referenceService.ProcessReferences(ctx, *req.Description, "issue", created.ID, projectID)
 	}
+	if s.revisionService != nil && req.Description != nil && *req.Description != "" {
+		s.revisionService.Record(ctx, models.RevisionEntityIssue, created.ID, nil, *req.Description, userID)
+	}
 
 	// Automatically make the creator watch this issue
 	_ = s.watcherRepo.Watch(ctx, userID, created.ID)
@@ -121,6 +341,7 @@ func (s *IssueService) Create(ctx context.Context, projectID int, req *models.Cr
 		go s.integrationService.SendEvent(context.Background(), projectID, models.EventIssueCreated, created)
 	}
 
+	created.WIPWarning = wip.warning
 	return created, nil
 }
 
@@ -142,6 +363,16 @@ func (s *IssueService) GetByID(ctx context.Context, id int, userID int) (*models
 		return nil, pkgerrors.ErrForbidden
 	}
 
+	if s.linkRepo != nil {
+		issue.Links, err = s.linkRepo.ListByIssueID(ctx, issue.ID)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if err := s.attachDetails(ctx, issue); err != nil {
+		return nil, err
+	}
+
 	return issue, nil
 }
 
@@ -157,14 +388,35 @@ func (s *IssueService) GetByNumber(ctx context.Context, projectID int, issueNumb
 		return nil, pkgerrors.ErrForbidden
 	}
 
-	return s.issueRepo.GetByProjectAndNumber(ctx, projectID, issueNumber)
+	issue, err := s.issueRepo.GetByProjectAndNumber(ctx, projectID, issueNumber)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.attachDetails(ctx, issue); err != nil {
+		return nil, err
+	}
+
+	return issue, nil
 }
 
 // GetByProjectKey retrieves an issue by project key and issue number
+// Former project keys and keys of issues moved to another project resolve
+// to the issue as well
 func (s *IssueService) GetByProjectKey(ctx context.Context, projectKey string, issueNumber int, userID int) (*models.Issue, error) {
-	// Get project by key
+	// Get project by current or former key; keys are unique per organization,
+	// so prefer the project the user can access, then current keys, then
+	// personal projects
 	var projectID int
-	err := s.db.QueryRowContext(ctx, "SELECT id FROM projects WHERE key = $1", projectKey).Scan(&projectID)
+	err := s.db.QueryRowContext(ctx, `
+		SELECT p.id
+		FROM projects p
+		WHERE p.key = $1
+		   OR EXISTS (SELECT 1 FROM project_key_aliases a WHERE a.project_id = p.id AND a.key = $1)
+		ORDER BY EXISTS (
+			SELECT 1 FROM project_access pa WHERE pa.project_id = p.id AND pa.user_id = $2
+		) DESC, p.key = $1 DESC, p.organization_id NULLS FIRST, p.id
+		LIMIT 1
+	`, projectKey, userID).Scan(&projectID)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return nil, pkgerrors.ErrNotFound
@@ -182,7 +434,42 @@ func (s *IssueService) GetByProjectKey(ctx context.Context, projectKey string, i
 		return nil, pkgerrors.ErrForbidden
 	}
 
-	return s.issueRepo.GetByProjectAndNumber(ctx, projectID, issueNumber)
+	issue, err := s.issueRepo.GetByProjectAndNumber(ctx, projectID, issueNumber)
+	if err == pkgerrors.ErrNotFound {
+		issue, err = s.getMovedIssue(ctx, projectID, issueNumber, userID)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := s.attachDetails(ctx, issue); err != nil {
+		return nil, err
+	}
+
+	return issue, nil
+}
+
+// getMovedIssue retrieves an issue that was moved away from a project key,
+// checking access to the project it is in now
+func (s *IssueService) getMovedIssue(ctx context.Context, projectID int, issueNumber int, userID int) (*models.Issue, error) {
+	issueID, err := s.issueRepo.GetRedirectedIssueID(ctx, projectID, issueNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	issue, err := s.issueRepo.GetByID(ctx, issueID)
+	if err != nil {
+		return nil, err
+	}
+
+	hasAccess, err := s.userHasAccess(ctx, userID, issue.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+	if !hasAccess {
+		return nil, pkgerrors.ErrForbidden
+	}
+
+	return issue, nil
 }
 
 // List retrieves issues with filtering
@@ -197,7 +484,15 @@ func (s *IssueService) List(ctx context.Context, filter *models.IssueFilter, use
 		return nil, pkgerrors.ErrForbidden
 	}
 
-	return s.issueRepo.List(ctx, filter)
+	issues, err := s.issueRepo.List(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.attachDetails(ctx, issues...); err != nil {
+		return nil, err
+	}
+
+	return issues, nil
 }
 
 // Update updates an issue
@@ -212,6 +507,7 @@ func (s *IssueService) Update(ctx context.Context, id int, req *models.UpdateIss
 	if err := s.authService.CheckWritePermission(ctx, issue.ProjectID, userID); err != nil {
 		return nil, err
 	}
+	previous := *issue
 
 	// Update fields
 	if req.Title != nil {
@@ -227,9 +523,6 @@ func (s *IssueService) Update(ctx context.Context, id int, req *models.UpdateIss
 			issue.DescriptionHTML = nil
 		}
 	}
-	if req.Status != nil {
-		issue.Status = *req.Status
-	}
 	if req.Priority != nil {
 		issue.Priority = *req.Priority
 	}
@@ -247,19 +540,133 @@ func (s *IssueService) Update(ctx context.Context, id int, req *models.UpdateIss
 		}
 		issue.EpicID = req.EpicID
 	}
-	if req.AssigneeID != nil {
+	var assigneeIDs []int // Replaces all assignees unless nil
+	if req.ClearAssignee {
+		assigneeIDs = []int{}
+	}
+	if req.AssigneeIDs != nil {
+		assigneeIDs = req.AssigneeIDs
+	}
+	if assigneeIDs == nil && req.AssigneeID != nil {
+		// Only the primary assignee is replaced
+		if err := s.validateAssignee(ctx, req.AssigneeID); err != nil {
+			return nil, err
+		}
 		issue.AssigneeID = req.AssigneeID
+	} else if assigneeIDs != nil {
+		primary := req.AssigneeID
+		if primary == nil && issue.AssigneeID != nil && slices.Contains(assigneeIDs, *issue.AssigneeID) {
+			primary = issue.AssigneeID
+		}
+		issue.AssigneeID, assigneeIDs = assigneeSet(primary, assigneeIDs)
+		if err := s.validateAssignees(ctx, assigneeIDs); err != nil {
+			return nil, err
+		}
+	}
+	if req.ClearAssigneeTeam {
+		issue.AssigneeTeamID = nil
+	}
+	if req.AssigneeTeamID != nil {
+		if err := s.validateAssigneeTeam(ctx, issue.ProjectID, req.AssigneeTeamID); err != nil {
+			return nil, err
+		}
+		issue.AssigneeTeamID = req.AssigneeTeamID
+	}
+	if req.ClearMilestone {
+		issue.MilestoneID = nil
 	}
 	if req.MilestoneID != nil {
 		issue.MilestoneID = req.MilestoneID
 	}
+	if req.ClearEstimate {
+		issue.StoryPoints = nil
+		issue.EstimateMinutes = nil
+		issue.RemainingMinutes = nil
+	}
+	if err := s.validateEstimate(ctx, issue.ProjectID, req.StoryPoints, req.EstimateMinutes); err != nil {
+		return nil, err
+	}
+	if req.StoryPoints != nil {
+		issue.StoryPoints = req.StoryPoints
+	}
+	if req.EstimateMinutes != nil {
+		issue.EstimateMinutes = req.EstimateMinutes
+	}
+	if req.RemainingMinutes != nil {
+		if *req.RemainingMinutes < 0 {
+			return nil, pkgerrors.NewValidationError("remaining_estimate_minutes must not be negative")
+		}
+		issue.RemainingMinutes = req.RemainingMinutes
+	}
+	if req.ClearStartDate {
+		issue.StartDate = nil
+	}
+	if req.ClearDueDate {
+		issue.DueDate = nil
+	}
+	if req.StartDate != nil {
+		issue.StartDate = dateOnly(req.StartDate)
+	}
+	if req.DueDate != nil {
+		issue.DueDate = dateOnly(req.DueDate)
+	}
+	if err := validateDates(issue); err != nil {
+		return nil, err
+	}
 
-	// Save changes
-	err = s.issueRepo.Update(ctx, issue)
+	var customFields customFieldChanges
+	if s.customFieldService != nil {
+		if customFields, err = s.customFieldService.PrepareValues(ctx, issue, req.CustomFields); err != nil {
+			return nil, err
+		}
+	} else if len(req.CustomFields) > 0 {
+		return nil, pkgerrors.NewValidationError("custom fields are not enabled")
+	}
+
+	if req.Resolution != nil {
+		issue.Resolution = req.Resolution
+		if *req.Resolution == "" {
+			issue.Resolution = nil
+		}
+	}
+
+	// Status changes go through the workflow last, so required fields
+	// can be provided in the same request
+	if req.Status != nil && *req.Status != issue.Status {
+		if err := s.applyStatus(ctx, issue, *req.Status, userID); err != nil {
+			return nil, err
+		}
+
+		// Move the card to the column of its new status
+		column, err := s.columnForStatus(ctx, issue.ProjectID, issue.Status)
+		if err != nil {
+			return nil, err
+		}
+		if column != nil && (issue.ColumnID == nil || *issue.ColumnID != column.ID) {
+			issue.ColumnID = &column.ID
+			issue.ColumnPosition = nil
+		}
+	}
+	if issue.StatusCategory != models.StatusCategoryDone {
+		issue.Resolution = nil // Only done issues have a resolution
+	}
+
+	wip, err := s.checkWIPLimit(ctx, issue, &previous, req.WIPOverrideReason, userID)
 	if err != nil {
 		return nil, err
 	}
 
+	// Save changes with the assignees and custom field values in one transaction
+	err = s.issueRepo.UpdateWithDetails(ctx, issue, assigneeIDs, customFields.values())
+	if err != nil {
+		return nil, err
+	}
+	s.recordWIPOverride(ctx, wip, id)
+
+	if len(customFields) > 0 {
+		s.customFieldService.LogChanges(ctx, issue, customFields, userID)
+	}
+
 	// Handle mentions and references if description was updated
 	if req.Description != nil {
 		// Delete old mentions and references for this issue
@@ -268,7 +675,7 @@ func (s *IssueService) Update(ctx context.Context, id int, req *models.UpdateIss
 
 		// Process new mentions and references
 		if *req.Description != "" {
-			mentionedUserIDs, err := s.mentionService.ProcessMentions(ctx, *req.Description, "issue", id, userID)
+			mentionedUserIDs, err := s.mentionService.ProcessMentions(ctx, *req.Description, "issue", id, issue.ProjectID, userID)
 			if err == nil && len(mentionedUserIDs) > 0 {
 				// Create notifications for mentioned users
 				_ = s.mentionService.CreateNotificationsForMentions(ctx, mentionedUserIDs, "issue", id, userID)
@@ -279,6 +686,21 @@ func (s *IssueService) Update(ctx context.Context, id int, req *models.UpdateIss
 		}
 	}
 
+	// Keep the replaced description; the first edit of a description written
+	// before revisions were recorded also saves it as the first revision
+	if s.revisionService != nil && req.Description != nil {
+		previousText := ""
+		if previous.Description != nil {
+			previousText = *previous.Description
+		}
+		if previousText != *req.Description {
+			s.revisionService.Record(ctx, models.RevisionEntityIssue, id, &models.ContentRevision{
+				Content:   &previousText,
+				CreatedAt: previous.UpdatedAt,
+			}, *req.Description, userID)
+		}
+	}
+
 	// Invalidate project caches
 	_ = pkgcache.InvalidateAllProjectCaches(ctx, s.cache, issue.ProjectID)
 
@@ -287,6 +709,9 @@ func (s *IssueService) Update(ctx context.Context, id int, req *models.UpdateIss
 	if err != nil {
 		return nil, err
 	}
+	if err := s.attachDetails(ctx, updated); err != nil {
+		return nil, err
+	}
 
 	// Deliver webhook event (use background context since this runs async)
 	if s.webhookService != nil {
@@ -294,14 +719,16 @@ func (s *IssueService) Update(ctx context.Context, id int, req *models.UpdateIss
 	}
 
 	// Send integration notifications (Slack, Discord, etc.)
-	if s.integrationService != nil {
+	if s.integrationService != nil && !isBulkOperation(ctx) {
 		go s.integrationService.SendEvent(context.Background(), issue.ProjectID, models.EventIssueUpdated, updated)
 	}
 
+	updated.WIPWarning = wip.warning
+	updated.BlockerWarning = s.blockerWarning(ctx, updated, &previous)
 	return updated, nil
 }
 
-// Delete deletes an issue
+// Delete moves an issue and its subtasks to the trash
 func (s *IssueService) Delete(ctx context.Context, id int, userID int) error {
 	// Get issue
 	issue, err := s.issueRepo.GetByID(ctx, id)
@@ -317,7 +744,7 @@ func (s *IssueService) Delete(ctx context.Context, id int, userID int) error {
 	// Copy issue data before deletion for webhook
 	deletedIssue := *issue
 
-	err = s.issueRepo.Delete(ctx, id)
+	err = s.issueRepo.Delete(ctx, id, userID)
 	if err != nil {
 		return err
 	}
@@ -331,7 +758,7 @@ func (s *IssueService) Delete(ctx context.Context, id int, userID int) error {
 	}
 
 	// Send integration notifications (Slack, Discord, etc.)
-	if s.integrationService != nil {
+	if s.integrationService != nil && !isBulkOperation(ctx) {
 		go s.integrationService.SendEvent(context.Background(), issue.ProjectID, models.EventIssueDeleted, &deletedIssue)
 	}
 
@@ -357,35 +784,53 @@ func (s *IssueService) MoveToColumn(ctx context.Context, id int, req *models.Mov
 	}
 
 	// Verify target column exists and belongs to the same project
-	var columnProjectID int
-	err = s.db.QueryRowContext(ctx, `
-		SELECT project_id FROM board_columns WHERE id = $1
-	`, req.ColumnID).Scan(&columnProjectID)
-
+	column, err := s.boardColumn(ctx, req.ColumnID)
 	if err != nil {
-		if err == sql.ErrNoRows {
-			return nil, pkgerrors.ErrNotFound
-		}
 		return nil, err
 	}
 
-	if columnProjectID != issue.ProjectID {
+	if column.ProjectID != issue.ProjectID {
 		return nil, pkgerrors.ErrValidation
 	}
 
 	// Update issue's column
+	previous := *issue
 	issue.ColumnID = &req.ColumnID
 	issue.ColumnPosition = req.Position
 
-	// Update status if provided (for kanban board auto-status change)
-	if req.Status != nil {
-		issue.Status = *req.Status
+	// Columns mapped to statuses set the status of the card server-side
+	status := req.Status
+	if status == nil {
+		status, err = s.statusForColumn(ctx, issue, column)
+		if err != nil {
+			return nil, err
+		}
+	} else if len(column.Statuses) > 0 && !columnHasStatus(column, *status) {
+		return nil, pkgerrors.NewValidationError(fmt.Sprintf("status %q is not mapped to column %q", *status, column.Name))
+	}
+
+	if req.Resolution != nil && *req.Resolution != "" {
+		issue.Resolution = req.Resolution
+	}
+	if status != nil {
+		if err := s.applyStatus(ctx, issue, *status, userID); err != nil {
+			return nil, err
+		}
+	}
+	if issue.StatusCategory != models.StatusCategoryDone {
+		issue.Resolution = nil
+	}
+
+	wip, err := s.checkWIPLimit(ctx, issue, &previous, req.WIPOverrideReason, userID)
+	if err != nil {
+		return nil, err
 	}
 
 	err = s.issueRepo.Update(ctx, issue)
 	if err != nil {
 		return nil, err
 	}
+	s.recordWIPOverride(ctx, wip, id)
 
 	// Invalidate project caches (for board view updates)
 	_ = pkgcache.InvalidateAllProjectCaches(ctx, s.cache, issue.ProjectID)
@@ -402,19 +847,233 @@ func (s *IssueService) MoveToColumn(ctx context.Context, id int, req *models.Mov
 	}
 
 	// Send integration notifications (Slack, Discord, etc.)
-	if s.integrationService != nil {
+	if s.integrationService != nil && !isBulkOperation(ctx) {
 		go s.integrationService.SendEvent(context.Background(), issue.ProjectID, models.EventIssueMoved, updated)
 	}
 
+	updated.WIPWarning = wip.warning
+	updated.BlockerWarning = s.blockerWarning(ctx, updated, &previous)
+	return updated, nil
+}
+
+// CloseAsDuplicate closes an issue with the duplicate resolution, moving it
+// to the first done status of its workflow the user may transition it to
+// The caller is responsible for checking write permission; the transition is
+// checked like any other status change
+func (s *IssueService) CloseAsDuplicate(ctx context.Context, issue *models.Issue, userID int) (*models.Issue, error) {
+	resolution := models.ResolutionDuplicate
+	if issue.StatusCategory == models.StatusCategoryDone {
+		if issue.Resolution != nil && *issue.Resolution == resolution {
+			return issue, nil
+		}
+		issue.Resolution = &resolution
+	} else {
+		workflow, err := s.workflowFor(ctx, issue.ProjectID, issue.IssueType)
+		if err != nil {
+			return nil, err
+		}
+
+		// The resolution is set first so transitions requiring one accept it
+		issue.Resolution = &resolution
+		closed := false
+		var transitionErr error
+		for _, status := range workflow.Statuses {
+			if status.Category != models.StatusCategoryDone {
+				continue
+			}
+			if transitionErr = s.applyStatus(ctx, issue, status.Key, userID); transitionErr == nil {
+				closed = true
+				break
+			}
+		}
+		if transitionErr != nil {
+			return nil, transitionErr
+		}
+		if !closed {
+			return nil, pkgerrors.NewValidationError("Workflow has no done status to close the duplicate with")
+		}
+
+		column, err := s.columnForStatus(ctx, issue.ProjectID, issue.Status)
+		if err != nil {
+			return nil, err
+		}
+		if column != nil && (issue.ColumnID == nil || *issue.ColumnID != column.ID) {
+			issue.ColumnID = &column.ID
+			issue.ColumnPosition = nil
+		}
+	}
+
+	if err := s.issueRepo.Update(ctx, issue); err != nil {
+		return nil, err
+	}
+
+	_ = pkgcache.InvalidateAllProjectCaches(ctx, s.cache, issue.ProjectID)
+
+	updated, err := s.issueRepo.GetByID(ctx, issue.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.webhookService != nil {
+		go s.webhookService.DeliverEvent(context.Background(), issue.ProjectID, models.EventIssueUpdated, userID, updated)
+	}
+
 	return updated, nil
 }
 
+// boardColumn retrieves a board column, with its mapped statuses when the
+// board repository is set
+func (s *IssueService) boardColumn(ctx context.Context, columnID int) (*models.BoardColumn, error) {
+	if s.boardRepo != nil {
+		return s.boardRepo.GetByID(ctx, columnID)
+	}
+
+	column := &models.BoardColumn{ID: columnID}
+	err := s.db.QueryRowContext(ctx, `
+		SELECT project_id, name FROM board_columns WHERE id = $1
+	`, columnID).Scan(&column.ProjectID, &column.Name)
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, pkgerrors.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return column, nil
+}
+
+// columnForStatus returns the column of the project's default board a status
+// is mapped to, or nil
+func (s *IssueService) columnForStatus(ctx context.Context, projectID int, status models.IssueStatus) (*models.BoardColumn, error) {
+	if s.boardRepo == nil {
+		return nil, nil
+	}
+
+	board, err := s.boardRepo.GetDefaultBoard(ctx, projectID)
+	if err == pkgerrors.ErrNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	column, err := s.boardRepo.GetByStatus(ctx, board.ID, status)
+	if err == pkgerrors.ErrNotFound {
+		return nil, nil
+	}
+	return column, err
+}
+
+// statusForColumn returns the status a card takes when moved to a column:
+// nil when the column has no mapping or already maps the issue's status,
+// otherwise the first mapped status of the issue's workflow
+func (s *IssueService) statusForColumn(ctx context.Context, issue *models.Issue, column *models.BoardColumn) (*models.IssueStatus, error) {
+	if len(column.Statuses) == 0 || columnHasStatus(column, issue.Status) {
+		return nil, nil
+	}
+
+	workflow, err := s.workflowFor(ctx, issue.ProjectID, issue.IssueType)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, status := range column.Statuses {
+		if workflow.Status(status) != nil {
+			return &status, nil
+		}
+	}
+
+	return nil, pkgerrors.NewValidationError(fmt.Sprintf("column %q has no status of the %s workflow", column.Name, workflow.Name))
+}
+
+// wipCheck is the outcome of checking the WIP limit of the column an issue enters
+type wipCheck struct {
+	override *models.WIPOverride // Recorded once the issue is saved
+	warning  *string             // Soft limit exceeded
+}
+
+// checkWIPLimit enforces the WIP limit of the column an issue is entering:
+// hard limits reject the issue unless an admin gives an override reason,
+// soft limits let it in with a warning
+// previous is the issue before the change, nil for new issues
+func (s *IssueService) checkWIPLimit(ctx context.Context, issue *models.Issue, previous *models.Issue, overrideReason *string, userID int) (*wipCheck, error) {
+	check := &wipCheck{}
+	if s.boardRepo == nil || issue.ColumnID == nil {
+		return check, nil
+	}
+
+	column, err := s.boardRepo.GetByID(ctx, *issue.ColumnID)
+	if err != nil {
+		return nil, err
+	}
+	if column.WIPLimit == nil || column.IssueCount < *column.WIPLimit {
+		return check, nil
+	}
+	if previous != nil && inColumn(previous, column) {
+		return check, nil // Already counted, e.g. reordering within the column
+	}
+
+	if overrideReason != nil && strings.TrimSpace(*overrideReason) != "" {
+		if err := s.authService.CheckAdminPermission(ctx, column.ProjectID, userID); err != nil {
+			return nil, err
+		}
+		check.override = &models.WIPOverride{
+			ColumnID:   column.ID,
+			UserID:     &userID,
+			Reason:     strings.TrimSpace(*overrideReason),
+			IssueCount: column.IssueCount,
+			WIPLimit:   *column.WIPLimit,
+		}
+		return check, nil
+	}
+
+	if column.WIPMode == models.WIPModeHard {
+		return nil, pkgerrors.NewWIPLimitError(fmt.Sprintf("column %q is at its WIP limit of %d", column.Name, *column.WIPLimit))
+	}
+
+	warning := fmt.Sprintf("column %q is over its WIP limit (%d/%d)", column.Name, column.IssueCount+1, *column.WIPLimit)
+	check.warning = &warning
+	return check, nil
+}
+
+// recordWIPOverride audits an admin override once the issue is saved
+func (s *IssueService) recordWIPOverride(ctx context.Context, check *wipCheck, issueID int) {
+	if check.override == nil {
+		return
+	}
+
+	check.override.IssueID = issueID
+	if err := s.boardRepo.CreateWIPOverride(ctx, check.override); err != nil {
+		log.Printf("WARNING: Failed to record WIP override for issue %d: %v", issueID, err)
+	}
+}
+
+// inColumn reports whether an issue is counted in a column: by status for
+// columns mapped to statuses, by placement otherwise
+func inColumn(issue *models.Issue, column *models.BoardColumn) bool {
+	if len(column.Statuses) > 0 {
+		return columnHasStatus(column, issue.Status)
+	}
+	return issue.ColumnID != nil && *issue.ColumnID == column.ID
+}
+
+// columnHasStatus reports whether a status is mapped to a column
+func columnHasStatus(column *models.BoardColumn, status models.IssueStatus) bool {
+	for _, mapped := range column.Statuses {
+		if mapped == status {
+			return true
+		}
+	}
+	return false
+}
+
 // userHasAccess checks if user has any access to the project
 func (s *IssueService) userHasAccess(ctx context.Context, userID int, projectID int) (bool, error) {
 	var count int
 	err := s.db.QueryRowContext(ctx, `
 		SELECT COUNT(*)
-		FROM project_members
+		FROM project_access
 		WHERE project_id = $1 AND user_id = $2
 	`, projectID, userID).Scan(&count)
 
@@ -430,7 +1089,7 @@ func (s *IssueService) userHasPermission(ctx context.Context, userID int, projec
 	var role string
 	err := s.db.QueryRowContext(ctx, `
 		SELECT role
-		FROM project_members
+		FROM project_access
 		WHERE project_id = $1 AND user_id = $2
 	`, projectID, userID).Scan(&role)
 
@@ -450,6 +1109,150 @@ func (s *IssueService) userHasPermission(ctx context.Context, userID int, projec
 	return false, nil
 }
 
+// validateAssignee rejects service accounts as assignees
+func (s *IssueService) validateAssignee(ctx context.Context, assigneeID *int) error {
+	if assigneeID == nil {
+		return nil
+	}
+
+	var isBot bool
+	err := s.db.QueryRowContext(ctx, "SELECT is_bot FROM users WHERE id = $1", *assigneeID).Scan(&isBot)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return pkgerrors.NewValidationError(fmt.Sprintf("assignee %d does not exist", *assigneeID))
+		}
+		return err
+	}
+
+	if isBot {
+		return pkgerrors.NewValidationError("service accounts can't be assigned issues")
+	}
+
+	return nil
+}
+
+// validateAssignees checks the number of assignees of an issue and each
+// assignee
+func (s *IssueService) validateAssignees(ctx context.Context, assigneeIDs []int) error {
+	if len(assigneeIDs) > models.MaxIssueAssignees {
+		return pkgerrors.NewValidationError(fmt.Sprintf("an issue can have at most %d assignees", models.MaxIssueAssignees))
+	}
+	for i := range assigneeIDs {
+		if err := s.validateAssignee(ctx, &assigneeIDs[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// assigneeSet returns the primary assignee and all assignees, without
+// duplicates, for a primary assignee and a list of assignees
+// The primary assignee comes first; without one the first listed user
+// becomes primary
+func assigneeSet(primary *int, assigneeIDs []int) (*int, []int) {
+	set := make([]int, 0, len(assigneeIDs)+1)
+	if primary != nil {
+		set = append(set, *primary)
+	}
+	for _, id := range assigneeIDs {
+		if !slices.Contains(set, id) {
+			set = append(set, id)
+		}
+	}
+
+	if len(set) == 0 {
+		return nil, set
+	}
+	return &set[0], set
+}
+
+// validateAssigneeTeam ensures an assigned team belongs to the project's organization
+func (s *IssueService) validateAssigneeTeam(ctx context.Context, projectID int, teamID *int) error {
+	if teamID == nil {
+		return nil
+	}
+
+	ok, err := s.teamInProjectOrganization(ctx, projectID, *teamID)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return pkgerrors.NewValidationError("assignee team must belong to the project's organization")
+	}
+
+	return nil
+}
+
+// teamInProjectOrganization reports whether a team belongs to the
+// organization of a project
+func (s *IssueService) teamInProjectOrganization(ctx context.Context, projectID int, teamID int) (bool, error) {
+	var exists bool
+	err := s.db.QueryRowContext(ctx, `
+		SELECT EXISTS(
+			SELECT 1 FROM teams t
+			JOIN projects p ON p.organization_id = t.organization_id
+			WHERE t.id = $1 AND p.id = $2
+		)
+	`, teamID, projectID).Scan(&exists)
+	return exists, err
+}
+
+// validateEstimate checks story points against the project's allowed values
+// and that a time estimate isn't negative
+func (s *IssueService) validateEstimate(ctx context.Context, projectID int, storyPoints *float64, estimateMinutes *int) error {
+	if estimateMinutes != nil && *estimateMinutes < 0 {
+		return pkgerrors.NewValidationError("original_estimate_minutes must not be negative")
+	}
+	if storyPoints == nil {
+		return nil
+	}
+
+	settings := &models.EstimationSettings{ProjectID: projectID}
+	if s.projectRepo != nil {
+		var err error
+		settings, err = s.projectRepo.GetEstimationSettings(ctx, projectID)
+		if err != nil {
+			return err
+		}
+	}
+
+	if !settings.AllowsStoryPoints(*storyPoints) {
+		return pkgerrors.NewValidationError(fmt.Sprintf("%g is not an allowed story point value (allowed: %s)",
+			*storyPoints, formatStoryPoints(settings.AllowedStoryPoints)))
+	}
+
+	return nil
+}
+
+// dateOnly truncates an optional date to its calendar day
+func dateOnly(t *time.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+	date := models.DateOnly(*t)
+	return &date
+}
+
+// validateDates checks that an issue doesn't start after it is due
+func validateDates(issue *models.Issue) error {
+	if issue.StartDate != nil && issue.DueDate != nil && issue.StartDate.After(*issue.DueDate) {
+		return pkgerrors.NewValidationError("start_date must not be after due_date")
+	}
+	return nil
+}
+
+// formatStoryPoints formats allowed story point values for error messages
+func formatStoryPoints(points []float64) string {
+	if len(points) == 0 {
+		return "any non-negative value"
+	}
+	values := make([]string, len(points))
+	for i, point := range points {
+		values[i] = strconv.FormatFloat(point, 'g', -1, 64)
+	}
+	return strings.Join(values, ", ")
+}
+
 // validateIssueTypeHierarchy validates issue type hierarchy rules
 func (s *IssueService) validateIssueTypeHierarchy(ctx context.Context, issueType models.IssueType, parentIssueID *int, epicID *int, projectID int) error {
 	// Rule 1: Subtasks must have a parent
@@ -568,45 +1371,45 @@ func (s *IssueService) GetEpics(ctx context.Context, projectID int, userID int)
 }
 
 // GetSubtaskProgress returns subtask completion stats for an issue
-func (s *IssueService) GetSubtaskProgress(ctx context.Context, issueID int, userID int) (total int, completed int, err error) {
+func (s *IssueService) GetSubtaskProgress(ctx context.Context, issueID int, userID int) (*models.IssueProgress, error) {
 	// Get parent issue to check access
 	parent, err := s.issueRepo.GetByID(ctx, issueID)
 	if err != nil {
-		return 0, 0, err
+		return nil, err
 	}
 
 	// Check access
 	hasAccess, err := s.userHasAccess(ctx, userID, parent.ProjectID)
 	if err != nil {
-		return 0, 0, err
+		return nil, err
 	}
 	if !hasAccess {
-		return 0, 0, pkgerrors.ErrForbidden
+		return nil, pkgerrors.ErrForbidden
 	}
 
 	return s.issueRepo.CountSubtasks(ctx, issueID)
 }
 
 // GetEpicProgress returns issue completion stats for an epic
-func (s *IssueService) GetEpicProgress(ctx context.Context, epicID int, userID int) (total int, completed int, err error) {
+func (s *IssueService) GetEpicProgress(ctx context.Context, epicID int, userID int) (*models.IssueProgress, error) {
 	// Get epic to check access
 	epic, err := s.issueRepo.GetByID(ctx, epicID)
 	if err != nil {
-		return 0, 0, err
+		return nil, err
 	}
 
 	// Verify it's an epic
 	if epic.IssueType != models.IssueTypeEpic {
-		return 0, 0, pkgerrors.ErrValidation
+		return nil, pkgerrors.ErrValidation
 	}
 
 	// Check access
 	hasAccess, err := s.userHasAccess(ctx, userID, epic.ProjectID)
 	if err != nil {
-		return 0, 0, err
+		return nil, err
 	}
 	if !hasAccess {
-		return 0, 0, pkgerrors.ErrForbidden
+		return nil, pkgerrors.ErrForbidden
 	}
 
 	return s.issueRepo.CountEpicIssues(ctx, epicID)
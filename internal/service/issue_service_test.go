@@ -8,6 +8,7 @@ import (
 	"github.com/yourusername/issue-tracker/internal/repository"
 	"github.com/yourusername/issue-tracker/pkg/database"
 	pkgerrors "github.com/yourusername/issue-tracker/pkg/errors"
+	"github.com/yourusername/issue-tracker/pkg/markdown"
 )
 
 func setupIssueService(t *testing.T) (*IssueService, *repository.UserRepository, *repository.ProjectRepository, *repository.BoardRepository, func()) {
@@ -22,7 +23,11 @@ func setupIssueService(t *testing.T) (*IssueService, *repository.UserRepository,
 	userRepo := repository.NewUserRepository(db)
 	projectRepo := repository.NewProjectRepository(db)
 	boardRepo := repository.NewBoardRepository(db)
-	issueService := NewIssueService(issueRepo, db)
+	authService := NewAuthorizationService(projectRepo, repository.NewProjectMemberRepository(db))
+	mentionService := NewMentionService(repository.NewMentionRepository(db), userRepo, repository.NewNotificationRepository(db), db)
+	mentionService.SetTeamRepo(repository.NewTeamRepository(db))
+	referenceService := NewIssueReferenceService(repository.NewIssueReferenceRepository(db), issueRepo, db)
+	issueService := NewIssueService(issueRepo, repository.NewIssueWatcherRepository(db), authService, db, nil, markdown.NewRenderer(), mentionService, referenceService, nil, nil)
 
 	cleanup := func() {
 		db.Exec("DELETE FROM issues WHERE project_id IN (SELECT id FROM projects WHERE key LIKE 'ISVC%')")
@@ -106,6 +111,27 @@ func TestIssueService_Create(t *testing.T) {
 			t.Errorf("Expected ErrForbidden, got %v", err)
 		}
 	})
+
+	t.Run("should reject a service account assignee", func(t *testing.T) {
+		bot, err := userRepo.Create(ctx, &models.User{
+			Email:        "issuesvc7@example.com",
+			Username:     "issuesvc7",
+			PasswordHash: "hash",
+			IsBot:        true,
+		})
+		if err != nil {
+			t.Fatalf("Failed to create bot user: %v", err)
+		}
+
+		_, err = service.Create(ctx, project.ID, &models.CreateIssueRequest{Title: "Bot work", AssigneeID: &bot.ID}, user.ID)
+		expectAppError(t, err, 400)
+	})
+
+	t.Run("should reject an unknown assignee", func(t *testing.T) {
+		unknown := -1
+		_, err := service.Create(ctx, project.ID, &models.CreateIssueRequest{Title: "Nobody", AssigneeID: &unknown}, user.ID)
+		expectAppError(t, err, 400)
+	})
 }
 
 func TestIssueService_GetByID(t *testing.T) {
@@ -236,3 +262,67 @@ func TestIssueService_Delete(t *testing.T) {
 		}
 	})
 }
+
+func TestIssueService_AssigneeTeam(t *testing.T) {
+	service, userRepo, projectRepo, _, cleanup := setupIssueService(t)
+	defer cleanup()
+
+	ctx := context.Background()
+
+	// Setup
+	user, _ := userRepo.Create(ctx, &models.User{Email: "issuesvc20@example.com", Username: "issuesvc20", PasswordHash: "hash"})
+	orgRepo := repository.NewOrganizationRepository(service.db)
+	teamRepo := repository.NewTeamRepository(service.db)
+	org, _ := orgRepo.Create(ctx, &models.Organization{Name: "Teams", Slug: "issuesvc-teams"}, user.ID)
+	otherOrg, _ := orgRepo.Create(ctx, &models.Organization{Name: "Other", Slug: "issuesvc-other"}, user.ID)
+	team, _ := teamRepo.Create(ctx, &models.Team{OrganizationID: org.ID, Name: "Backend", Slug: "backend"})
+	otherTeam, _ := teamRepo.Create(ctx, &models.Team{OrganizationID: otherOrg.ID, Name: "Backend", Slug: "backend"})
+	project, _ := projectRepo.Create(ctx, &models.Project{Name: "Test", Key: "ISVC20", OwnerID: user.ID, OrganizationID: &org.ID})
+	defer func() {
+		// Organizations can only be deleted without projects
+		service.db.Exec("DELETE FROM issues WHERE project_id = $1", project.ID)
+		service.db.Exec("DELETE FROM projects WHERE id = $1", project.ID)
+		service.db.Exec("DELETE FROM organizations WHERE slug LIKE 'issuesvc%'")
+	}()
+
+	var issue *models.Issue
+
+	t.Run("should assign a team of the project's organization", func(t *testing.T) {
+		created, err := service.Create(ctx, project.ID, &models.CreateIssueRequest{Title: "Team work", AssigneeTeamID: &team.ID}, user.ID)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if created.AssigneeTeamID == nil || *created.AssigneeTeamID != team.ID {
+			t.Errorf("Expected assignee team %d, got %v", team.ID, created.AssigneeTeamID)
+		}
+		issue = created
+	})
+
+	t.Run("should reject a team of another organization", func(t *testing.T) {
+		_, err := service.Create(ctx, project.ID, &models.CreateIssueRequest{Title: "Wrong team", AssigneeTeamID: &otherTeam.ID}, user.ID)
+		expectAppError(t, err, 400)
+
+		_, err = service.Update(ctx, issue.ID, &models.UpdateIssueRequest{AssigneeTeamID: &otherTeam.ID}, user.ID)
+		expectAppError(t, err, 400)
+	})
+
+	t.Run("should filter by assignee team", func(t *testing.T) {
+		issues, err := service.issueRepo.List(ctx, &models.IssueFilter{ProjectID: project.ID, AssigneeTeamID: &team.ID})
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if len(issues) != 1 || issues[0].ID != issue.ID {
+			t.Errorf("Expected the team issue, got %d issues", len(issues))
+		}
+	})
+
+	t.Run("should clear the assignee team", func(t *testing.T) {
+		updated, err := service.Update(ctx, issue.ID, &models.UpdateIssueRequest{ClearAssigneeTeam: true}, user.ID)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if updated.AssigneeTeamID != nil {
+			t.Errorf("Expected no assignee team, got %d", *updated.AssigneeTeamID)
+		}
+	})
+}
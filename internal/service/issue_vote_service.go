@@ -0,0 +1,199 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+
+	"github.com/yourusername/issue-tracker/internal/models"
+	"github.com/yourusername/issue-tracker/internal/repository"
+	pkgcache "github.com/yourusername/issue-tracker/pkg/cache"
+	pkgerrors "github.com/yourusername/issue-tracker/pkg/errors"
+)
+
+// maxOrganizationNameLength is the longest customer organization name
+const maxOrganizationNameLength = 255
+
+// IssueVoteService handles issue votes and customer requests, which product
+// managers use to rank issues by demand
+type IssueVoteService struct {
+	voteRepo        *repository.IssueVoteRepository
+	issueRepo       *repository.IssueRepository
+	authService     *AuthorizationService
+	activityService *ActivityService
+	cache           pkgcache.Cache
+}
+
+// NewIssueVoteService creates a new issue vote service
+func NewIssueVoteService(
+	voteRepo *repository.IssueVoteRepository,
+	issueRepo *repository.IssueRepository,
+	authService *AuthorizationService,
+	activityService *ActivityService,
+	cache pkgcache.Cache,
+) *IssueVoteService {
+	return &IssueVoteService{
+		voteRepo:        voteRepo,
+		issueRepo:       issueRepo,
+		authService:     authService,
+		activityService: activityService,
+		cache:           cache,
+	}
+}
+
+// accessibleIssue returns an issue the user can read
+func (s *IssueVoteService) accessibleIssue(ctx context.Context, issueID, userID int) (*models.Issue, error) {
+	issue, err := s.issueRepo.GetByID(ctx, issueID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.authService.CheckProjectAccess(ctx, issue.ProjectID, userID); err != nil {
+		return nil, err
+	}
+	return issue, nil
+}
+
+// GetSummary returns the vote totals of an issue and the user's own vote
+func (s *IssueVoteService) GetSummary(ctx context.Context, issueID, userID int) (*models.IssueVoteSummary, error) {
+	if _, err := s.accessibleIssue(ctx, issueID, userID); err != nil {
+		return nil, err
+	}
+	return s.voteRepo.GetSummary(ctx, issueID, userID)
+}
+
+// Vote votes an issue up or down; every user with access has one vote,
+// which a new vote replaces
+func (s *IssueVoteService) Vote(ctx context.Context, issueID int, req *models.VoteIssueRequest, userID int) (*models.IssueVoteSummary, error) {
+	if req.Value != models.VoteUp && req.Value != models.VoteDown {
+		return nil, pkgerrors.NewValidationError("value must be 1 (up) or -1 (down)")
+	}
+
+	issue, err := s.accessibleIssue(ctx, issueID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	vote := &models.IssueVote{IssueID: issueID, UserID: userID, Value: req.Value}
+	if err := s.voteRepo.Vote(ctx, vote); err != nil {
+		return nil, err
+	}
+	_ = pkgcache.InvalidateAllProjectCaches(ctx, s.cache, issue.ProjectID)
+
+	return s.voteRepo.GetSummary(ctx, issueID, userID)
+}
+
+// Unvote removes the user's vote from an issue
+func (s *IssueVoteService) Unvote(ctx context.Context, issueID, userID int) (*models.IssueVoteSummary, error) {
+	issue, err := s.accessibleIssue(ctx, issueID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.voteRepo.Unvote(ctx, issueID, userID); err != nil {
+		return nil, err
+	}
+	_ = pkgcache.InvalidateAllProjectCaches(ctx, s.cache, issue.ProjectID)
+
+	return s.voteRepo.GetSummary(ctx, issueID, userID)
+}
+
+// ListCustomerRequests lists the customers that requested an issue
+func (s *IssueVoteService) ListCustomerRequests(ctx context.Context, issueID, userID int) ([]*models.CustomerRequest, error) {
+	if _, err := s.accessibleIssue(ctx, issueID, userID); err != nil {
+		return nil, err
+	}
+	return s.voteRepo.ListCustomerRequests(ctx, issueID)
+}
+
+// AddCustomerRequest records that a customer requested an issue
+// Only project admins may record requests, once per organization
+func (s *IssueVoteService) AddCustomerRequest(ctx context.Context, issueID int, req *models.CreateCustomerRequestRequest, userID int) (*models.CustomerRequest, error) {
+	name := strings.TrimSpace(req.OrganizationName)
+	if name == "" {
+		return nil, pkgerrors.NewValidationError("organization_name is required")
+	}
+	if len(name) > maxOrganizationNameLength {
+		return nil, pkgerrors.NewValidationError("organization_name must be at most 255 characters")
+	}
+
+	issue, err := s.issueRepo.GetByID(ctx, issueID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.authService.CheckAdminPermission(ctx, issue.ProjectID, userID); err != nil {
+		return nil, err
+	}
+
+	request := &models.CustomerRequest{
+		IssueID:          issueID,
+		OrganizationName: name,
+		Note:             req.Note,
+		CreatedBy:        &userID,
+	}
+	if err := s.voteRepo.AddCustomerRequest(ctx, request); err != nil {
+		if err == pkgerrors.ErrConflict {
+			return nil, pkgerrors.NewValidationError("this organization already requested the issue")
+		}
+		return nil, err
+	}
+
+	s.logCustomerRequest(ctx, issue, "customer_request_added", name, userID)
+	_ = pkgcache.InvalidateAllProjectCaches(ctx, s.cache, issue.ProjectID)
+
+	slog.Info("customer request added", "issue_id", issueID, "request_id", request.ID, "user_id", userID)
+	return request, nil
+}
+
+// DeleteCustomerRequest removes a customer request from an issue
+func (s *IssueVoteService) DeleteCustomerRequest(ctx context.Context, issueID, requestID, userID int) error {
+	request, err := s.voteRepo.GetCustomerRequest(ctx, requestID)
+	if err != nil {
+		return err
+	}
+	if request.IssueID != issueID {
+		return pkgerrors.ErrNotFound
+	}
+
+	issue, err := s.issueRepo.GetByID(ctx, issueID)
+	if err != nil {
+		return err
+	}
+	if err := s.authService.CheckAdminPermission(ctx, issue.ProjectID, userID); err != nil {
+		return err
+	}
+
+	if err := s.voteRepo.DeleteCustomerRequest(ctx, request); err != nil {
+		return err
+	}
+
+	s.logCustomerRequest(ctx, issue, "customer_request_removed", request.OrganizationName, userID)
+	_ = pkgcache.InvalidateAllProjectCaches(ctx, s.cache, issue.ProjectID)
+
+	slog.Info("customer request removed", "issue_id", issueID, "request_id", requestID, "user_id", userID)
+	return nil
+}
+
+// logCustomerRequest records a change to the customer requests of an issue
+// in the activity log of its project
+func (s *IssueVoteService) logCustomerRequest(ctx context.Context, issue *models.Issue, action string, organization string, userID int) {
+	if s.activityService == nil {
+		return
+	}
+
+	projectID, issueID := issue.ProjectID, issue.ID
+	req := &models.CreateActivityRequest{
+		ProjectID:  &projectID,
+		IssueID:    &issueID,
+		UserID:     userID,
+		Action:     action,
+		EntityType: string(models.EntityTypeIssue),
+		EntityID:   &issueID,
+		FieldName:  strPtr("customer_request"),
+	}
+	if action == "customer_request_added" {
+		req.NewValue = &organization
+	} else {
+		req.OldValue = &organization
+	}
+	_, _ = s.activityService.LogActivity(ctx, req)
+}
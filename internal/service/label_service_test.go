@@ -31,7 +31,9 @@ func setupLabelService(t *testing.T) (*LabelService, *repository.ProjectReposito
 	userRepo := repository.NewUserRepository(db)
 	issueRepo := repository.NewIssueRepository(db)
 
-	labelService := NewLabelService(labelRepo, projectRepo, issueRepo, db)
+	authService := NewAuthorizationService(projectRepo, repository.NewProjectMemberRepository(db))
+
+	labelService := NewLabelService(labelRepo, projectRepo, issueRepo, authService, db, nil)
 
 	cleanup := func() {
 		db.Exec("DELETE FROM issue_labels")
@@ -243,7 +245,3 @@ func TestLabelService_Delete(t *testing.T) {
 		t.Error("Expected label to be deleted")
 	}
 }
-
-func stringPtr(s string) *string {
-	return &s
-}
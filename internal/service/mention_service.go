@@ -7,6 +7,7 @@ import (
 
 	"github.com/yourusername/issue-tracker/internal/models"
 	"github.com/yourusername/issue-tracker/internal/repository"
+	pkgerrors "github.com/yourusername/issue-tracker/pkg/errors"
 )
 
 // MentionService handles mention business logic
@@ -14,6 +15,7 @@ type MentionService struct {
 	mentionRepo      *repository.MentionRepository
 	userRepo         *repository.UserRepository
 	notificationRepo *repository.NotificationRepository
+	teamRepo         *repository.TeamRepository
 	db               *sql.DB
 }
 
@@ -32,9 +34,16 @@ func NewMentionService(
 	}
 }
 
+// SetTeamRepo sets the team repository (optional, for @team mentions)
+func (s *MentionService) SetTeamRepo(teamRepo *repository.TeamRepository) {
+	s.teamRepo = teamRepo
+}
+
 // ProcessMentions parses text for @mentions and creates mention records
+// A name that is not a username is resolved as a team slug in the project's
+// organization and mentions every member of the team
 // Returns the list of mentioned user IDs
-func (s *MentionService) ProcessMentions(ctx context.Context, text string, entityType string, entityID int, mentionedByUserID int) ([]int, error) {
+func (s *MentionService) ProcessMentions(ctx context.Context, text string, entityType string, entityID int, projectID int, mentionedByUserID int) ([]int, error) {
 	// Parse usernames from text
 	usernames := models.ParseMentions(text)
 	if len(usernames) == 0 {
@@ -44,32 +53,32 @@ func (s *MentionService) ProcessMentions(ctx context.Context, text string, entit
 	// Look up users by username
 	var mentionedUserIDs []int
 	var mentions []*models.Mention
+	seen := make(map[int]bool)
 
 	for _, username := range usernames {
-		user, err := s.userRepo.GetByUsername(ctx, username)
+		userIDs, err := s.resolveMention(ctx, username, projectID)
 		if err != nil {
-			if err == sql.ErrNoRows {
-				// User doesn't exist, skip
-				continue
-			}
 			return nil, err
 		}
 
-		// Don't allow self-mentions
-		if user.ID == mentionedByUserID {
-			continue
-		}
+		for _, userID := range userIDs {
+			// Don't allow self-mentions
+			if userID == mentionedByUserID || seen[userID] {
+				continue
+			}
+			seen[userID] = true
 
-		mentionedUserIDs = append(mentionedUserIDs, user.ID)
+			mentionedUserIDs = append(mentionedUserIDs, userID)
 
-		mention := &models.Mention{
-			UserID:            user.ID,
-			MentionedByUserID: mentionedByUserID,
-			EntityType:        entityType,
-			EntityID:          entityID,
-			CreatedAt:         time.Now(),
+			mention := &models.Mention{
+				UserID:            userID,
+				MentionedByUserID: mentionedByUserID,
+				EntityType:        entityType,
+				EntityID:          entityID,
+				CreatedAt:         time.Now(),
+			}
+			mentions = append(mentions, mention)
 		}
-		mentions = append(mentions, mention)
 	}
 
 	// Create mention records in batch
@@ -83,6 +92,34 @@ func (s *MentionService) ProcessMentions(ctx context.Context, text string, entit
 	return mentionedUserIDs, nil
 }
 
+// resolveMention returns the users a mentioned name refers to: the user with
+// that username, otherwise the members of the team with that slug
+func (s *MentionService) resolveMention(ctx context.Context, name string, projectID int) ([]int, error) {
+	user, err := s.userRepo.GetByUsername(ctx, name)
+	if err == nil {
+		return []int{user.ID}, nil
+	}
+	if err != pkgerrors.ErrNotFound && err != sql.ErrNoRows {
+		return nil, err
+	}
+
+	if s.teamRepo == nil {
+		// User doesn't exist, skip
+		return nil, nil
+	}
+
+	team, err := s.teamRepo.GetBySlugForProject(ctx, projectID, name)
+	if err != nil {
+		if err == pkgerrors.ErrNotFound {
+			// Neither a user nor a team, skip
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return s.teamRepo.ListMemberIDs(ctx, team.ID)
+}
+
 // CreateNotificationsForMentions creates notifications for all mentioned users
 func (s *MentionService) CreateNotificationsForMentions(ctx context.Context, mentionedUserIDs []int, entityType string, entityID int, mentionedByUserID int) error {
 	if len(mentionedUserIDs) == 0 {
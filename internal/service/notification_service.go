@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 	"log"
+	"slices"
 
 	"github.com/yourusername/issue-tracker/internal/models"
 	"github.com/yourusername/issue-tracker/internal/repository"
@@ -132,11 +133,17 @@ func (s *NotificationService) CountUnread(ctx context.Context, userID int) (int,
 }
 
 // CreateForIssueCreated creates a notification when an issue is created
-func (s *NotificationService) CreateForIssueCreated(ctx context.Context, issueID int, issueKey, issueTitle string, actorID int, assigneeID *int, projectMemberIDs []int, projectName string) error {
-	// Notify assignee if assigned
-	if assigneeID != nil && *assigneeID != actorID {
+// Each assignee is told about the assignment, the other project members
+// about the new issue
+func (s *NotificationService) CreateForIssueCreated(ctx context.Context, issueID int, issueKey, issueTitle string, actorID int, assigneeIDs []int, projectMemberIDs []int, projectName string) error {
+	// Notify assignees
+	for _, assigneeID := range assigneeIDs {
+		if assigneeID == actorID {
+			continue
+		}
+
 		notification := &models.Notification{
-			UserID:     *assigneeID,
+			UserID:     assigneeID,
 			ActorID:    &actorID,
 			EntityType: models.NotificationEntityIssue,
 			EntityID:   issueID,
@@ -152,7 +159,7 @@ func (s *NotificationService) CreateForIssueCreated(ctx context.Context, issueID
 		}
 
 		// Send email notification
-		assignee, err := s.userRepo.GetByID(ctx, *assigneeID)
+		assignee, err := s.userRepo.GetByID(ctx, assigneeID)
 		if err == nil && assignee.Email != "" {
 			actor, err := s.userRepo.GetByID(ctx, actorID)
 			actorName := "Someone"
@@ -173,9 +180,9 @@ func (s *NotificationService) CreateForIssueCreated(ctx context.Context, issueID
 		}
 	}
 
-	// Notify project members (except actor and assignee)
+	// Notify project members (except actor and assignees)
 	for _, memberID := range projectMemberIDs {
-		if memberID == actorID || (assigneeID != nil && memberID == *assigneeID) {
+		if memberID == actorID || slices.Contains(assigneeIDs, memberID) {
 			continue
 		}
 
@@ -200,7 +207,7 @@ func (s *NotificationService) CreateForIssueCreated(ctx context.Context, issueID
 }
 
 // CreateForComment creates a notification when a comment is added
-func (s *NotificationService) CreateForComment(ctx context.Context, commentID int, issueID int, issueKey, issueTitle, commentText string, actorID int, issueCreatorID int, assigneeID *int, projectName string) error {
+func (s *NotificationService) CreateForComment(ctx context.Context, commentID int, issueID int, issueKey, issueTitle, commentText string, actorID int, issueCreatorID int, assigneeIDs []int, projectName string) error {
 	// Get actor name
 	actor, err := s.userRepo.GetByID(ctx, actorID)
 	actorName := "Someone"
@@ -243,10 +250,14 @@ func (s *NotificationService) CreateForComment(ctx context.Context, commentID in
 		}
 	}
 
-	// Notify assignee if they're not the commenter and not the creator
-	if assigneeID != nil && *assigneeID != actorID && *assigneeID != issueCreatorID {
+	// Notify assignees who are neither the commenter nor the creator
+	for _, assigneeID := range assigneeIDs {
+		if assigneeID == actorID || assigneeID == issueCreatorID {
+			continue
+		}
+
 		notification := &models.Notification{
-			UserID:     *assigneeID,
+			UserID:     assigneeID,
 			ActorID:    &actorID,
 			EntityType: models.NotificationEntityComment,
 			EntityID:   commentID,
@@ -262,7 +273,7 @@ func (s *NotificationService) CreateForComment(ctx context.Context, commentID in
 		}
 
 		// Send email to assignee
-		assignee, err := s.userRepo.GetByID(ctx, *assigneeID)
+		assignee, err := s.userRepo.GetByID(ctx, assigneeID)
 		if err == nil && assignee.Email != "" {
 			err = s.emailClient.SendCommentAdded(
 				assignee.Email,
@@ -284,3 +295,37 @@ func (s *NotificationService) CreateForComment(ctx context.Context, commentID in
 func stringPtr(s string) *string {
 	return &s
 }
+
+// CreateForDueReminder notifies and emails a user that an issue is about to
+// be due or is overdue
+func (s *NotificationService) CreateForDueReminder(ctx context.Context, reminder *models.IssueReminder) error {
+	dueDate := reminder.DueDate.Format("2006-01-02")
+	overdue := reminder.Kind == models.ReminderOverdue
+
+	action, title := models.NotificationActionDueSoon, "Issue due soon"
+	if overdue {
+		action, title = models.NotificationActionOverdue, "Issue overdue"
+	}
+
+	_, err := s.notificationRepo.Create(ctx, &models.Notification{
+		UserID:     reminder.UserID,
+		EntityType: models.NotificationEntityIssue,
+		EntityID:   reminder.IssueID,
+		Action:     action,
+		Title:      title,
+		Message:    stringPtr(reminder.IssueKey + ": " + reminder.IssueTitle + " (due " + dueDate + ")"),
+		Read:       false,
+	})
+	if err != nil {
+		return err
+	}
+
+	if reminder.Email != "" {
+		err = s.emailClient.SendDueReminder(reminder.Email, reminder.IssueKey, reminder.IssueTitle, dueDate, reminder.ProjectName, overdue)
+		if err != nil {
+			log.Printf("Failed to send due reminder email: %v", err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,349 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/yourusername/issue-tracker/internal/models"
+	"github.com/yourusername/issue-tracker/internal/repository"
+	pkgerrors "github.com/yourusername/issue-tracker/pkg/errors"
+)
+
+// organizationSlugPattern matches lowercase URL-safe organization slugs
+var organizationSlugPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9-]{1,49}$`)
+
+// OrganizationService handles organization business logic
+type OrganizationService struct {
+	orgRepo     *repository.OrganizationRepository
+	projectRepo *repository.ProjectRepository
+	userRepo    *repository.UserRepository
+	// Two-factor status of new members of organizations requiring it
+	twoFactorRepo *repository.TwoFactorRepository
+}
+
+// NewOrganizationService creates a new organization service
+func NewOrganizationService(
+	orgRepo *repository.OrganizationRepository,
+	projectRepo *repository.ProjectRepository,
+	userRepo *repository.UserRepository,
+) *OrganizationService {
+	return &OrganizationService{
+		orgRepo:     orgRepo,
+		projectRepo: projectRepo,
+		userRepo:    userRepo,
+	}
+}
+
+// SetTwoFactorRepo enables the two-factor check on new members of
+// organizations that require it
+func (s *OrganizationService) SetTwoFactorRepo(twoFactorRepo *repository.TwoFactorRepository) {
+	s.twoFactorRepo = twoFactorRepo
+}
+
+// Create creates an organization owned by the current user
+func (s *OrganizationService) Create(ctx context.Context, req *models.CreateOrganizationRequest, userID int) (*models.Organization, error) {
+	if err := rejectServiceAccount(ctx, s.userRepo, userID); err != nil {
+		return nil, err
+	}
+
+	name := strings.TrimSpace(req.Name)
+	slug := strings.ToLower(strings.TrimSpace(req.Slug))
+	if name == "" || !organizationSlugPattern.MatchString(slug) {
+		return nil, pkgerrors.ErrValidation
+	}
+
+	return s.orgRepo.Create(ctx, &models.Organization{Name: name, Slug: slug}, userID)
+}
+
+// List retrieves the organizations of the current user
+func (s *OrganizationService) List(ctx context.Context, userID int) ([]*models.Organization, error) {
+	return s.orgRepo.ListByUserID(ctx, userID)
+}
+
+// GetByID retrieves an organization the current user is a member of
+func (s *OrganizationService) GetByID(ctx context.Context, orgID int, userID int) (*models.Organization, error) {
+	member, err := s.requireRole(ctx, orgID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	org, err := s.orgRepo.GetByID(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	org.Role = member.Role
+
+	return org, nil
+}
+
+// Update updates an organization
+func (s *OrganizationService) Update(ctx context.Context, orgID int, req *models.UpdateOrganizationRequest, userID int) (*models.Organization, error) {
+	if _, err := s.requireRole(ctx, orgID, userID, models.OrgRoleOwner, models.OrgRoleAdmin); err != nil {
+		return nil, err
+	}
+
+	org, err := s.orgRepo.GetByID(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Name != nil {
+		name := strings.TrimSpace(*req.Name)
+		if name == "" {
+			return nil, pkgerrors.ErrValidation
+		}
+		org.Name = name
+	}
+
+	if err := s.orgRepo.Update(ctx, org); err != nil {
+		return nil, err
+	}
+
+	return s.GetByID(ctx, orgID, userID)
+}
+
+// UpdateSettings replaces the org-wide project defaults
+func (s *OrganizationService) UpdateSettings(ctx context.Context, orgID int, settings *models.OrganizationSettings, userID int) (*models.Organization, error) {
+	if _, err := s.requireRole(ctx, orgID, userID, models.OrgRoleOwner, models.OrgRoleAdmin); err != nil {
+		return nil, err
+	}
+
+	switch settings.DefaultProjectRole {
+	case "":
+		settings.DefaultProjectRole = models.RoleMember
+	case models.RoleMember, models.RoleViewer:
+	default:
+		return nil, pkgerrors.ErrValidation
+	}
+
+	for _, integrationType := range settings.AllowedIntegrations {
+		if !isKnownIntegrationType(integrationType) {
+			return nil, pkgerrors.ErrValidation
+		}
+	}
+
+	// Requiring 2FA must not lock out current members
+	if settings.Require2FA {
+		missing, err := s.orgRepo.CountMembersWithoutTwoFactor(ctx, orgID)
+		if err != nil {
+			return nil, err
+		}
+		if missing > 0 {
+			return nil, pkgerrors.NewValidationError(fmt.Sprintf(
+				"%d member(s) have not enabled two-factor authentication", missing))
+		}
+	}
+
+	if err := s.orgRepo.UpdateSettings(ctx, orgID, settings); err != nil {
+		return nil, err
+	}
+
+	return s.GetByID(ctx, orgID, userID)
+}
+
+// Delete deletes an organization that no longer owns any projects
+func (s *OrganizationService) Delete(ctx context.Context, orgID int, userID int) error {
+	if _, err := s.requireRole(ctx, orgID, userID, models.OrgRoleOwner); err != nil {
+		return err
+	}
+
+	return s.orgRepo.Delete(ctx, orgID)
+}
+
+// ListProjects retrieves the projects of an organization
+func (s *OrganizationService) ListProjects(ctx context.Context, orgID int, userID int) ([]*models.Project, error) {
+	if _, err := s.requireRole(ctx, orgID, userID); err != nil {
+		return nil, err
+	}
+
+	return s.projectRepo.ListByOrganizationID(ctx, orgID)
+}
+
+// ListMembers retrieves the members of an organization
+func (s *OrganizationService) ListMembers(ctx context.Context, orgID int, userID int) ([]*models.OrganizationMember, error) {
+	if _, err := s.requireRole(ctx, orgID, userID); err != nil {
+		return nil, err
+	}
+
+	return s.orgRepo.ListMembers(ctx, orgID)
+}
+
+// AddMember adds a user to an organization
+// Only owners can add other owners
+func (s *OrganizationService) AddMember(ctx context.Context, orgID int, req *models.AddOrganizationMemberRequest, userID int) error {
+	current, err := s.requireRole(ctx, orgID, userID, models.OrgRoleOwner, models.OrgRoleAdmin)
+	if err != nil {
+		return err
+	}
+
+	if !isValidOrganizationRole(req.Role) {
+		return pkgerrors.ErrValidation
+	}
+	if req.Role == models.OrgRoleOwner && current.Role != models.OrgRoleOwner {
+		return pkgerrors.ErrForbidden
+	}
+
+	user, err := s.userRepo.GetByID(ctx, req.UserID)
+	if err != nil {
+		return err
+	}
+
+	// Service accounts belong to a single project
+	if user.IsBot {
+		return pkgerrors.ErrValidation
+	}
+
+	if err := s.requireMemberTwoFactor(ctx, orgID, user.ID); err != nil {
+		return err
+	}
+
+	return s.orgRepo.AddMember(ctx, &models.OrganizationMember{
+		OrganizationID: orgID,
+		UserID:         req.UserID,
+		Role:           req.Role,
+		InvitedBy:      &userID,
+	})
+}
+
+// UpdateMemberRole changes the role of an organization member
+// Only owners can grant or revoke the owner role, and the last owner cannot be demoted
+func (s *OrganizationService) UpdateMemberRole(ctx context.Context, orgID, memberUserID int, req *models.UpdateOrganizationMemberRequest, userID int) error {
+	current, err := s.requireRole(ctx, orgID, userID, models.OrgRoleOwner, models.OrgRoleAdmin)
+	if err != nil {
+		return err
+	}
+
+	if !isValidOrganizationRole(req.Role) {
+		return pkgerrors.ErrValidation
+	}
+
+	member, err := s.orgRepo.GetMember(ctx, orgID, memberUserID)
+	if err != nil {
+		return err
+	}
+
+	if (req.Role == models.OrgRoleOwner || member.Role == models.OrgRoleOwner) && current.Role != models.OrgRoleOwner {
+		return pkgerrors.ErrForbidden
+	}
+
+	if member.Role == models.OrgRoleOwner && req.Role != models.OrgRoleOwner {
+		if err := s.ensureAnotherOwner(ctx, orgID); err != nil {
+			return err
+		}
+	}
+
+	return s.orgRepo.UpdateMemberRole(ctx, orgID, memberUserID, req.Role)
+}
+
+// RemoveMember removes a user from an organization
+// Members can always leave; removing others requires admin, and removing an owner requires owner
+func (s *OrganizationService) RemoveMember(ctx context.Context, orgID, memberUserID int, userID int) error {
+	current, err := s.requireRole(ctx, orgID, userID)
+	if err != nil {
+		return err
+	}
+
+	member, err := s.orgRepo.GetMember(ctx, orgID, memberUserID)
+	if err != nil {
+		return err
+	}
+
+	if memberUserID != userID {
+		if current.Role == models.OrgRoleMember {
+			return pkgerrors.ErrForbidden
+		}
+		if member.Role == models.OrgRoleOwner && current.Role != models.OrgRoleOwner {
+			return pkgerrors.ErrForbidden
+		}
+	}
+
+	if member.Role == models.OrgRoleOwner {
+		if err := s.ensureAnotherOwner(ctx, orgID); err != nil {
+			return err
+		}
+	}
+
+	return s.orgRepo.RemoveMember(ctx, orgID, memberUserID)
+}
+
+// requireRole returns the membership of the user in the organization,
+// checking it against the allowed roles when any are given
+func (s *OrganizationService) requireRole(ctx context.Context, orgID, userID int, allowed ...models.OrganizationRole) (*models.OrganizationMember, error) {
+	member, err := s.orgRepo.GetMember(ctx, orgID, userID)
+	if err != nil {
+		if err == pkgerrors.ErrNotFound {
+			// Don't reveal whether the organization exists
+			return nil, pkgerrors.ErrNotFound
+		}
+		return nil, err
+	}
+
+	if len(allowed) == 0 {
+		return member, nil
+	}
+	for _, role := range allowed {
+		if member.Role == role {
+			return member, nil
+		}
+	}
+
+	return nil, pkgerrors.ErrForbidden
+}
+
+// ensureAnotherOwner prevents an organization from losing its last owner
+func (s *OrganizationService) ensureAnotherOwner(ctx context.Context, orgID int) error {
+	owners, err := s.orgRepo.CountOwners(ctx, orgID)
+	if err != nil {
+		return err
+	}
+	if owners <= 1 {
+		return pkgerrors.ErrConflict
+	}
+	return nil
+}
+
+// isValidOrganizationRole checks if the organization role is valid
+func isValidOrganizationRole(role models.OrganizationRole) bool {
+	switch role {
+	case models.OrgRoleOwner, models.OrgRoleAdmin, models.OrgRoleMember:
+		return true
+	}
+	return false
+}
+
+// isKnownIntegrationType checks if the integration type exists
+func isKnownIntegrationType(integrationType string) bool {
+	for _, t := range models.AllIntegrationTypes() {
+		if t == integrationType {
+			return true
+		}
+	}
+	return false
+}
+
+// requireMemberTwoFactor refuses users without two-factor authentication as
+// members of an organization that requires it
+func (s *OrganizationService) requireMemberTwoFactor(ctx context.Context, orgID, userID int) error {
+	if s.twoFactorRepo == nil {
+		return nil
+	}
+
+	org, err := s.orgRepo.GetByID(ctx, orgID)
+	if err != nil {
+		return err
+	}
+	if !org.Settings.Require2FA {
+		return nil
+	}
+
+	enabled, err := s.twoFactorRepo.IsEnabled(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if !enabled {
+		return pkgerrors.NewValidationError("this organization requires members to enable two-factor authentication")
+	}
+	return nil
+}
@@ -51,11 +51,16 @@ func (s *ProjectMemberService) AddMember(ctx context.Context, projectID int, req
 	}
 
 	// Check if user to be added exists
-	_, err = s.userRepo.GetByID(ctx, req.UserID)
+	user, err := s.userRepo.GetByID(ctx, req.UserID)
 	if err != nil {
 		return err
 	}
 
+	// Service accounts are managed through their own endpoints and stay in their project
+	if user.IsBot {
+		return pkgerrors.ErrValidation
+	}
+
 	// If adding as owner, check if owner already exists
 	if req.Role == models.RoleOwner {
 		members, err := s.memberRepo.ListByProjectID(ctx, projectID)
@@ -81,7 +86,8 @@ func (s *ProjectMemberService) AddMember(ctx context.Context, projectID int, req
 }
 
 // ListMembers lists all members of a project
-func (s *ProjectMemberService) ListMembers(ctx context.Context, projectID int, currentUserID int) ([]*models.ProjectMember, error) {
+// Service accounts are excluded unless includeBots is set, so they don't show up in assignee pickers
+func (s *ProjectMemberService) ListMembers(ctx context.Context, projectID int, currentUserID int, includeBots bool) ([]*models.ProjectMember, error) {
 	// Check if user has access to project
 	hasAccess, err := s.userHasAccess(ctx, currentUserID, projectID)
 	if err != nil {
@@ -92,7 +98,10 @@ func (s *ProjectMemberService) ListMembers(ctx context.Context, projectID int, c
 		return nil, pkgerrors.ErrForbidden
 	}
 
-	return s.memberRepo.ListByProjectID(ctx, projectID)
+	if includeBots {
+		return s.memberRepo.ListByProjectID(ctx, projectID)
+	}
+	return s.memberRepo.ListHumansByProjectID(ctx, projectID)
 }
 
 // UpdateMemberRole updates a member's role
@@ -171,7 +180,7 @@ func (s *ProjectMemberService) userHasAccess(ctx context.Context, userID int, pr
 	var count int
 	err := s.db.QueryRowContext(ctx, `
 		SELECT COUNT(*)
-		FROM project_members
+		FROM project_access
 		WHERE project_id = $1 AND user_id = $2
 	`, projectID, userID).Scan(&count)
 
@@ -187,7 +196,7 @@ func (s *ProjectMemberService) userHasPermission(ctx context.Context, userID int
 	var role string
 	err := s.db.QueryRowContext(ctx, `
 		SELECT role
-		FROM project_members
+		FROM project_access
 		WHERE project_id = $1 AND user_id = $2
 	`, projectID, userID).Scan(&role)
 
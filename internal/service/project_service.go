@@ -3,6 +3,8 @@ package service
 import (
 	"context"
 	"database/sql"
+	"regexp"
+	"sort"
 
 	"github.com/yourusername/issue-tracker/internal/models"
 	"github.com/yourusername/issue-tracker/internal/repository"
@@ -10,12 +12,17 @@ import (
 	pkgerrors "github.com/yourusername/issue-tracker/pkg/errors"
 )
 
+// projectKeyPattern matches project keys, e.g. PROJ
+var projectKeyPattern = regexp.MustCompile(`^[A-Z][A-Z0-9]{1,9}$`)
+
 // ProjectService handles project business logic
 type ProjectService struct {
 	projectRepo  *repository.ProjectRepository
 	boardRepo    *repository.BoardRepository
+	userRepo     *repository.UserRepository
 	templateRepo *repository.TemplateRepository
 	labelRepo    *repository.LabelRepository
+	orgRepo      *repository.OrganizationRepository
 	db           *sql.DB
 	cache        pkgcache.Cache
 }
@@ -24,12 +31,14 @@ type ProjectService struct {
 func NewProjectService(
 	projectRepo *repository.ProjectRepository,
 	boardRepo *repository.BoardRepository,
+	userRepo *repository.UserRepository,
 	db *sql.DB,
 	cache pkgcache.Cache,
 ) *ProjectService {
 	return &ProjectService{
 		projectRepo: projectRepo,
 		boardRepo:   boardRepo,
+		userRepo:    userRepo,
 		db:          db,
 		cache:       cache,
 	}
@@ -45,8 +54,49 @@ func (s *ProjectService) SetLabelRepo(labelRepo *repository.LabelRepository) {
 	s.labelRepo = labelRepo
 }
 
+// SetOrganizationRepo sets the organization repository (optional, for organization projects)
+func (s *ProjectService) SetOrganizationRepo(orgRepo *repository.OrganizationRepository) {
+	s.orgRepo = orgRepo
+}
+
 // Create creates a new project with default columns and adds owner as member
 func (s *ProjectService) Create(ctx context.Context, req *models.CreateProjectRequest, ownerID int) (*models.Project, error) {
+	if err := rejectServiceAccount(ctx, s.userRepo, ownerID); err != nil {
+		return nil, err
+	}
+
+	// Organization projects can only be created by org owners and admins,
+	// and use the organization's default template unless one is given
+	if req.OrganizationID != nil {
+		if s.orgRepo == nil {
+			return nil, pkgerrors.ErrValidation
+		}
+		member, err := s.orgRepo.GetMember(ctx, *req.OrganizationID, ownerID)
+		if err != nil {
+			if err == pkgerrors.ErrNotFound {
+				return nil, pkgerrors.ErrForbidden
+			}
+			return nil, err
+		}
+		if member.Role != models.OrgRoleOwner && member.Role != models.OrgRoleAdmin {
+			return nil, pkgerrors.ErrForbidden
+		}
+		if req.TemplateID == nil {
+			org, err := s.orgRepo.GetByID(ctx, *req.OrganizationID)
+			if err != nil {
+				return nil, err
+			}
+			req.TemplateID = org.Settings.DefaultProjectTemplateID
+		}
+	}
+
+	// Former keys of other projects keep resolving, so they can't be reused
+	if isAlias, err := s.projectRepo.IsKeyAlias(ctx, req.OrganizationID, req.Key); err != nil {
+		return nil, err
+	} else if isAlias {
+		return nil, pkgerrors.ErrConflict
+	}
+
 	// Start transaction
 	tx, err := s.db.BeginTx(ctx, nil)
 	if err != nil {
@@ -56,10 +106,11 @@ func (s *ProjectService) Create(ctx context.Context, req *models.CreateProjectRe
 
 	// Create project
 	project := &models.Project{
-		Name:        req.Name,
-		Key:         req.Key,
-		Description: req.Description,
-		OwnerID:     ownerID,
+		Name:           req.Name,
+		Key:            req.Key,
+		Description:    req.Description,
+		OwnerID:        ownerID,
+		OrganizationID: req.OrganizationID,
 	}
 
 	createdProject, err := s.projectRepo.Create(ctx, project)
@@ -119,10 +170,13 @@ func (s *ProjectService) Create(ctx context.Context, req *models.CreateProjectRe
 // createColumnsFromTemplate creates board columns from template config
 func (s *ProjectService) createColumnsFromTemplate(ctx context.Context, projectID int, columns []models.ColumnConfig) error {
 	for _, col := range columns {
-		_, err := s.db.ExecContext(ctx, `
-			INSERT INTO board_columns (project_id, name, position)
-			VALUES ($1, $2, $3)
-		`, projectID, col.Name, col.Position)
+		_, err := s.boardRepo.Create(ctx, &models.BoardColumn{
+			ProjectID: projectID,
+			Name:      col.Name,
+			Position:  col.Position,
+			Statuses:  col.Statuses,
+			WIPLimit:  col.WIPLimit,
+		})
 		if err != nil {
 			return err
 		}
@@ -162,6 +216,11 @@ func (s *ProjectService) GetByID(ctx context.Context, id int, userID int) (*mode
 		return nil, pkgerrors.ErrForbidden
 	}
 
+	project.PreviousKeys, err = s.projectRepo.ListKeyAliases(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
 	return project, nil
 }
 
@@ -196,18 +255,111 @@ func (s *ProjectService) Update(ctx context.Context, id int, req *models.UpdateP
 		project.Description = req.Description
 	}
 
+	// Renaming keeps the old key as an alias, so existing references resolve
+	if req.Key != nil && *req.Key != project.Key {
+		if !projectKeyPattern.MatchString(*req.Key) {
+			return nil, pkgerrors.NewValidationError("key must be 2-10 uppercase letters or digits, starting with a letter")
+		}
+		if err := s.projectRepo.ChangeKey(ctx, id, *req.Key); err != nil {
+			return nil, err
+		}
+		project.Key = *req.Key
+	}
+
 	// Save changes
 	err = s.projectRepo.Update(ctx, project)
 	if err != nil {
 		return nil, err
 	}
 
+	project.PreviousKeys, err = s.projectRepo.ListKeyAliases(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
 	// Invalidate project caches
 	_ = pkgcache.InvalidateAllProjectCaches(ctx, s.cache, id)
 
 	return project, nil
 }
 
+// GetEstimationSettings retrieves the estimation configuration of a project
+func (s *ProjectService) GetEstimationSettings(ctx context.Context, id int, userID int) (*models.EstimationSettings, error) {
+	hasAccess, err := s.userHasAccess(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if !hasAccess {
+		return nil, pkgerrors.ErrForbidden
+	}
+
+	return s.projectRepo.GetEstimationSettings(ctx, id)
+}
+
+// UpdateEstimationSettings configures how a project estimates issues
+// Existing estimates are kept when the allowed values change
+func (s *ProjectService) UpdateEstimationSettings(ctx context.Context, id int, req *models.UpdateEstimationSettingsRequest, userID int) (*models.EstimationSettings, error) {
+	hasPermission, err := s.userHasPermission(ctx, userID, id, []models.ProjectRole{models.RoleOwner, models.RoleAdmin})
+	if err != nil {
+		return nil, err
+	}
+
+	if !hasPermission {
+		return nil, pkgerrors.ErrForbidden
+	}
+
+	settings, err := s.projectRepo.GetEstimationSettings(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.EstimationType != nil {
+		if *req.EstimationType != models.EstimationTypeStoryPoints && *req.EstimationType != models.EstimationTypeTime {
+			return nil, pkgerrors.NewValidationError("estimation_type must be story_points or time")
+		}
+		settings.EstimationType = *req.EstimationType
+	}
+	if req.AllowedStoryPoints != nil {
+		allowed, err := normalizeStoryPoints(*req.AllowedStoryPoints)
+		if err != nil {
+			return nil, err
+		}
+		settings.AllowedStoryPoints = allowed
+	}
+
+	if err := s.projectRepo.UpdateEstimationSettings(ctx, settings); err != nil {
+		return nil, err
+	}
+
+	_ = pkgcache.InvalidateAllProjectCaches(ctx, s.cache, id)
+
+	return settings, nil
+}
+
+// normalizeStoryPoints sorts and deduplicates allowed story point values
+func normalizeStoryPoints(points []float64) ([]float64, error) {
+	normalized := make([]float64, 0, len(points))
+	for _, point := range points {
+		if point < 0 || point > 99999 {
+			return nil, pkgerrors.NewValidationError("story points must be between 0 and 99999")
+		}
+		if point*10 != float64(int(point*10)) {
+			return nil, pkgerrors.NewValidationError("story points allow at most one decimal place")
+		}
+		normalized = append(normalized, point)
+	}
+
+	sort.Float64s(normalized)
+	unique := make([]float64, 0, len(normalized))
+	for _, point := range normalized {
+		if len(unique) == 0 || point != unique[len(unique)-1] {
+			unique = append(unique, point)
+		}
+	}
+	return unique, nil
+}
+
 // Delete deletes a project
 func (s *ProjectService) Delete(ctx context.Context, id int, userID int) error {
 	// Only owner can delete project
@@ -236,7 +388,7 @@ func (s *ProjectService) userHasAccess(ctx context.Context, userID int, projectI
 	var count int
 	err := s.db.QueryRowContext(ctx, `
 		SELECT COUNT(*)
-		FROM project_members
+		FROM project_access
 		WHERE project_id = $1 AND user_id = $2
 	`, projectID, userID).Scan(&count)
 
@@ -252,7 +404,7 @@ func (s *ProjectService) userHasPermission(ctx context.Context, userID int, proj
 	var role string
 	err := s.db.QueryRowContext(ctx, `
 		SELECT role
-		FROM project_members
+		FROM project_access
 		WHERE project_id = $1 AND user_id = $2
 	`, projectID, userID).Scan(&role)
 
@@ -21,7 +21,7 @@ func setupProjectService(t *testing.T) (*ProjectService, *repository.UserReposit
 	projectRepo := repository.NewProjectRepository(db)
 	boardRepo := repository.NewBoardRepository(db)
 	userRepo := repository.NewUserRepository(db)
-	projectService := NewProjectService(projectRepo, boardRepo, db)
+	projectService := NewProjectService(projectRepo, boardRepo, userRepo, db, nil)
 
 	cleanup := func() {
 		db.Exec("DELETE FROM project_members WHERE project_id IN (SELECT id FROM projects WHERE key LIKE 'SVC%')")
@@ -0,0 +1,86 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/yourusername/issue-tracker/internal/models"
+	"github.com/yourusername/issue-tracker/internal/repository"
+)
+
+// ReminderScheduler periodically sends due date reminders to the assignees
+// and watchers of unfinished issues
+// Each reminder is claimed in the database before it is sent, so restarts
+// and several running instances never send the same reminder twice
+type ReminderScheduler struct {
+	reminderRepo        *repository.IssueReminderRepository
+	notificationService *NotificationService
+	interval            time.Duration
+	daysBefore          int
+}
+
+// NewReminderScheduler creates a new reminder scheduler that checks every
+// interval and reminds daysBefore days ahead of due dates
+func NewReminderScheduler(
+	reminderRepo *repository.IssueReminderRepository,
+	notificationService *NotificationService,
+	interval time.Duration,
+	daysBefore int,
+) *ReminderScheduler {
+	return &ReminderScheduler{
+		reminderRepo:        reminderRepo,
+		notificationService: notificationService,
+		interval:            interval,
+		daysBefore:          daysBefore,
+	}
+}
+
+// Run sends reminders every interval until ctx is cancelled
+func (s *ReminderScheduler) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		if err := s.RunOnce(ctx, time.Now()); err != nil {
+			slog.Error("failed to send due date reminders", "error", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// RunOnce sends the reminders pending at now
+func (s *ReminderScheduler) RunOnce(ctx context.Context, now time.Time) error {
+	reminders, err := s.reminderRepo.ListPending(ctx, models.DateOnly(now), s.daysBefore)
+	if err != nil {
+		return err
+	}
+
+	sent := 0
+	for _, reminder := range reminders {
+		claimed, err := s.reminderRepo.Claim(ctx, reminder)
+		if err != nil {
+			slog.Error("failed to claim due date reminder", "error", err, "issue_id", reminder.IssueID, "user_id", reminder.UserID)
+			continue
+		}
+		if !claimed {
+			continue // Sent by another instance
+		}
+
+		if err := s.notificationService.CreateForDueReminder(ctx, reminder); err != nil {
+			slog.Error("failed to send due date reminder", "error", err, "issue_id", reminder.IssueID, "user_id", reminder.UserID)
+			continue
+		}
+		sent++
+	}
+
+	if sent > 0 {
+		slog.Info("due date reminders sent", "count", sent)
+	}
+	return nil
+}
@@ -0,0 +1,237 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/yourusername/issue-tracker/internal/models"
+	"github.com/yourusername/issue-tracker/internal/repository"
+	"github.com/yourusername/issue-tracker/pkg/diff"
+	pkgerrors "github.com/yourusername/issue-tracker/pkg/errors"
+)
+
+// diffContextLines is the number of unchanged lines around each change of a
+// unified diff
+const diffContextLines = 3
+
+// RevisionService handles the revision history of issue descriptions and
+// comments
+type RevisionService struct {
+	revisionRepo   *repository.RevisionRepository
+	issueRepo      *repository.IssueRepository
+	commentRepo    *repository.CommentRepository
+	authService    *AuthorizationService
+	issueService   *IssueService
+	commentService *CommentService
+}
+
+// NewRevisionService creates a new revision service
+func NewRevisionService(revisionRepo *repository.RevisionRepository, issueRepo *repository.IssueRepository, commentRepo *repository.CommentRepository, authService *AuthorizationService, issueService *IssueService, commentService *CommentService) *RevisionService {
+	return &RevisionService{
+		revisionRepo:   revisionRepo,
+		issueRepo:      issueRepo,
+		commentRepo:    commentRepo,
+		authService:    authService,
+		issueService:   issueService,
+		commentService: commentService,
+	}
+}
+
+// Record saves content as the next revision of an entity
+// previous is the text it replaces; it becomes the first revision when the
+// entity has no history yet. Failures are logged and don't fail the change
+// that saved the text
+func (s *RevisionService) Record(ctx context.Context, entityType models.RevisionEntityType, entityID int, previous *models.ContentRevision, content string, authorID int) {
+	if previous != nil && (previous.Content == nil || *previous.Content == "") {
+		previous = nil
+	}
+
+	revision := &models.ContentRevision{
+		EntityType: entityType,
+		EntityID:   entityID,
+		Content:    &content,
+		AuthorID:   &authorID,
+	}
+	if err := s.revisionRepo.Record(ctx, previous, revision); err != nil {
+		slog.Warn("failed to record revision", "error", err, "entity_type", entityType, "entity_id", entityID)
+	}
+}
+
+// DeleteHistory deletes the revisions of a deleted entity
+func (s *RevisionService) DeleteHistory(ctx context.Context, entityType models.RevisionEntityType, entityID int) {
+	if err := s.revisionRepo.DeleteByEntity(ctx, entityType, entityID); err != nil {
+		slog.Warn("failed to delete revisions", "error", err, "entity_type", entityType, "entity_id", entityID)
+	}
+}
+
+// projectOf returns the project of an issue or of a comment's issue
+func (s *RevisionService) projectOf(ctx context.Context, entityType models.RevisionEntityType, entityID int) (int, error) {
+	issueID := entityID
+	switch entityType {
+	case models.RevisionEntityIssue:
+	case models.RevisionEntityComment:
+		comment, err := s.commentRepo.GetByID(ctx, entityID)
+		if err != nil {
+			return 0, err
+		}
+		issueID = comment.IssueID
+	default:
+		return 0, pkgerrors.NewValidationError("entity type must be issue or comment")
+	}
+
+	issue, err := s.issueRepo.GetByID(ctx, issueID)
+	if err != nil {
+		return 0, err
+	}
+	return issue.ProjectID, nil
+}
+
+// List returns the revisions of an issue description or comment, newest first
+func (s *RevisionService) List(ctx context.Context, entityType models.RevisionEntityType, entityID int, userID int) ([]*models.ContentRevision, error) {
+	projectID, err := s.projectOf(ctx, entityType, entityID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.authService.CheckProjectAccess(ctx, projectID, userID); err != nil {
+		return nil, err
+	}
+
+	return s.revisionRepo.List(ctx, entityType, entityID)
+}
+
+// Diff compares two revisions of an issue description or comment
+// Redacted revisions can't be compared
+func (s *RevisionService) Diff(ctx context.Context, entityType models.RevisionEntityType, entityID int, from, to int, mode models.RevisionDiffMode, userID int) (*models.RevisionDiff, error) {
+	if mode == "" {
+		mode = models.RevisionDiffUnified
+	}
+	if !mode.IsValid() {
+		return nil, pkgerrors.NewValidationError("mode must be unified or words")
+	}
+
+	projectID, err := s.projectOf(ctx, entityType, entityID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.authService.CheckProjectAccess(ctx, projectID, userID); err != nil {
+		return nil, err
+	}
+
+	texts := make([]string, 2)
+	for i, number := range []int{from, to} {
+		revision, err := s.revisionRepo.Get(ctx, entityType, entityID, number)
+		if err != nil {
+			return nil, err
+		}
+		if revision.Redacted {
+			return nil, pkgerrors.NewValidationError(fmt.Sprintf("revision %d was redacted", number))
+		}
+		texts[i] = *revision.Content
+	}
+
+	result := &models.RevisionDiff{
+		EntityType: entityType,
+		EntityID:   entityID,
+		From:       from,
+		To:         to,
+		Mode:       mode,
+	}
+	if mode == models.RevisionDiffWords {
+		result.Changes = make([]*models.RevisionDiffChange, 0)
+		for _, op := range diff.Words(texts[0], texts[1]) {
+			result.Changes = append(result.Changes, &models.RevisionDiffChange{Type: string(op.Kind), Text: op.Text})
+		}
+		return result, nil
+	}
+
+	unified := diff.Unified(texts[0], texts[1], fmt.Sprintf("revision %d", from), fmt.Sprintf("revision %d", to), diffContextLines)
+	result.Unified = &unified
+	return result, nil
+}
+
+// restorable returns the content of a revision that can be restored
+func (s *RevisionService) restorable(ctx context.Context, entityType models.RevisionEntityType, entityID, number int) (string, error) {
+	revision, err := s.revisionRepo.Get(ctx, entityType, entityID, number)
+	if err != nil {
+		return "", err
+	}
+	if revision.Redacted {
+		return "", pkgerrors.NewValidationError("a redacted revision can't be restored")
+	}
+	return *revision.Content, nil
+}
+
+// RestoreIssue makes a revision the current description of an issue
+// The restored text is saved as a new revision
+func (s *RevisionService) RestoreIssue(ctx context.Context, issueID, number int, userID int) (*models.Issue, error) {
+	projectID, err := s.projectOf(ctx, models.RevisionEntityIssue, issueID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.authService.CheckWritePermission(ctx, projectID, userID); err != nil {
+		return nil, err
+	}
+
+	content, err := s.restorable(ctx, models.RevisionEntityIssue, issueID, number)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.issueService.Update(ctx, issueID, &models.UpdateIssueRequest{Description: &content}, userID)
+}
+
+// RestoreComment makes a revision the current text of a comment
+// Like editing, only the comment's author may restore it
+func (s *RevisionService) RestoreComment(ctx context.Context, commentID, number int, userID int) (*models.Comment, error) {
+	comment, err := s.commentRepo.GetByID(ctx, commentID)
+	if err != nil {
+		return nil, err
+	}
+	if comment.UserID != userID {
+		return nil, pkgerrors.ErrForbidden
+	}
+
+	content, err := s.restorable(ctx, models.RevisionEntityComment, commentID, number)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.commentService.Update(ctx, commentID, &models.UpdateCommentRequest{Content: content}, userID)
+}
+
+// Redact removes the content of a revision that must not be kept, e.g. one
+// that leaked a secret. Only project admins may redact, and the current
+// revision has to be replaced by editing the text first
+func (s *RevisionService) Redact(ctx context.Context, entityType models.RevisionEntityType, entityID, number int, userID int) (*models.ContentRevision, error) {
+	projectID, err := s.projectOf(ctx, entityType, entityID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.authService.CheckAdminPermission(ctx, projectID, userID); err != nil {
+		return nil, err
+	}
+
+	revision, err := s.revisionRepo.Get(ctx, entityType, entityID, number)
+	if err != nil {
+		return nil, err
+	}
+	if revision.Redacted {
+		return revision, nil
+	}
+
+	latest, err := s.revisionRepo.Latest(ctx, entityType, entityID)
+	if err != nil {
+		return nil, err
+	}
+	if number == latest {
+		return nil, pkgerrors.NewValidationError("the current revision can't be redacted; edit the text first")
+	}
+
+	if err := s.revisionRepo.Redact(ctx, entityType, entityID, number, userID); err != nil {
+		return nil, err
+	}
+
+	slog.Info("revision redacted", "entity_type", entityType, "entity_id", entityID, "revision", number, "user_id", userID)
+	return s.revisionRepo.Get(ctx, entityType, entityID, number)
+}
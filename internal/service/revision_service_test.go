@@ -0,0 +1,86 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/yourusername/issue-tracker/internal/models"
+	"github.com/yourusername/issue-tracker/internal/repository"
+)
+
+func TestRevisionService_Redact(t *testing.T) {
+	issueService, userRepo, projectRepo, _, cleanup := setupIssueService(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	db := issueService.db
+	revisionService := NewRevisionService(repository.NewRevisionRepository(db), issueService.issueRepo, repository.NewCommentRepository(db), issueService.authService, issueService, nil)
+	issueService.SetRevisionService(revisionService)
+
+	// Setup
+	owner, _ := userRepo.Create(ctx, &models.User{Email: "issuesvc47@example.com", Username: "issuesvc47", PasswordHash: "hash"})
+	member, _ := userRepo.Create(ctx, &models.User{Email: "issuesvc48@example.com", Username: "issuesvc48", PasswordHash: "hash"})
+	project, _ := projectRepo.Create(ctx, &models.Project{Name: "Revisions", Key: "ISVC47", OwnerID: owner.ID})
+	db.ExecContext(ctx, "INSERT INTO project_members (project_id, user_id, role) VALUES ($1, $2, $3)", project.ID, member.ID, models.RoleMember)
+
+	first := "Steps to reproduce"
+	issue, err := issueService.Create(ctx, project.ID, &models.CreateIssueRequest{Title: "Leaky", Description: &first}, owner.ID)
+	if err != nil {
+		t.Fatalf("Failed to create issue: %v", err)
+	}
+	defer db.Exec("DELETE FROM content_revisions WHERE entity_type = $1 AND entity_id = $2", models.RevisionEntityIssue, issue.ID)
+
+	for _, description := range []string{"Token: s3cr3t", "Token removed"} {
+		if _, err := issueService.Update(ctx, issue.ID, &models.UpdateIssueRequest{Description: &description}, owner.ID); err != nil {
+			t.Fatalf("Failed to update description: %v", err)
+		}
+	}
+
+	revisions, err := revisionService.List(ctx, models.RevisionEntityIssue, issue.ID, owner.ID)
+	if err != nil || len(revisions) != 3 {
+		t.Fatalf("Expected 3 revisions, got %d (%v)", len(revisions), err)
+	}
+
+	t.Run("should refuse to redact the current revision", func(t *testing.T) {
+		_, err := revisionService.Redact(ctx, models.RevisionEntityIssue, issue.ID, 3, owner.ID)
+		expectAppError(t, err, 400)
+	})
+
+	t.Run("should only let admins redact", func(t *testing.T) {
+		_, err := revisionService.Redact(ctx, models.RevisionEntityIssue, issue.ID, 2, member.ID)
+		expectAppError(t, err, 403)
+	})
+
+	t.Run("should redact an earlier revision", func(t *testing.T) {
+		redacted, err := revisionService.Redact(ctx, models.RevisionEntityIssue, issue.ID, 2, owner.ID)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if !redacted.Redacted || redacted.Content != nil {
+			t.Errorf("Expected the content to be removed, got %+v", redacted)
+		}
+		if redacted.RedactedBy == nil || *redacted.RedactedBy != owner.ID {
+			t.Errorf("Expected redacted by %d, got %v", owner.ID, redacted.RedactedBy)
+		}
+	})
+
+	t.Run("should refuse to restore a redacted revision", func(t *testing.T) {
+		_, err := revisionService.RestoreIssue(ctx, issue.ID, 2, owner.ID)
+		expectAppError(t, err, 400)
+	})
+
+	t.Run("should restore an earlier revision as a new one", func(t *testing.T) {
+		restored, err := revisionService.RestoreIssue(ctx, issue.ID, 1, member.ID)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if restored.Description == nil || *restored.Description != first {
+			t.Errorf("Expected description %q, got %v", first, restored.Description)
+		}
+
+		revisions, err := revisionService.List(ctx, models.RevisionEntityIssue, issue.ID, owner.ID)
+		if err != nil || len(revisions) != 4 {
+			t.Errorf("Expected 4 revisions, got %d (%v)", len(revisions), err)
+		}
+	})
+}
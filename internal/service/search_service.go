@@ -43,7 +43,7 @@ func (s *SearchService) SearchIssues(ctx context.Context, req *models.IssueSearc
 
 		// Check if user is owner or member
 		if project.OwnerID != userID {
-			member, err := s.memberRepo.GetMember(ctx, *req.ProjectID, userID)
+			member, err := s.memberRepo.GetAccess(ctx, *req.ProjectID, userID)
 			if err != nil || member == nil {
 				// User doesn't have access, return empty results
 				return &models.SearchResponse{
@@ -120,7 +120,7 @@ func (s *SearchService) SearchProjects(ctx context.Context, req *models.ProjectS
 		}
 
 		// Check if user is a member
-		member, err := s.memberRepo.GetMember(ctx, project.ID, userID)
+		member, err := s.memberRepo.GetAccess(ctx, project.ID, userID)
 		if err == nil && member != nil {
 			accessibleResults = append(accessibleResults, project)
 		}
@@ -0,0 +1,198 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/yourusername/issue-tracker/internal/models"
+	"github.com/yourusername/issue-tracker/internal/repository"
+	pkgerrors "github.com/yourusername/issue-tracker/pkg/errors"
+)
+
+// ServiceAccountService handles service account business logic
+type ServiceAccountService struct {
+	accountRepo *repository.ServiceAccountRepository
+	projectRepo *repository.ProjectRepository
+	authService *AuthorizationService
+}
+
+// NewServiceAccountService creates a new service account service
+func NewServiceAccountService(
+	accountRepo *repository.ServiceAccountRepository,
+	projectRepo *repository.ProjectRepository,
+	authService *AuthorizationService,
+) *ServiceAccountService {
+	return &ServiceAccountService{
+		accountRepo: accountRepo,
+		projectRepo: projectRepo,
+		authService: authService,
+	}
+}
+
+// Create creates a new service account in a project
+func (s *ServiceAccountService) Create(ctx context.Context, projectID int, req *models.CreateServiceAccountRequest, userID int) (*models.ServiceAccount, error) {
+	// Only admins and owners can manage service accounts
+	if err := s.authService.CheckAdminPermission(ctx, projectID, userID); err != nil {
+		return nil, err
+	}
+
+	username := strings.TrimSpace(req.Username)
+	if len(username) < 3 || len(username) > 100 || models.IsReservedUsername(username) {
+		return nil, pkgerrors.ErrValidation
+	}
+
+	// Service accounts cannot own a project
+	switch req.Role {
+	case models.RoleAdmin, models.RoleMember, models.RoleViewer:
+	default:
+		return nil, pkgerrors.ErrValidation
+	}
+
+	project, err := s.projectRepo.GetByID(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	account := &models.ServiceAccount{
+		User: models.User{
+			// Bots have no mailbox; the address only has to be unique
+			Email:    fmt.Sprintf("%s@%s.bots.invalid", strings.ToLower(username), strings.ToLower(project.Key)),
+			Username: username,
+			Name:     req.Name,
+		},
+		ProjectID: projectID,
+		Role:      req.Role,
+		CreatedBy: &userID,
+	}
+
+	return s.accountRepo.Create(ctx, account)
+}
+
+// List retrieves all service accounts of a project
+func (s *ServiceAccountService) List(ctx context.Context, projectID int, userID int) ([]*models.ServiceAccount, error) {
+	if err := s.authService.CheckProjectAccess(ctx, projectID, userID); err != nil {
+		return nil, err
+	}
+
+	return s.accountRepo.ListByProject(ctx, projectID)
+}
+
+// Delete deactivates a service account: all its tokens are revoked and it
+// loses access to the project, while its past activity remains attributed
+func (s *ServiceAccountService) Delete(ctx context.Context, projectID, accountID int, userID int) error {
+	if err := s.authService.CheckAdminPermission(ctx, projectID, userID); err != nil {
+		return err
+	}
+
+	if _, err := s.accountRepo.GetByID(ctx, projectID, accountID); err != nil {
+		return err
+	}
+
+	return s.accountRepo.Deactivate(ctx, projectID, accountID)
+}
+
+// CreateToken issues a new API token for a service account
+// The plaintext token is only returned here and cannot be retrieved later
+func (s *ServiceAccountService) CreateToken(ctx context.Context, projectID, accountID int, req *models.CreateServiceAccountTokenRequest, userID int) (*models.CreateServiceAccountTokenResponse, error) {
+	if err := s.authService.CheckAdminPermission(ctx, projectID, userID); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.accountRepo.GetByID(ctx, projectID, accountID); err != nil {
+		return nil, err
+	}
+
+	if strings.TrimSpace(req.Name) == "" {
+		return nil, pkgerrors.ErrValidation
+	}
+
+	var expiresAt *time.Time
+	if req.ExpiresInDays != nil {
+		if *req.ExpiresInDays <= 0 {
+			return nil, pkgerrors.ErrValidation
+		}
+		t := time.Now().Add(time.Duration(*req.ExpiresInDays) * 24 * time.Hour)
+		expiresAt = &t
+	}
+
+	plaintext, err := generateServiceAccountToken()
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := s.accountRepo.CreateToken(ctx, &models.ServiceAccountToken{
+		UserID:      accountID,
+		Name:        req.Name,
+		TokenHash:   hashServiceAccountToken(plaintext),
+		TokenPrefix: plaintext[:len(models.ServiceAccountTokenPrefix)+4],
+		CreatedBy:   &userID,
+		ExpiresAt:   expiresAt,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.CreateServiceAccountTokenResponse{
+		ServiceAccountToken: *token,
+		Token:               plaintext,
+	}, nil
+}
+
+// ListTokens retrieves the tokens of a service account (without secrets)
+func (s *ServiceAccountService) ListTokens(ctx context.Context, projectID, accountID int, userID int) ([]*models.ServiceAccountToken, error) {
+	if err := s.authService.CheckAdminPermission(ctx, projectID, userID); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.accountRepo.GetByID(ctx, projectID, accountID); err != nil {
+		return nil, err
+	}
+
+	return s.accountRepo.ListTokens(ctx, accountID)
+}
+
+// RevokeToken revokes a service account token
+func (s *ServiceAccountService) RevokeToken(ctx context.Context, projectID, accountID, tokenID int, userID int) error {
+	if err := s.authService.CheckAdminPermission(ctx, projectID, userID); err != nil {
+		return err
+	}
+
+	if _, err := s.accountRepo.GetByID(ctx, projectID, accountID); err != nil {
+		return err
+	}
+
+	return s.accountRepo.RevokeToken(ctx, accountID, tokenID)
+}
+
+// generateServiceAccountToken creates a random prefixed API token
+func generateServiceAccountToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return models.ServiceAccountTokenPrefix + hex.EncodeToString(b), nil
+}
+
+// hashServiceAccountToken returns the hex SHA-256 hash stored for a token
+func hashServiceAccountToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// rejectServiceAccount refuses service accounts, which act only within the
+// project they belong to and so can't create projects, organizations or teams
+func rejectServiceAccount(ctx context.Context, userRepo *repository.UserRepository, userID int) error {
+	user, err := userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if user.IsBot {
+		return pkgerrors.NewPermissionError("service accounts can only act within their own project")
+	}
+	return nil
+}
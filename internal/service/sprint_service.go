@@ -0,0 +1,474 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/yourusername/issue-tracker/internal/models"
+	"github.com/yourusername/issue-tracker/internal/repository"
+	pkgcache "github.com/yourusername/issue-tracker/pkg/cache"
+	pkgerrors "github.com/yourusername/issue-tracker/pkg/errors"
+)
+
+// SprintService handles sprint business logic
+type SprintService struct {
+	sprintRepo     *repository.SprintRepository
+	boardRepo      *repository.BoardRepository
+	issueRepo      *repository.IssueRepository
+	authService    *AuthorizationService
+	cache          pkgcache.Cache
+	webhookService *WebhookService
+}
+
+// NewSprintService creates a new sprint service
+func NewSprintService(
+	sprintRepo *repository.SprintRepository,
+	boardRepo *repository.BoardRepository,
+	issueRepo *repository.IssueRepository,
+	authService *AuthorizationService,
+	cache pkgcache.Cache,
+	webhookService *WebhookService,
+) *SprintService {
+	return &SprintService{
+		sprintRepo:     sprintRepo,
+		boardRepo:      boardRepo,
+		issueRepo:      issueRepo,
+		authService:    authService,
+		cache:          cache,
+		webhookService: webhookService,
+	}
+}
+
+// Create plans a future sprint on a board
+func (s *SprintService) Create(ctx context.Context, boardID int, req *models.CreateSprintRequest, userID int) (*models.Sprint, error) {
+	board, err := s.getBoard(ctx, boardID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.authService.CheckWritePermission(ctx, board.ProjectID, userID); err != nil {
+		return nil, err
+	}
+
+	sprint := &models.Sprint{
+		BoardID:   board.ID,
+		ProjectID: board.ProjectID,
+		Name:      strings.TrimSpace(req.Name),
+		Goal:      req.Goal,
+		StartDate: req.StartDate,
+		EndDate:   req.EndDate,
+		CreatedBy: &userID,
+	}
+	if err := validateSprint(sprint); err != nil {
+		return nil, err
+	}
+
+	return s.sprintRepo.Create(ctx, sprint)
+}
+
+// List lists the sprints of a board, optionally in one state
+func (s *SprintService) List(ctx context.Context, boardID int, state *models.SprintState, userID int) ([]*models.Sprint, error) {
+	board, err := s.getBoard(ctx, boardID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.authService.CheckProjectAccess(ctx, board.ProjectID, userID); err != nil {
+		return nil, err
+	}
+
+	if state != nil && !isValidSprintState(*state) {
+		return nil, pkgerrors.NewValidationError(fmt.Sprintf("invalid sprint state %q (future, active or closed)", *state))
+	}
+
+	return s.sprintRepo.ListByBoardID(ctx, boardID, state)
+}
+
+// GetByID retrieves a sprint
+func (s *SprintService) GetByID(ctx context.Context, id int, userID int) (*models.Sprint, error) {
+	sprint, err := s.sprintRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.authService.CheckProjectAccess(ctx, sprint.ProjectID, userID); err != nil {
+		return nil, err
+	}
+
+	return sprint, nil
+}
+
+// ListIssues lists the issues of a sprint
+func (s *SprintService) ListIssues(ctx context.Context, id int, userID int) ([]*models.Issue, error) {
+	sprint, err := s.GetByID(ctx, id, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.issueRepo.List(ctx, &models.IssueFilter{ProjectID: sprint.ProjectID, SprintID: &sprint.ID})
+}
+
+// Update updates the name, goal and dates of a sprint that isn't closed
+func (s *SprintService) Update(ctx context.Context, id int, req *models.UpdateSprintRequest, userID int) (*models.Sprint, error) {
+	sprint, err := s.sprintRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.authService.CheckWritePermission(ctx, sprint.ProjectID, userID); err != nil {
+		return nil, err
+	}
+
+	if sprint.State == models.SprintStateClosed {
+		return nil, pkgerrors.NewValidationError("closed sprints can't be changed")
+	}
+
+	if req.Name != nil {
+		sprint.Name = strings.TrimSpace(*req.Name)
+	}
+	if req.Goal != nil {
+		sprint.Goal = req.Goal
+		if *req.Goal == "" {
+			sprint.Goal = nil
+		}
+	}
+	if req.StartDate != nil {
+		sprint.StartDate = req.StartDate
+	}
+	if req.EndDate != nil {
+		sprint.EndDate = req.EndDate
+	}
+	if err := validateSprint(sprint); err != nil {
+		return nil, err
+	}
+
+	if err := s.sprintRepo.Update(ctx, sprint); err != nil {
+		return nil, err
+	}
+
+	return s.sprintRepo.GetByID(ctx, id)
+}
+
+// Delete deletes a future sprint; its issues return to the backlog
+func (s *SprintService) Delete(ctx context.Context, id int, userID int) error {
+	sprint, err := s.sprintRepo.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if err := s.authService.CheckAdminPermission(ctx, sprint.ProjectID, userID); err != nil {
+		return err
+	}
+
+	if sprint.State != models.SprintStateFuture {
+		return pkgerrors.NewValidationError("only future sprints can be deleted")
+	}
+
+	if err := s.sprintRepo.Delete(ctx, id); err != nil {
+		return err
+	}
+
+	_ = pkgcache.InvalidateAllProjectCaches(ctx, s.cache, sprint.ProjectID)
+	return nil
+}
+
+// PlanIssues moves issues of the project into a future or active sprint
+// Issues added to an active sprint count as added after the start in its report
+func (s *SprintService) PlanIssues(ctx context.Context, id int, req *models.PlanSprintRequest, userID int) (*models.Sprint, error) {
+	sprint, err := s.sprintRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.authService.CheckWritePermission(ctx, sprint.ProjectID, userID); err != nil {
+		return nil, err
+	}
+
+	if sprint.State == models.SprintStateClosed {
+		return nil, pkgerrors.NewValidationError("issues can't be planned into a closed sprint")
+	}
+	if len(req.IssueIDs) == 0 {
+		return nil, pkgerrors.NewValidationError("issue_ids is required")
+	}
+
+	moved, err := s.sprintRepo.SetIssueSprint(ctx, sprint.ProjectID, req.IssueIDs, &sprint.ID)
+	if err != nil {
+		return nil, err
+	}
+	if moved != len(uniqueInts(req.IssueIDs)) {
+		return nil, pkgerrors.NewValidationError("some issues don't exist in the sprint's project")
+	}
+
+	_ = pkgcache.InvalidateAllProjectCaches(ctx, s.cache, sprint.ProjectID)
+
+	return s.sprintRepo.GetByID(ctx, id)
+}
+
+// RemoveIssue moves an issue of a sprint back to the backlog
+func (s *SprintService) RemoveIssue(ctx context.Context, id int, issueID int, userID int) error {
+	sprint, err := s.sprintRepo.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if err := s.authService.CheckWritePermission(ctx, sprint.ProjectID, userID); err != nil {
+		return err
+	}
+
+	if sprint.State == models.SprintStateClosed {
+		return pkgerrors.NewValidationError("issues can't be removed from a closed sprint")
+	}
+
+	issue, err := s.issueRepo.GetByID(ctx, issueID)
+	if err != nil && err != pkgerrors.ErrNotFound {
+		return err
+	}
+	if issue == nil || issue.SprintID == nil || *issue.SprintID != sprint.ID {
+		return pkgerrors.NewNotFoundError("Issue is not in this sprint")
+	}
+
+	if _, err := s.sprintRepo.SetIssueSprint(ctx, sprint.ProjectID, []int{issueID}, nil); err != nil {
+		return err
+	}
+
+	_ = pkgcache.InvalidateAllProjectCaches(ctx, s.cache, sprint.ProjectID)
+	return nil
+}
+
+// Start activates a future sprint; a board runs one sprint at a time
+// The issues in the sprint become its committed work
+func (s *SprintService) Start(ctx context.Context, id int, req *models.StartSprintRequest, userID int) (*models.Sprint, error) {
+	sprint, err := s.sprintRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.authService.CheckAdminPermission(ctx, sprint.ProjectID, userID); err != nil {
+		return nil, err
+	}
+
+	if sprint.State != models.SprintStateFuture {
+		return nil, pkgerrors.NewValidationError("only future sprints can be started")
+	}
+
+	if req.StartDate != nil {
+		sprint.StartDate = req.StartDate
+	}
+	if req.EndDate != nil {
+		sprint.EndDate = req.EndDate
+	}
+	if sprint.StartDate == nil {
+		today := time.Now().UTC().Truncate(24 * time.Hour)
+		sprint.StartDate = &today
+	}
+	if sprint.EndDate == nil {
+		return nil, pkgerrors.NewValidationError("end_date is required to start a sprint")
+	}
+	if err := validateSprint(sprint); err != nil {
+		return nil, err
+	}
+
+	if err := s.sprintRepo.Start(ctx, sprint); err != nil {
+		return nil, err
+	}
+
+	started, err := s.sprintRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Deliver webhook event (use background context since this runs async)
+	if s.webhookService != nil {
+		go s.webhookService.DeliverEvent(context.Background(), started.ProjectID, models.EventSprintStarted, userID, started)
+	}
+
+	return started, nil
+}
+
+// Complete closes the active sprint of a board, moves its unfinished issues
+// to the backlog or the next sprint and stores the report of committed
+// versus completed work
+func (s *SprintService) Complete(ctx context.Context, id int, req *models.CompleteSprintRequest, userID int) (*models.Sprint, error) {
+	sprint, err := s.sprintRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.authService.CheckAdminPermission(ctx, sprint.ProjectID, userID); err != nil {
+		return nil, err
+	}
+
+	if sprint.State != models.SprintStateActive {
+		return nil, pkgerrors.NewValidationError("only the active sprint can be completed")
+	}
+
+	moveTo := req.MoveTo
+	if moveTo == "" {
+		moveTo = models.SprintMoveToBacklog
+	}
+
+	var nextSprintID *int
+	switch moveTo {
+	case models.SprintMoveToBacklog:
+	case models.SprintMoveToNextSprint:
+		next, err := s.nextSprint(ctx, sprint, req.NextSprintID)
+		if err != nil {
+			return nil, err
+		}
+		nextSprintID = &next.ID
+	default:
+		return nil, pkgerrors.NewValidationError(fmt.Sprintf("invalid move_to %q (backlog or next_sprint)", moveTo))
+	}
+
+	issues, err := s.issueRepo.List(ctx, &models.IssueFilter{ProjectID: sprint.ProjectID, SprintID: &sprint.ID})
+	if err != nil {
+		return nil, err
+	}
+
+	report := buildSprintReport(sprint, issues)
+	report.MovedTo = moveTo
+	report.NextSprintID = nextSprintID
+
+	if err := s.sprintRepo.Complete(ctx, sprint.ID, report, nextSprintID); err != nil {
+		return nil, err
+	}
+
+	_ = pkgcache.InvalidateAllProjectCaches(ctx, s.cache, sprint.ProjectID)
+
+	completed, err := s.sprintRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Deliver webhook event (use background context since this runs async)
+	if s.webhookService != nil {
+		go s.webhookService.DeliverEvent(context.Background(), completed.ProjectID, models.EventSprintCompleted, userID, completed)
+	}
+
+	return completed, nil
+}
+
+// getBoard retrieves the board a sprint belongs to
+func (s *SprintService) getBoard(ctx context.Context, boardID int) (*models.Board, error) {
+	board, err := s.boardRepo.GetBoard(ctx, boardID)
+	if err == pkgerrors.ErrNotFound {
+		return nil, pkgerrors.NewNotFoundError("Board not found")
+	}
+	return board, err
+}
+
+// GetReport returns the report of a closed sprint
+func (s *SprintService) GetReport(ctx context.Context, id int, userID int) (*models.SprintReport, error) {
+	sprint, err := s.GetByID(ctx, id, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if sprint.Report == nil {
+		return nil, pkgerrors.NewNotFoundError("The sprint has no report until it is completed")
+	}
+
+	return sprint.Report, nil
+}
+
+// nextSprint returns the future sprint receiving the unfinished issues of a
+// sprint: the requested one, otherwise the board's next future sprint
+func (s *SprintService) nextSprint(ctx context.Context, sprint *models.Sprint, requestedID *int) (*models.Sprint, error) {
+	if requestedID == nil {
+		next, err := s.sprintRepo.GetNextFutureSprint(ctx, sprint.BoardID, sprint.ID)
+		if err == pkgerrors.ErrNotFound {
+			return nil, pkgerrors.NewValidationError("the board has no future sprint; plan one or move issues to the backlog")
+		}
+		return next, err
+	}
+
+	next, err := s.sprintRepo.GetByID(ctx, *requestedID)
+	if err == pkgerrors.ErrNotFound {
+		return nil, pkgerrors.NewValidationError("next sprint not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+	if next.BoardID != sprint.BoardID || next.State != models.SprintStateFuture {
+		return nil, pkgerrors.NewValidationError("the next sprint must be a future sprint of the same board")
+	}
+	return next, nil
+}
+
+// buildSprintReport compares the issues committed when a sprint started with
+// the issues in it at completion
+func buildSprintReport(sprint *models.Sprint, issues []*models.Issue) *models.SprintReport {
+	report := &models.SprintReport{
+		CommittedIssues:    len(sprint.CommittedIssueIDs),
+		CompletedIssueIDs:  []int{},
+		IncompleteIssueIDs: []int{},
+		AddedIssueIDs:      []int{},
+		RemovedIssueIDs:    []int{},
+	}
+
+	committed := make(map[int]bool, len(sprint.CommittedIssueIDs))
+	for _, id := range sprint.CommittedIssueIDs {
+		committed[id] = true
+	}
+
+	inSprint := make(map[int]bool, len(issues))
+	for _, issue := range issues {
+		inSprint[issue.ID] = true
+		if issue.StatusCategory == models.StatusCategoryDone {
+			report.CompletedIssueIDs = append(report.CompletedIssueIDs, issue.ID)
+		} else {
+			report.IncompleteIssueIDs = append(report.IncompleteIssueIDs, issue.ID)
+		}
+		if !committed[issue.ID] {
+			report.AddedIssueIDs = append(report.AddedIssueIDs, issue.ID)
+		}
+	}
+
+	for _, id := range sprint.CommittedIssueIDs {
+		if !inSprint[id] {
+			report.RemovedIssueIDs = append(report.RemovedIssueIDs, id)
+		}
+	}
+
+	report.CompletedIssues = len(report.CompletedIssueIDs)
+	report.IncompleteIssues = len(report.IncompleteIssueIDs)
+	report.AddedIssues = len(report.AddedIssueIDs)
+	report.RemovedIssues = len(report.RemovedIssueIDs)
+
+	return report
+}
+
+// validateSprint checks the name and dates of a sprint
+func validateSprint(sprint *models.Sprint) error {
+	if sprint.Name == "" || len(sprint.Name) > 100 {
+		return pkgerrors.NewValidationError("sprint name must be 1 to 100 characters")
+	}
+	if sprint.StartDate != nil && sprint.EndDate != nil && sprint.EndDate.Before(*sprint.StartDate) {
+		return pkgerrors.NewValidationError("end_date must not be before start_date")
+	}
+	return nil
+}
+
+// isValidSprintState checks if the sprint state is valid
+func isValidSprintState(state models.SprintState) bool {
+	switch state {
+	case models.SprintStateFuture, models.SprintStateActive, models.SprintStateClosed:
+		return true
+	}
+	return false
+}
+
+// uniqueInts returns the distinct values of a slice, in order
+func uniqueInts(values []int) []int {
+	seen := make(map[int]bool, len(values))
+	unique := make([]int, 0, len(values))
+	for _, value := range values {
+		if !seen[value] {
+			seen[value] = true
+			unique = append(unique, value)
+		}
+	}
+	return unique
+}
@@ -118,7 +118,7 @@ func (s *StatisticsService) checkProjectAccess(ctx context.Context, projectID in
 	}
 
 	// Check if user is a project member
-	member, err := s.memberRepo.GetMember(ctx, projectID, userID)
+	member, err := s.memberRepo.GetAccess(ctx, projectID, userID)
 	if err != nil {
 		return pkgerrors.ErrForbidden
 	}
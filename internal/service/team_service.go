@@ -0,0 +1,252 @@
+package service
+
+import (
+	"context"
+	"regexp"
+	"strings"
+
+	"github.com/yourusername/issue-tracker/internal/models"
+	"github.com/yourusername/issue-tracker/internal/repository"
+	pkgerrors "github.com/yourusername/issue-tracker/pkg/errors"
+)
+
+// teamSlugPattern matches team slugs; they share the mention charset of usernames
+var teamSlugPattern = regexp.MustCompile(`^[a-z0-9_]{3,30}$`)
+
+// TeamService handles team business logic
+// Teams belong to an organization and are managed by its owners and admins;
+// project admins decide which of the organization's teams get project roles
+type TeamService struct {
+	teamRepo    *repository.TeamRepository
+	orgRepo     *repository.OrganizationRepository
+	orgService  *OrganizationService
+	projectRepo *repository.ProjectRepository
+	authService *AuthorizationService
+}
+
+// NewTeamService creates a new team service
+func NewTeamService(
+	teamRepo *repository.TeamRepository,
+	orgRepo *repository.OrganizationRepository,
+	orgService *OrganizationService,
+	projectRepo *repository.ProjectRepository,
+	authService *AuthorizationService,
+) *TeamService {
+	return &TeamService{
+		teamRepo:    teamRepo,
+		orgRepo:     orgRepo,
+		orgService:  orgService,
+		projectRepo: projectRepo,
+		authService: authService,
+	}
+}
+
+// Create creates a team in an organization
+func (s *TeamService) Create(ctx context.Context, orgID int, req *models.CreateTeamRequest, userID int) (*models.Team, error) {
+	if err := rejectServiceAccount(ctx, s.orgService.userRepo, userID); err != nil {
+		return nil, err
+	}
+	if _, err := s.orgService.requireRole(ctx, orgID, userID, models.OrgRoleOwner, models.OrgRoleAdmin); err != nil {
+		return nil, err
+	}
+
+	name := strings.TrimSpace(req.Name)
+	slug := strings.ToLower(strings.TrimSpace(req.Slug))
+	if name == "" || !teamSlugPattern.MatchString(slug) {
+		return nil, pkgerrors.ErrValidation
+	}
+
+	return s.teamRepo.Create(ctx, &models.Team{
+		OrganizationID: orgID,
+		Name:           name,
+		Slug:           slug,
+		Description:    req.Description,
+		CreatedBy:      &userID,
+	})
+}
+
+// List retrieves the teams of an organization
+func (s *TeamService) List(ctx context.Context, orgID int, userID int) ([]*models.Team, error) {
+	if _, err := s.orgService.requireRole(ctx, orgID, userID); err != nil {
+		return nil, err
+	}
+
+	return s.teamRepo.ListByOrganizationID(ctx, orgID)
+}
+
+// GetByID retrieves a team visible to the current user
+func (s *TeamService) GetByID(ctx context.Context, teamID int, userID int) (*models.Team, error) {
+	return s.getTeam(ctx, teamID, userID)
+}
+
+// Update updates a team
+func (s *TeamService) Update(ctx context.Context, teamID int, req *models.UpdateTeamRequest, userID int) (*models.Team, error) {
+	team, err := s.getTeam(ctx, teamID, userID, models.OrgRoleOwner, models.OrgRoleAdmin)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Name != nil {
+		name := strings.TrimSpace(*req.Name)
+		if name == "" {
+			return nil, pkgerrors.ErrValidation
+		}
+		team.Name = name
+	}
+	if req.Description != nil {
+		team.Description = req.Description
+	}
+
+	if err := s.teamRepo.Update(ctx, team); err != nil {
+		return nil, err
+	}
+
+	return s.teamRepo.GetByID(ctx, teamID)
+}
+
+// Delete deletes a team; its members lose the access granted through it
+func (s *TeamService) Delete(ctx context.Context, teamID int, userID int) error {
+	if _, err := s.getTeam(ctx, teamID, userID, models.OrgRoleOwner, models.OrgRoleAdmin); err != nil {
+		return err
+	}
+
+	return s.teamRepo.Delete(ctx, teamID)
+}
+
+// ListMembers retrieves the members of a team
+func (s *TeamService) ListMembers(ctx context.Context, teamID int, userID int) ([]*models.TeamMember, error) {
+	if _, err := s.getTeam(ctx, teamID, userID); err != nil {
+		return nil, err
+	}
+
+	return s.teamRepo.ListMembers(ctx, teamID)
+}
+
+// AddMember adds an organization member to a team
+// The user immediately gets every project role granted to the team
+func (s *TeamService) AddMember(ctx context.Context, teamID int, req *models.AddTeamMemberRequest, userID int) error {
+	team, err := s.getTeam(ctx, teamID, userID, models.OrgRoleOwner, models.OrgRoleAdmin)
+	if err != nil {
+		return err
+	}
+
+	// Only organization members can join its teams
+	if _, err := s.orgRepo.GetMember(ctx, team.OrganizationID, req.UserID); err != nil {
+		if err == pkgerrors.ErrNotFound {
+			return pkgerrors.ErrValidation
+		}
+		return err
+	}
+
+	return s.teamRepo.AddMember(ctx, &models.TeamMember{
+		TeamID:  teamID,
+		UserID:  req.UserID,
+		AddedBy: &userID,
+	})
+}
+
+// RemoveMember removes a user from a team
+// Members can always leave; removing others requires organization admin
+func (s *TeamService) RemoveMember(ctx context.Context, teamID, memberUserID int, userID int) error {
+	if memberUserID == userID {
+		if _, err := s.getTeam(ctx, teamID, userID); err != nil {
+			return err
+		}
+	} else if _, err := s.getTeam(ctx, teamID, userID, models.OrgRoleOwner, models.OrgRoleAdmin); err != nil {
+		return err
+	}
+
+	return s.teamRepo.RemoveMember(ctx, teamID, memberUserID)
+}
+
+// ListProjectTeams retrieves the teams granted a role in a project
+func (s *TeamService) ListProjectTeams(ctx context.Context, projectID int, userID int) ([]*models.ProjectTeam, error) {
+	if err := s.authService.CheckProjectAccess(ctx, projectID, userID); err != nil {
+		return nil, err
+	}
+
+	return s.teamRepo.ListByProjectID(ctx, projectID)
+}
+
+// AddProjectTeam grants a team of the project's organization a project role
+func (s *TeamService) AddProjectTeam(ctx context.Context, projectID int, req *models.AddProjectTeamRequest, userID int) error {
+	if err := s.authService.CheckAdminPermission(ctx, projectID, userID); err != nil {
+		return err
+	}
+
+	if !isValidTeamProjectRole(req.Role) {
+		return pkgerrors.ErrValidation
+	}
+
+	project, err := s.projectRepo.GetByID(ctx, projectID)
+	if err != nil {
+		return err
+	}
+
+	team, err := s.teamRepo.GetByID(ctx, req.TeamID)
+	if err != nil {
+		if err == pkgerrors.ErrNotFound {
+			return pkgerrors.ErrValidation
+		}
+		return err
+	}
+
+	// Teams can only be granted access to their own organization's projects
+	if project.OrganizationID == nil || *project.OrganizationID != team.OrganizationID {
+		return pkgerrors.ErrValidation
+	}
+
+	return s.teamRepo.AddProject(ctx, &models.ProjectTeam{
+		ProjectID: projectID,
+		TeamID:    req.TeamID,
+		Role:      req.Role,
+		AddedBy:   &userID,
+	})
+}
+
+// UpdateProjectTeamRole changes the project role of a team
+func (s *TeamService) UpdateProjectTeamRole(ctx context.Context, projectID, teamID int, req *models.UpdateProjectTeamRequest, userID int) error {
+	if err := s.authService.CheckAdminPermission(ctx, projectID, userID); err != nil {
+		return err
+	}
+
+	if !isValidTeamProjectRole(req.Role) {
+		return pkgerrors.ErrValidation
+	}
+
+	return s.teamRepo.UpdateProjectRole(ctx, projectID, teamID, req.Role)
+}
+
+// RemoveProjectTeam revokes the project role of a team
+func (s *TeamService) RemoveProjectTeam(ctx context.Context, projectID, teamID int, userID int) error {
+	if err := s.authService.CheckAdminPermission(ctx, projectID, userID); err != nil {
+		return err
+	}
+
+	return s.teamRepo.RemoveProject(ctx, projectID, teamID)
+}
+
+// getTeam retrieves a team, checking the user's role in its organization
+// against the allowed roles when any are given
+func (s *TeamService) getTeam(ctx context.Context, teamID, userID int, allowed ...models.OrganizationRole) (*models.Team, error) {
+	team, err := s.teamRepo.GetByID(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.orgService.requireRole(ctx, team.OrganizationID, userID, allowed...); err != nil {
+		return nil, err
+	}
+
+	return team, nil
+}
+
+// isValidTeamProjectRole checks if a team can be granted the project role
+// Projects have a single owner, so teams get at most admin
+func isValidTeamProjectRole(role models.ProjectRole) bool {
+	switch role {
+	case models.RoleAdmin, models.RoleMember, models.RoleViewer:
+		return true
+	}
+	return false
+}
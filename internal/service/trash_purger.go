@@ -0,0 +1,53 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+	"time"
+)
+
+// TrashPurger periodically deletes issues that have been in the trash for
+// longer than the retention period, together with their attachment files
+type TrashPurger struct {
+	trashService *TrashService
+	interval     time.Duration
+}
+
+// NewTrashPurger creates a new trash purger that checks every interval
+func NewTrashPurger(trashService *TrashService, interval time.Duration) *TrashPurger {
+	return &TrashPurger{
+		trashService: trashService,
+		interval:     interval,
+	}
+}
+
+// Run purges expired issues every interval until ctx is cancelled
+func (p *TrashPurger) Run(ctx context.Context) {
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	for {
+		if err := p.RunOnce(ctx, time.Now()); err != nil {
+			slog.Error("failed to purge trash", "error", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// RunOnce purges the issues whose retention period has ended at now
+func (p *TrashPurger) RunOnce(ctx context.Context, now time.Time) error {
+	purged, err := p.trashService.PurgeExpired(ctx, now)
+	if err != nil {
+		return err
+	}
+
+	if purged > 0 {
+		slog.Info("trash purged", "count", purged)
+	}
+	return nil
+}
@@ -0,0 +1,196 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/yourusername/issue-tracker/internal/models"
+	"github.com/yourusername/issue-tracker/internal/repository"
+	pkgcache "github.com/yourusername/issue-tracker/pkg/cache"
+	pkgerrors "github.com/yourusername/issue-tracker/pkg/errors"
+	"github.com/yourusername/issue-tracker/pkg/storage"
+)
+
+// TrashService handles the trash of deleted issues
+type TrashService struct {
+	issueRepo          *repository.IssueRepository
+	issueService       *IssueService
+	authService        *AuthorizationService
+	storage            storage.Storage
+	cache              pkgcache.Cache
+	webhookService     *WebhookService
+	integrationService *IntegrationService
+	retention          time.Duration
+}
+
+// NewTrashService creates a new trash service; deleted issues are purged
+// after retention, or kept until purged by hand when retention is zero
+func NewTrashService(
+	issueRepo *repository.IssueRepository,
+	issueService *IssueService,
+	authService *AuthorizationService,
+	storage storage.Storage,
+	cache pkgcache.Cache,
+	webhookService *WebhookService,
+	integrationService *IntegrationService,
+	retention time.Duration,
+) *TrashService {
+	return &TrashService{
+		issueRepo:          issueRepo,
+		issueService:       issueService,
+		authService:        authService,
+		storage:            storage,
+		cache:              cache,
+		webhookService:     webhookService,
+		integrationService: integrationService,
+		retention:          retention,
+	}
+}
+
+// List lists the deleted issues of a project (admins only)
+func (s *TrashService) List(ctx context.Context, projectID int, userID int) ([]*models.TrashedIssue, error) {
+	if err := s.authService.CheckAdminPermission(ctx, projectID, userID); err != nil {
+		return nil, err
+	}
+
+	issues, err := s.issueRepo.ListDeleted(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	trashed := make([]*models.TrashedIssue, len(issues))
+	for i, issue := range issues {
+		trashed[i] = &models.TrashedIssue{Issue: issue}
+		if s.retention > 0 {
+			purgeAt := issue.DeletedAt.Add(s.retention)
+			trashed[i].PurgeAt = &purgeAt
+		}
+	}
+	return trashed, nil
+}
+
+// Restore takes an issue out of the trash together with the subtasks deleted
+// with it (admins only)
+// Labels, board position and everything else attached to the issue are kept
+// while it is in the trash; a card whose column was removed goes to the
+// column of its status
+func (s *TrashService) Restore(ctx context.Context, id int, userID int) (*models.Issue, error) {
+	issue, err := s.issueRepo.GetDeletedByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.authService.CheckAdminPermission(ctx, issue.ProjectID, userID); err != nil {
+		return nil, err
+	}
+
+	if issue.ParentIssueID != nil {
+		if _, err := s.issueRepo.GetByID(ctx, *issue.ParentIssueID); err == pkgerrors.ErrNotFound {
+			return nil, pkgerrors.NewValidationError("the parent issue is in the trash, restore it first")
+		} else if err != nil {
+			return nil, err
+		}
+	}
+
+	var columnID *int
+	if issue.ColumnID == nil {
+		column, err := s.issueService.columnForStatus(ctx, issue.ProjectID, issue.Status)
+		if err != nil {
+			return nil, err
+		}
+		if column != nil {
+			columnID = &column.ID
+		}
+	}
+
+	if err := s.issueRepo.Restore(ctx, issue, columnID); err != nil {
+		return nil, err
+	}
+
+	restored, err := s.issueRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.issueService.attachDetails(ctx, restored); err != nil {
+		return nil, err
+	}
+
+	_ = pkgcache.InvalidateAllProjectCaches(ctx, s.cache, restored.ProjectID)
+
+	// Deliver webhook event (use background context since this runs async)
+	if s.webhookService != nil {
+		go s.webhookService.DeliverEvent(context.Background(), restored.ProjectID, models.EventIssueRestored, userID, restored)
+	}
+
+	// Send integration notifications (Slack, Discord, etc.)
+	if s.integrationService != nil {
+		go s.integrationService.SendEvent(context.Background(), restored.ProjectID, models.EventIssueRestored, restored)
+	}
+
+	slog.Info("issue restored", "issue_id", id, "project_id", restored.ProjectID, "user_id", userID)
+	return restored, nil
+}
+
+// Purge permanently deletes an issue from the trash of a project (admins only)
+func (s *TrashService) Purge(ctx context.Context, projectID int, id int, userID int) error {
+	if err := s.authService.CheckAdminPermission(ctx, projectID, userID); err != nil {
+		return err
+	}
+
+	issue, err := s.issueRepo.GetDeletedByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if issue.ProjectID != projectID {
+		return pkgerrors.ErrNotFound
+	}
+
+	if err := s.purge(ctx, id); err != nil {
+		return err
+	}
+
+	slog.Info("issue purged", "issue_id", id, "project_id", projectID, "user_id", userID)
+	return nil
+}
+
+// PurgeExpired permanently deletes the issues deleted before the retention
+// period ending at now, and returns how many were purged
+func (s *TrashService) PurgeExpired(ctx context.Context, now time.Time) (int, error) {
+	if s.retention <= 0 {
+		return 0, nil
+	}
+
+	ids, err := s.issueRepo.ListExpiredDeleted(ctx, now.Add(-s.retention))
+	if err != nil {
+		return 0, err
+	}
+
+	purged := 0
+	for _, id := range ids {
+		if err := s.purge(ctx, id); err != nil {
+			if err != pkgerrors.ErrNotFound { // Purged or restored meanwhile
+				slog.Error("failed to purge issue", "error", err, "issue_id", id)
+			}
+			continue
+		}
+		purged++
+	}
+	return purged, nil
+}
+
+// purge deletes an issue from the database, then the files of its attachments
+func (s *TrashService) purge(ctx context.Context, id int) error {
+	storageKeys, err := s.issueRepo.Purge(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	// Errors are logged but not returned, the issue is already gone
+	for _, key := range storageKeys {
+		if err := s.storage.Delete(key); err != nil {
+			slog.Warn("failed to delete attachment file of purged issue", "error", err, "issue_id", id, "storage_key", key)
+		}
+	}
+	return nil
+}
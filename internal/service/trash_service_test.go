@@ -0,0 +1,110 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/yourusername/issue-tracker/internal/models"
+)
+
+func TestTrashService_Restore(t *testing.T) {
+	issueService, userRepo, projectRepo, boardRepo, cleanup := setupIssueService(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	db := issueService.db
+	issueService.SetBoardRepository(boardRepo)
+	trashService := NewTrashService(issueService.issueRepo, issueService, issueService.authService, nil, nil, nil, nil, 0)
+
+	// Setup
+	owner, _ := userRepo.Create(ctx, &models.User{Email: "issuesvc40@example.com", Username: "issuesvc40", PasswordHash: "hash"})
+	member, _ := userRepo.Create(ctx, &models.User{Email: "issuesvc41@example.com", Username: "issuesvc41", PasswordHash: "hash"})
+	project, _ := projectRepo.Create(ctx, &models.Project{Name: "Trash", Key: "ISVC40", OwnerID: owner.ID})
+	db.ExecContext(ctx, "INSERT INTO project_members (project_id, user_id, role) VALUES ($1, $2, $3)", project.ID, member.ID, models.RoleMember)
+	if err := boardRepo.CreateDefaultColumns(ctx, project.ID); err != nil {
+		t.Fatalf("Failed to create default columns: %v", err)
+	}
+	defaultColumns, err := boardRepo.ListByProjectID(ctx, project.ID)
+	if err != nil || len(defaultColumns) == 0 {
+		t.Fatalf("Failed to list default columns: %v", err)
+	}
+
+	trash := func(title string) *models.Issue {
+		t.Helper()
+		issue, err := issueService.Create(ctx, project.ID, &models.CreateIssueRequest{Title: title}, owner.ID)
+		if err != nil {
+			t.Fatalf("Failed to create issue: %v", err)
+		}
+		if err := issueService.Delete(ctx, issue.ID, owner.ID); err != nil {
+			t.Fatalf("Failed to trash issue: %v", err)
+		}
+		return issue
+	}
+
+	t.Run("should only let admins restore", func(t *testing.T) {
+		issue := trash("Admins only")
+
+		_, err := trashService.Restore(ctx, issue.ID, member.ID)
+		expectAppError(t, err, 403)
+	})
+
+	t.Run("should place a card whose column was removed in the column of its status", func(t *testing.T) {
+		issue, err := issueService.Create(ctx, project.ID, &models.CreateIssueRequest{Title: "Moved board"}, owner.ID)
+		if err != nil {
+			t.Fatalf("Failed to create issue: %v", err)
+		}
+
+		board, err := boardRepo.CreateBoard(ctx, &models.Board{ProjectID: project.ID, Name: "Triage", Swimlane: models.SwimlaneNone}, []*models.BoardColumn{
+			{Name: "Inbox", Position: 0, Statuses: []models.IssueStatus{models.IssueStatusOpen}},
+		})
+		if err != nil {
+			t.Fatalf("Failed to create board: %v", err)
+		}
+		columns, err := boardRepo.ListByBoardID(ctx, board.ID)
+		if err != nil || len(columns) != 1 {
+			t.Fatalf("Failed to list board columns: %v", err)
+		}
+		if _, err := db.ExecContext(ctx, "UPDATE issues SET column_id = $1 WHERE id = $2", columns[0].ID, issue.ID); err != nil {
+			t.Fatalf("Failed to place issue: %v", err)
+		}
+
+		if err := issueService.Delete(ctx, issue.ID, owner.ID); err != nil {
+			t.Fatalf("Failed to trash issue: %v", err)
+		}
+		if err := boardRepo.DeleteBoard(ctx, board.ID); err != nil {
+			t.Fatalf("Failed to delete board: %v", err)
+		}
+
+		restored, err := trashService.Restore(ctx, issue.ID, owner.ID)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if restored.ColumnID == nil || *restored.ColumnID != defaultColumns[0].ID {
+			t.Errorf("Expected column %d, got %v", defaultColumns[0].ID, restored.ColumnID)
+		}
+	})
+
+	t.Run("should refuse a subtask whose parent is in the trash", func(t *testing.T) {
+		parent, err := issueService.Create(ctx, project.ID, &models.CreateIssueRequest{Title: "Parent"}, owner.ID)
+		if err != nil {
+			t.Fatalf("Failed to create parent: %v", err)
+		}
+		subtask, err := issueService.Create(ctx, project.ID, &models.CreateIssueRequest{Title: "Subtask", ParentIssueID: &parent.ID}, owner.ID)
+		if err != nil {
+			t.Fatalf("Failed to create subtask: %v", err)
+		}
+		if err := issueService.Delete(ctx, parent.ID, owner.ID); err != nil {
+			t.Fatalf("Failed to trash parent: %v", err)
+		}
+
+		_, err = trashService.Restore(ctx, subtask.ID, owner.ID)
+		expectAppError(t, err, 400)
+
+		if _, err := trashService.Restore(ctx, parent.ID, owner.ID); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if _, err := issueService.issueRepo.GetByID(ctx, subtask.ID); err != nil {
+			t.Errorf("Expected the subtask to be restored with its parent, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,123 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/yourusername/issue-tracker/internal/auth"
+	"github.com/yourusername/issue-tracker/internal/models"
+	"github.com/yourusername/issue-tracker/internal/repository"
+	pkgerrors "github.com/yourusername/issue-tracker/pkg/errors"
+)
+
+// twoFactorIssuer is the issuer shown in authenticator apps
+const twoFactorIssuer = "Issue Tracker"
+
+// TwoFactorService handles TOTP two-factor authentication of users
+type TwoFactorService struct {
+	twoFactorRepo *repository.TwoFactorRepository
+	userRepo      *repository.UserRepository
+	orgRepo       *repository.OrganizationRepository
+}
+
+// NewTwoFactorService creates a new two-factor service
+func NewTwoFactorService(
+	twoFactorRepo *repository.TwoFactorRepository,
+	userRepo *repository.UserRepository,
+	orgRepo *repository.OrganizationRepository,
+) *TwoFactorService {
+	return &TwoFactorService{
+		twoFactorRepo: twoFactorRepo,
+		userRepo:      userRepo,
+		orgRepo:       orgRepo,
+	}
+}
+
+// Status retrieves the two-factor state of the current user
+func (s *TwoFactorService) Status(ctx context.Context, userID int) (*models.TwoFactorStatus, error) {
+	required, err := s.orgRepo.RequiresTwoFactor(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	status := &models.TwoFactorStatus{Required: required}
+	tf, err := s.twoFactorRepo.Get(ctx, userID)
+	if err == pkgerrors.ErrNotFound {
+		return status, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	status.Enabled = tf.Enabled()
+	status.EnabledAt = tf.EnabledAt
+	return status, nil
+}
+
+// Setup generates a new secret for the current user; it takes effect once a
+// code from it is confirmed with Enable
+func (s *TwoFactorService) Setup(ctx context.Context, userID int) (*models.TwoFactorSetupResponse, error) {
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	// Service accounts authenticate with API tokens only
+	if user.IsBot {
+		return nil, pkgerrors.ErrForbidden
+	}
+
+	secret, err := auth.GenerateTOTPSecret()
+	if err != nil {
+		return nil, err
+	}
+	if err := s.twoFactorRepo.SavePending(ctx, userID, secret); err != nil {
+		return nil, err
+	}
+
+	return &models.TwoFactorSetupResponse{
+		Secret: secret,
+		URI:    auth.TOTPURI(twoFactorIssuer, user.Email, secret),
+	}, nil
+}
+
+// Enable confirms the pending secret with a code from the authenticator app
+func (s *TwoFactorService) Enable(ctx context.Context, userID int, code string) error {
+	tf, err := s.twoFactorRepo.Get(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if tf.Enabled() {
+		return pkgerrors.ErrConflict
+	}
+
+	if !auth.ValidateTOTP(tf.Secret, code, time.Now()) {
+		return pkgerrors.NewValidationError("invalid two-factor authentication code")
+	}
+
+	return s.twoFactorRepo.Enable(ctx, userID)
+}
+
+// Disable turns off two-factor authentication after confirming a code
+// Members of an organization that requires it cannot turn it off
+func (s *TwoFactorService) Disable(ctx context.Context, userID int, code string) error {
+	tf, err := s.twoFactorRepo.Get(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	if tf.Enabled() {
+		required, err := s.orgRepo.RequiresTwoFactor(ctx, userID)
+		if err != nil {
+			return err
+		}
+		if required {
+			return pkgerrors.NewPermissionError("an organization you belong to requires two-factor authentication")
+		}
+
+		if !auth.ValidateTOTP(tf.Secret, code, time.Now()) {
+			return pkgerrors.NewValidationError("invalid two-factor authentication code")
+		}
+	}
+
+	return s.twoFactorRepo.Delete(ctx, userID)
+}
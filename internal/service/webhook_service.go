@@ -19,6 +19,8 @@ import (
 type WebhookService struct {
 	webhookRepo *repository.WebhookRepository
 	authService *AuthorizationService
+	userRepo    *repository.UserRepository
+	projectRepo *repository.ProjectRepository
 	httpClient  *http.Client
 }
 
@@ -33,6 +35,17 @@ func NewWebhookService(webhookRepo *repository.WebhookRepository, authService *A
 	}
 }
 
+// SetUserRepo sets the user repository used to describe the actor in payloads
+func (s *WebhookService) SetUserRepo(userRepo *repository.UserRepository) {
+	s.userRepo = userRepo
+}
+
+// SetProjectRepo sets the project repository used to add the project's
+// current and former keys to payloads
+func (s *WebhookService) SetProjectRepo(projectRepo *repository.ProjectRepository) {
+	s.projectRepo = projectRepo
+}
+
 // Create creates a new webhook
 func (s *WebhookService) Create(ctx context.Context, projectID int, req *models.CreateWebhookRequest, userID int) (*models.Webhook, error) {
 	// Check admin permission
@@ -167,16 +180,15 @@ func (s *WebhookService) DeliverEvent(ctx context.Context, projectID int, eventT
 		return nil
 	}
 
-	// Create minimal actor info for the payload
-	actor := &models.User{ID: actorID}
-
 	payload := &models.WebhookPayload{
-		Event:     eventType,
-		Timestamp: time.Now().UTC(),
-		ProjectID: projectID,
-		Actor:     actor,
-		Data:      data,
+		Event:        eventType,
+		Timestamp:    time.Now().UTC(),
+		ProjectID:    projectID,
+		PreviousKeys: []string{},
+		Actor:        s.payloadActor(ctx, actorID),
+		Data:         data,
 	}
+	s.addProjectKeys(ctx, payload)
 
 	payloadBytes, err := json.Marshal(payload)
 	if err != nil {
@@ -191,6 +203,47 @@ func (s *WebhookService) DeliverEvent(ctx context.Context, projectID int, eventT
 	return nil
 }
 
+// addProjectKeys sets the current and former keys of the payload's project,
+// so receivers can match PROJ-123 references made before a key change
+func (s *WebhookService) addProjectKeys(ctx context.Context, payload *models.WebhookPayload) {
+	if s.projectRepo == nil {
+		return
+	}
+
+	project, err := s.projectRepo.GetByID(ctx, payload.ProjectID)
+	if err != nil {
+		return
+	}
+	payload.ProjectKey = project.Key
+
+	if previousKeys, err := s.projectRepo.ListKeyAliases(ctx, payload.ProjectID); err == nil {
+		payload.PreviousKeys = previousKeys
+	}
+}
+
+// payloadActor returns the public profile of the acting user, so receivers
+// can tell service accounts (is_bot) apart from humans
+func (s *WebhookService) payloadActor(ctx context.Context, actorID int) *models.User {
+	actor := &models.User{ID: actorID}
+	if s.userRepo == nil {
+		return actor
+	}
+
+	user, err := s.userRepo.GetByID(ctx, actorID)
+	if err != nil {
+		return actor
+	}
+
+	return &models.User{
+		ID:           user.ID,
+		Username:     user.Username,
+		Name:         user.Name,
+		AvatarURL:    user.AvatarURL,
+		IsBot:        user.IsBot,
+		BotProjectID: user.BotProjectID,
+	}
+}
+
 // deliverToWebhook sends the payload to a single webhook
 func (s *WebhookService) deliverToWebhook(ctx context.Context, webhook *models.Webhook, eventType string, payloadBytes []byte) {
 	delivery := &models.WebhookDelivery{
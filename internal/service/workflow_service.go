@@ -0,0 +1,409 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/yourusername/issue-tracker/internal/models"
+	"github.com/yourusername/issue-tracker/internal/repository"
+	pkgerrors "github.com/yourusername/issue-tracker/pkg/errors"
+)
+
+// workflowStatusKeyPattern matches workflow status keys stored on issues
+var workflowStatusKeyPattern = regexp.MustCompile(`^[a-z0-9_]{1,50}$`)
+
+// WorkflowService handles workflow business logic
+type WorkflowService struct {
+	workflowRepo *repository.WorkflowRepository
+	issueRepo    *repository.IssueRepository
+	authService  *AuthorizationService
+}
+
+// NewWorkflowService creates a new workflow service
+func NewWorkflowService(
+	workflowRepo *repository.WorkflowRepository,
+	issueRepo *repository.IssueRepository,
+	authService *AuthorizationService,
+) *WorkflowService {
+	return &WorkflowService{
+		workflowRepo: workflowRepo,
+		issueRepo:    issueRepo,
+		authService:  authService,
+	}
+}
+
+// Create creates a workflow for a project
+func (s *WorkflowService) Create(ctx context.Context, projectID int, req *models.WorkflowRequest, userID int) (*models.Workflow, error) {
+	if err := s.authService.CheckAdminPermission(ctx, projectID, userID); err != nil {
+		return nil, err
+	}
+
+	workflow, err := buildWorkflow(projectID, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.workflowRepo.Create(ctx, workflow)
+}
+
+// List retrieves the workflows of a project
+// Projects without workflows return the built-in default workflow
+func (s *WorkflowService) List(ctx context.Context, projectID int, userID int) ([]*models.Workflow, error) {
+	if err := s.authService.CheckProjectAccess(ctx, projectID, userID); err != nil {
+		return nil, err
+	}
+
+	workflows, err := s.workflowRepo.ListByProjectID(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(workflows) == 0 {
+		return []*models.Workflow{models.DefaultWorkflow(projectID)}, nil
+	}
+
+	return workflows, nil
+}
+
+// GetByID retrieves a workflow
+func (s *WorkflowService) GetByID(ctx context.Context, id int, userID int) (*models.Workflow, error) {
+	workflow, err := s.workflowRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.authService.CheckProjectAccess(ctx, workflow.ProjectID, userID); err != nil {
+		return nil, err
+	}
+
+	return workflow, nil
+}
+
+// Update replaces the definition of a workflow
+// Issues take the new category of their status; issues in a status that no
+// longer exists can move to any status of the workflow
+func (s *WorkflowService) Update(ctx context.Context, id int, req *models.WorkflowRequest, userID int) (*models.Workflow, error) {
+	existing, err := s.workflowRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.authService.CheckAdminPermission(ctx, existing.ProjectID, userID); err != nil {
+		return nil, err
+	}
+
+	workflow, err := buildWorkflow(existing.ProjectID, req)
+	if err != nil {
+		return nil, err
+	}
+	workflow.ID = id
+
+	return s.workflowRepo.Replace(ctx, workflow)
+}
+
+// Delete deletes a workflow
+func (s *WorkflowService) Delete(ctx context.Context, id int, userID int) error {
+	workflow, err := s.workflowRepo.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if err := s.authService.CheckAdminPermission(ctx, workflow.ProjectID, userID); err != nil {
+		return err
+	}
+
+	return s.workflowRepo.Delete(ctx, id)
+}
+
+// WorkflowFor returns the workflow an issue type uses in a project,
+// falling back to the built-in default workflow
+func (s *WorkflowService) WorkflowFor(ctx context.Context, projectID int, issueType models.IssueType) (*models.Workflow, error) {
+	workflow, err := s.workflowRepo.GetForIssueType(ctx, projectID, issueType)
+	if err != nil {
+		if err == pkgerrors.ErrNotFound {
+			return models.DefaultWorkflow(projectID), nil
+		}
+		return nil, err
+	}
+
+	return workflow, nil
+}
+
+// GetTransitions returns the transitions the user can perform on an issue
+func (s *WorkflowService) GetTransitions(ctx context.Context, issueID int, userID int) ([]*models.WorkflowTransition, error) {
+	issue, err := s.issueRepo.GetByID(ctx, issueID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.authService.CheckProjectAccess(ctx, issue.ProjectID, userID); err != nil {
+		return nil, err
+	}
+
+	workflow, err := s.WorkflowFor(ctx, issue.ProjectID, issue.IssueType)
+	if err != nil {
+		return nil, err
+	}
+
+	role, err := s.authService.GetUserRole(ctx, issue.ProjectID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	available := make([]*models.WorkflowTransition, 0)
+	if models.ProjectRole(role) == models.RoleViewer {
+		return available, nil
+	}
+
+	for _, transition := range s.candidateTransitions(workflow, issue.Status) {
+		if !transition.AllowsRole(models.ProjectRole(role)) {
+			continue
+		}
+		option := *transition
+		option.To = workflow.Status(transition.ToStatus)
+		available = append(available, &option)
+	}
+
+	return available, nil
+}
+
+// ApplyTransition validates moving an issue to a new status and applies the
+// status, its category and the resolution to the issue
+// The issue must already carry the other changes of the request, so
+// required fields are checked against the values being saved
+func (s *WorkflowService) ApplyTransition(ctx context.Context, issue *models.Issue, to models.IssueStatus, userID int) error {
+	workflow, err := s.WorkflowFor(ctx, issue.ProjectID, issue.IssueType)
+	if err != nil {
+		return err
+	}
+
+	target := workflow.Status(to)
+	if target == nil {
+		return pkgerrors.NewValidationError(fmt.Sprintf("status %q is not part of the %s workflow", to, workflow.Name))
+	}
+
+	if issue.Status != to {
+		if err := s.checkTransition(ctx, workflow, issue, to, userID); err != nil {
+			return err
+		}
+	}
+
+	issue.Status = target.Key
+	issue.StatusCategory = target.Category
+	if target.Category != models.StatusCategoryDone {
+		issue.Resolution = nil
+	}
+
+	return nil
+}
+
+// checkTransition verifies that a transition to the status exists, that the
+// user's role may perform it and that its required fields are set
+func (s *WorkflowService) checkTransition(ctx context.Context, workflow *models.Workflow, issue *models.Issue, to models.IssueStatus, userID int) error {
+	var matching []*models.WorkflowTransition
+	for _, transition := range s.candidateTransitions(workflow, issue.Status) {
+		if transition.ToStatus == to {
+			matching = append(matching, transition)
+		}
+	}
+
+	if len(matching) == 0 {
+		return pkgerrors.NewValidationError(fmt.Sprintf("transition from %q to %q is not allowed", issue.Status, to))
+	}
+
+	role, err := s.authService.GetUserRole(ctx, issue.ProjectID, userID)
+	if err != nil {
+		return err
+	}
+
+	var missing []string
+	for _, transition := range matching {
+		if !transition.AllowsRole(models.ProjectRole(role)) {
+			continue
+		}
+		missing = missingTransitionFields(transition, issue)
+		if len(missing) == 0 {
+			return nil
+		}
+	}
+
+	if missing == nil {
+		return pkgerrors.NewPermissionError(fmt.Sprintf("your role cannot move issues to %q", to))
+	}
+
+	return pkgerrors.NewValidationError("transition requires: " + strings.Join(missing, ", "))
+}
+
+// candidateTransitions returns the transitions leaving the issue's status
+// Issues in a status the workflow doesn't know (after the workflow changed)
+// can move to any status so they are never stuck
+func (s *WorkflowService) candidateTransitions(workflow *models.Workflow, from models.IssueStatus) []*models.WorkflowTransition {
+	if workflow.Status(from) != nil {
+		return workflow.TransitionsFrom(from)
+	}
+
+	transitions := make([]*models.WorkflowTransition, 0, len(workflow.Statuses))
+	for _, status := range workflow.Statuses {
+		transitions = append(transitions, &models.WorkflowTransition{
+			WorkflowID:     workflow.ID,
+			Name:           status.Name,
+			ToStatus:       status.Key,
+			RequiredFields: []string{},
+		})
+	}
+	return transitions
+}
+
+// missingTransitionFields returns the required fields of a transition the issue lacks
+func missingTransitionFields(transition *models.WorkflowTransition, issue *models.Issue) []string {
+	missing := make([]string, 0)
+	for _, field := range transition.RequiredFields {
+		switch field {
+		case models.TransitionFieldResolution:
+			if issue.Resolution == nil || strings.TrimSpace(*issue.Resolution) == "" {
+				missing = append(missing, field)
+			}
+		case models.TransitionFieldAssignee:
+			if issue.AssigneeID == nil && issue.AssigneeTeamID == nil {
+				missing = append(missing, field)
+			}
+		case models.TransitionFieldMilestone:
+			if issue.MilestoneID == nil {
+				missing = append(missing, field)
+			}
+		case models.TransitionFieldDescription:
+			if issue.Description == nil || strings.TrimSpace(*issue.Description) == "" {
+				missing = append(missing, field)
+			}
+		}
+	}
+	return missing
+}
+
+// buildWorkflow validates a workflow request and converts it to a workflow
+func buildWorkflow(projectID int, req *models.WorkflowRequest) (*models.Workflow, error) {
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return nil, pkgerrors.NewValidationError("workflow name is required")
+	}
+	if len(req.Statuses) == 0 {
+		return nil, pkgerrors.NewValidationError("a workflow needs at least one status")
+	}
+
+	workflow := &models.Workflow{
+		ProjectID:   projectID,
+		Name:        name,
+		IsDefault:   req.IsDefault,
+		IssueTypes:  req.IssueTypes,
+		Statuses:    make([]*models.WorkflowStatus, 0, len(req.Statuses)),
+		Transitions: make([]*models.WorkflowTransition, 0, len(req.Transitions)),
+	}
+
+	initial := 0
+	for i, status := range req.Statuses {
+		if status == nil || !workflowStatusKeyPattern.MatchString(string(status.Key)) {
+			return nil, pkgerrors.NewValidationError("status keys must be 1-50 lowercase letters, digits or underscores")
+		}
+		if workflow.Status(status.Key) != nil {
+			return nil, pkgerrors.NewValidationError(fmt.Sprintf("duplicate status %q", status.Key))
+		}
+		if !models.IsValidStatusCategory(status.Category) {
+			return nil, pkgerrors.NewValidationError(fmt.Sprintf("status %q has an invalid category", status.Key))
+		}
+		if status.IsInitial {
+			initial++
+		}
+
+		statusName := strings.TrimSpace(status.Name)
+		if statusName == "" {
+			statusName = string(status.Key)
+		}
+		workflow.Statuses = append(workflow.Statuses, &models.WorkflowStatus{
+			Key:       status.Key,
+			Name:      statusName,
+			Category:  status.Category,
+			Position:  i,
+			IsInitial: status.IsInitial,
+		})
+	}
+
+	switch initial {
+	case 0:
+		workflow.Statuses[0].IsInitial = true
+	case 1:
+	default:
+		return nil, pkgerrors.NewValidationError("a workflow can have only one initial status")
+	}
+
+	for _, transition := range req.Transitions {
+		if transition == nil || workflow.Status(transition.ToStatus) == nil {
+			return nil, pkgerrors.NewValidationError("transitions must target a status of the workflow")
+		}
+		if transition.FromStatus != nil {
+			if workflow.Status(*transition.FromStatus) == nil {
+				return nil, pkgerrors.NewValidationError(fmt.Sprintf("unknown status %q", *transition.FromStatus))
+			}
+			if *transition.FromStatus == transition.ToStatus {
+				return nil, pkgerrors.NewValidationError("a transition must change the status")
+			}
+		}
+		for _, role := range transition.AllowedRoles {
+			if !isValidProjectRole(role) {
+				return nil, pkgerrors.NewValidationError(fmt.Sprintf("invalid role %q", role))
+			}
+		}
+		requiredFields := make([]string, 0, len(transition.RequiredFields))
+		for _, field := range transition.RequiredFields {
+			if !models.IsValidTransitionField(field) {
+				return nil, pkgerrors.NewValidationError(fmt.Sprintf("unsupported required field %q", field))
+			}
+			requiredFields = append(requiredFields, field)
+		}
+
+		transitionName := strings.TrimSpace(transition.Name)
+		if transitionName == "" {
+			transitionName = workflow.Status(transition.ToStatus).Name
+		}
+		workflow.Transitions = append(workflow.Transitions, &models.WorkflowTransition{
+			Name:           transitionName,
+			FromStatus:     transition.FromStatus,
+			ToStatus:       transition.ToStatus,
+			AllowedRoles:   transition.AllowedRoles,
+			RequiredFields: requiredFields,
+		})
+	}
+
+	seenTypes := make(map[models.IssueType]bool)
+	for _, issueType := range req.IssueTypes {
+		if !isValidIssueType(issueType) || seenTypes[issueType] {
+			return nil, pkgerrors.NewValidationError(fmt.Sprintf("invalid issue type %q", issueType))
+		}
+		seenTypes[issueType] = true
+	}
+	if workflow.IssueTypes == nil {
+		workflow.IssueTypes = []models.IssueType{}
+	}
+
+	return workflow, nil
+}
+
+// isValidIssueType checks if the issue type exists
+func isValidIssueType(issueType models.IssueType) bool {
+	switch issueType {
+	case models.IssueTypeBug, models.IssueTypeImprovement, models.IssueTypeEpic,
+		models.IssueTypeFeature, models.IssueTypeTask, models.IssueTypeSubtask:
+		return true
+	}
+	return false
+}
+
+// isValidProjectRole checks if the project role exists
+func isValidProjectRole(role models.ProjectRole) bool {
+	switch role {
+	case models.RoleOwner, models.RoleAdmin, models.RoleMember, models.RoleViewer:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,208 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/yourusername/issue-tracker/internal/models"
+	"github.com/yourusername/issue-tracker/internal/repository"
+	pkgerrors "github.com/yourusername/issue-tracker/pkg/errors"
+)
+
+// expectAppError fails the test unless err is an AppError with the status code
+func expectAppError(t *testing.T, err error, statusCode int) {
+	t.Helper()
+	appErr, ok := err.(*pkgerrors.AppError)
+	if !ok {
+		t.Fatalf("Expected AppError with status %d, got %v", statusCode, err)
+	}
+	if appErr.StatusCode != statusCode {
+		t.Errorf("Expected status %d, got %d (%s)", statusCode, appErr.StatusCode, appErr.Message)
+	}
+}
+
+func TestWorkflowService_Transitions(t *testing.T) {
+	issueService, userRepo, projectRepo, _, cleanup := setupIssueService(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	db := issueService.db
+	defer db.Exec("DELETE FROM workflows WHERE project_id IN (SELECT id FROM projects WHERE key LIKE 'ISVC%')")
+
+	workflowService := NewWorkflowService(repository.NewWorkflowRepository(db), issueService.issueRepo, NewAuthorizationService(projectRepo, repository.NewProjectMemberRepository(db)))
+	issueService.SetWorkflowService(workflowService)
+
+	// Setup
+	owner, _ := userRepo.Create(ctx, &models.User{Email: "issuesvc30@example.com", Username: "issuesvc30", PasswordHash: "hash"})
+	member, _ := userRepo.Create(ctx, &models.User{Email: "issuesvc31@example.com", Username: "issuesvc31", PasswordHash: "hash"})
+	project, _ := projectRepo.Create(ctx, &models.Project{Name: "Workflow", Key: "ISVC30", OwnerID: owner.ID})
+	db.ExecContext(ctx, "INSERT INTO project_members (project_id, user_id, role) VALUES ($1, $2, $3)", project.ID, member.ID, models.RoleMember)
+
+	todo, doing, done := models.IssueStatus("todo"), models.IssueStatus("doing"), models.IssueStatus("done")
+	_, err := workflowService.Create(ctx, project.ID, &models.WorkflowRequest{
+		Name:      "Delivery",
+		IsDefault: true,
+		Statuses: []*models.WorkflowStatus{
+			{Key: todo, Name: "To do", Category: models.StatusCategoryTodo, IsInitial: true},
+			{Key: doing, Name: "Doing", Category: models.StatusCategoryInProgress},
+			{Key: done, Name: "Done", Category: models.StatusCategoryDone},
+		},
+		Transitions: []*models.WorkflowTransition{
+			{FromStatus: &todo, ToStatus: doing},
+			{FromStatus: &doing, ToStatus: done, RequiredFields: []string{models.TransitionFieldResolution}},
+			{FromStatus: &done, ToStatus: todo, AllowedRoles: []models.ProjectRole{models.RoleOwner, models.RoleAdmin}},
+		},
+	}, owner.ID)
+	if err != nil {
+		t.Fatalf("Failed to create workflow: %v", err)
+	}
+
+	issue, err := issueService.Create(ctx, project.ID, &models.CreateIssueRequest{Title: "Ship it"}, member.ID)
+	if err != nil {
+		t.Fatalf("Failed to create issue: %v", err)
+	}
+
+	t.Run("should start issues in the initial status", func(t *testing.T) {
+		if issue.Status != todo || issue.StatusCategory != models.StatusCategoryTodo {
+			t.Errorf("Expected todo/todo, got %s/%s", issue.Status, issue.StatusCategory)
+		}
+	})
+
+	t.Run("should reject a transition the workflow doesn't allow", func(t *testing.T) {
+		_, err := issueService.Update(ctx, issue.ID, &models.UpdateIssueRequest{Status: &done}, member.ID)
+		expectAppError(t, err, 400)
+	})
+
+	t.Run("should reject a status outside the workflow", func(t *testing.T) {
+		closed := models.IssueStatusClosed
+		_, err := issueService.Update(ctx, issue.ID, &models.UpdateIssueRequest{Status: &closed}, member.ID)
+		expectAppError(t, err, 400)
+	})
+
+	t.Run("should set the status category", func(t *testing.T) {
+		updated, err := issueService.Update(ctx, issue.ID, &models.UpdateIssueRequest{Status: &doing}, member.ID)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if updated.StatusCategory != models.StatusCategoryInProgress {
+			t.Errorf("Expected category in_progress, got %s", updated.StatusCategory)
+		}
+	})
+
+	t.Run("should require a resolution when moving to done", func(t *testing.T) {
+		_, err := issueService.Update(ctx, issue.ID, &models.UpdateIssueRequest{Status: &done}, member.ID)
+		expectAppError(t, err, 400)
+
+		resolution := "fixed"
+		updated, err := issueService.Update(ctx, issue.ID, &models.UpdateIssueRequest{Status: &done, Resolution: &resolution}, member.ID)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if updated.StatusCategory != models.StatusCategoryDone {
+			t.Errorf("Expected category done, got %s", updated.StatusCategory)
+		}
+		if updated.Resolution == nil || *updated.Resolution != resolution {
+			t.Errorf("Expected resolution %s, got %v", resolution, updated.Resolution)
+		}
+	})
+
+	t.Run("should reject a transition the role may not perform", func(t *testing.T) {
+		_, err := issueService.Update(ctx, issue.ID, &models.UpdateIssueRequest{Status: &todo}, member.ID)
+		expectAppError(t, err, 403)
+	})
+
+	t.Run("should clear the resolution when reopened", func(t *testing.T) {
+		updated, err := issueService.Update(ctx, issue.ID, &models.UpdateIssueRequest{Status: &todo}, owner.ID)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if updated.Resolution != nil {
+			t.Errorf("Expected no resolution, got %s", *updated.Resolution)
+		}
+	})
+
+	t.Run("should list only the transitions available to the user", func(t *testing.T) {
+		transitions, err := workflowService.GetTransitions(ctx, issue.ID, member.ID)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if len(transitions) != 1 || transitions[0].ToStatus != doing {
+			t.Errorf("Expected a single transition to doing, got %d", len(transitions))
+		}
+	})
+
+	t.Run("should close duplicates only through an allowed transition", func(t *testing.T) {
+		linkRepo := repository.NewIssueLinkRepository(db)
+		linkService := NewIssueLinkService(linkRepo, issueService.issueRepo, repository.NewIssueWatcherRepository(db), issueService, issueService.authService)
+		original, err := issueService.Create(ctx, project.ID, &models.CreateIssueRequest{Title: "Original"}, member.ID)
+		if err != nil {
+			t.Fatalf("Failed to create issue: %v", err)
+		}
+		duplicate, err := issueService.Create(ctx, project.ID, &models.CreateIssueRequest{Title: "Duplicate"}, member.ID)
+		if err != nil {
+			t.Fatalf("Failed to create issue: %v", err)
+		}
+
+		// No transition leads from todo to done
+		_, err = linkService.Create(ctx, duplicate.ID, &models.CreateIssueLinkRequest{
+			TargetIssueID: original.ID,
+			Relation:      "duplicates",
+		}, member.ID)
+		expectAppError(t, err, 400)
+
+		links, err := linkRepo.ListByIssueID(ctx, duplicate.ID)
+		if err != nil {
+			t.Fatalf("Failed to list links: %v", err)
+		}
+		if len(links) != 0 {
+			t.Errorf("Expected the link to be removed, got %d links", len(links))
+		}
+
+		if _, err := issueService.Update(ctx, duplicate.ID, &models.UpdateIssueRequest{Status: &doing}, member.ID); err != nil {
+			t.Fatalf("Failed to start issue: %v", err)
+		}
+		_, err = linkService.Create(ctx, duplicate.ID, &models.CreateIssueLinkRequest{
+			TargetIssueID: original.ID,
+			Relation:      "duplicates",
+		}, member.ID)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		closed, err := issueService.issueRepo.GetByID(ctx, duplicate.ID)
+		if err != nil {
+			t.Fatalf("Failed to get issue: %v", err)
+		}
+		if closed.Status != done || closed.Resolution == nil || *closed.Resolution != models.ResolutionDuplicate {
+			t.Errorf("Expected the duplicate to be closed, got %s/%v", closed.Status, closed.Resolution)
+		}
+	})
+
+	t.Run("should recompute categories when the workflow is replaced", func(t *testing.T) {
+		workflows, err := workflowService.List(ctx, project.ID, owner.ID)
+		if err != nil || len(workflows) != 1 {
+			t.Fatalf("Failed to list workflows: %v", err)
+		}
+
+		// "todo" now counts as work in progress
+		_, err = workflowService.Update(ctx, workflows[0].ID, &models.WorkflowRequest{
+			Name:      "Delivery",
+			IsDefault: true,
+			Statuses: []*models.WorkflowStatus{
+				{Key: todo, Name: "To do", Category: models.StatusCategoryInProgress, IsInitial: true},
+				{Key: done, Name: "Done", Category: models.StatusCategoryDone},
+			},
+		}, owner.ID)
+		if err != nil {
+			t.Fatalf("Failed to replace workflow: %v", err)
+		}
+
+		updated, err := issueService.issueRepo.GetByID(ctx, issue.ID)
+		if err != nil {
+			t.Fatalf("Failed to get issue: %v", err)
+		}
+		if updated.StatusCategory != models.StatusCategoryInProgress {
+			t.Errorf("Expected category in_progress, got %s", updated.StatusCategory)
+		}
+	})
+}
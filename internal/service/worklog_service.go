@@ -0,0 +1,301 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+	"strconv"
+	"time"
+
+	"github.com/yourusername/issue-tracker/internal/models"
+	"github.com/yourusername/issue-tracker/internal/repository"
+	pkgerrors "github.com/yourusername/issue-tracker/pkg/errors"
+)
+
+// maxTimesheetRange is the longest period a timesheet report covers
+const maxTimesheetRange = 366 * 24 * time.Hour
+
+// WorklogService handles time tracking business logic
+type WorklogService struct {
+	worklogRepo     *repository.WorklogRepository
+	issueRepo       *repository.IssueRepository
+	authService     *AuthorizationService
+	activityService *ActivityService
+}
+
+// NewWorklogService creates a new worklog service
+func NewWorklogService(
+	worklogRepo *repository.WorklogRepository,
+	issueRepo *repository.IssueRepository,
+	authService *AuthorizationService,
+	activityService *ActivityService,
+) *WorklogService {
+	return &WorklogService{
+		worklogRepo:     worklogRepo,
+		issueRepo:       issueRepo,
+		authService:     authService,
+		activityService: activityService,
+	}
+}
+
+// Create logs work on an issue and adjusts its remaining estimate
+func (s *WorklogService) Create(ctx context.Context, issueID int, req *models.CreateWorklogRequest, userID int) (*models.Worklog, error) {
+	issue, err := s.issueRepo.GetByID(ctx, issueID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.authService.CheckWritePermission(ctx, issue.ProjectID, userID); err != nil {
+		return nil, err
+	}
+
+	if req.TimeSpentMinutes <= 0 {
+		return nil, pkgerrors.NewValidationError("time_spent_minutes must be positive")
+	}
+	if req.RemainingEstimateMinutes != nil && *req.RemainingEstimateMinutes < 0 {
+		return nil, pkgerrors.NewValidationError("remaining_estimate_minutes must not be negative")
+	}
+
+	startedAt := time.Now()
+	if req.StartedAt != nil {
+		startedAt = *req.StartedAt
+	}
+
+	created, err := s.worklogRepo.Create(ctx, &models.Worklog{
+		IssueID:          issueID,
+		UserID:           userID,
+		TimeSpentMinutes: req.TimeSpentMinutes,
+		StartedAt:        startedAt,
+		Description:      req.Description,
+	})
+	if err != nil {
+		slog.Error("failed to create worklog", "error", err, "issue_id", issueID)
+		return nil, err
+	}
+
+	if err := s.adjustRemainingEstimate(ctx, issue, req); err != nil {
+		slog.Error("failed to adjust remaining estimate", "error", err, "issue_id", issueID)
+	}
+
+	s.logActivity(ctx, issue, created.ID, userID, "worklog_added", nil, strPtr(strconv.Itoa(created.TimeSpentMinutes)))
+
+	slog.Info("worklog created", "id", created.ID, "issue_id", issueID, "user_id", userID)
+	return created, nil
+}
+
+// adjustRemainingEstimate sets the remaining estimate after work is logged:
+// the requested value, otherwise the previous remaining estimate (or the
+// original estimate less all logged time) reduced by the time spent
+func (s *WorklogService) adjustRemainingEstimate(ctx context.Context, issue *models.Issue, req *models.CreateWorklogRequest) error {
+	var remaining int
+	switch {
+	case req.RemainingEstimateMinutes != nil:
+		remaining = *req.RemainingEstimateMinutes
+	case issue.RemainingMinutes != nil:
+		remaining = *issue.RemainingMinutes - req.TimeSpentMinutes
+	case issue.EstimateMinutes != nil:
+		spent, err := s.worklogRepo.SumTimeSpent(ctx, issue.ID)
+		if err != nil {
+			return err
+		}
+		remaining = *issue.EstimateMinutes - spent
+	default:
+		return nil // Nothing estimated
+	}
+
+	if remaining < 0 {
+		remaining = 0
+	}
+	return s.worklogRepo.SetRemainingEstimate(ctx, issue.ID, &remaining)
+}
+
+// shiftRemainingEstimate moves the remaining estimate by delta minutes when a
+// worklog's time changes or it is deleted, giving back time that is no longer
+// logged; issues without a remaining estimate are left alone
+func (s *WorklogService) shiftRemainingEstimate(ctx context.Context, issue *models.Issue, delta int) error {
+	if delta == 0 || issue.RemainingMinutes == nil {
+		return nil
+	}
+
+	remaining := *issue.RemainingMinutes + delta
+	if remaining < 0 {
+		remaining = 0
+	}
+	return s.worklogRepo.SetRemainingEstimate(ctx, issue.ID, &remaining)
+}
+
+// ListByIssueID lists the worklogs of an issue
+func (s *WorklogService) ListByIssueID(ctx context.Context, issueID int, userID int) ([]*models.Worklog, error) {
+	issue, err := s.issueRepo.GetByID(ctx, issueID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.authService.CheckProjectAccess(ctx, issue.ProjectID, userID); err != nil {
+		return nil, err
+	}
+
+	return s.worklogRepo.ListByIssueID(ctx, issueID)
+}
+
+// GetTimeTracking returns the estimates and logged time of an issue, rolled
+// up over its subtasks and epic issues
+func (s *WorklogService) GetTimeTracking(ctx context.Context, issueID int, userID int) (*models.TimeTracking, error) {
+	issue, err := s.issueRepo.GetByID(ctx, issueID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.authService.CheckProjectAccess(ctx, issue.ProjectID, userID); err != nil {
+		return nil, err
+	}
+
+	return s.worklogRepo.GetTimeTracking(ctx, issue)
+}
+
+// Update updates a worklog; only its author or a project admin may change it
+func (s *WorklogService) Update(ctx context.Context, id int, req *models.UpdateWorklogRequest, userID int) (*models.Worklog, error) {
+	worklog, issue, err := s.getModifiable(ctx, id, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	oldMinutes := worklog.TimeSpentMinutes
+	if req.TimeSpentMinutes != nil {
+		if *req.TimeSpentMinutes <= 0 {
+			return nil, pkgerrors.NewValidationError("time_spent_minutes must be positive")
+		}
+		worklog.TimeSpentMinutes = *req.TimeSpentMinutes
+	}
+	if req.StartedAt != nil {
+		worklog.StartedAt = *req.StartedAt
+	}
+	if req.Description != nil {
+		worklog.Description = req.Description
+		if *req.Description == "" {
+			worklog.Description = nil
+		}
+	}
+
+	if err := s.worklogRepo.Update(ctx, worklog); err != nil {
+		slog.Error("failed to update worklog", "error", err, "id", id)
+		return nil, err
+	}
+
+	if err := s.shiftRemainingEstimate(ctx, issue, oldMinutes-worklog.TimeSpentMinutes); err != nil {
+		slog.Error("failed to adjust remaining estimate", "error", err, "issue_id", issue.ID)
+	}
+
+	var oldValue, newValue *string
+	if oldMinutes != worklog.TimeSpentMinutes {
+		oldValue, newValue = strPtr(strconv.Itoa(oldMinutes)), strPtr(strconv.Itoa(worklog.TimeSpentMinutes))
+	}
+	s.logActivity(ctx, issue, id, userID, "worklog_updated", oldValue, newValue)
+
+	slog.Info("worklog updated", "id", id, "user_id", userID)
+	return s.worklogRepo.GetByID(ctx, id)
+}
+
+// Delete deletes a worklog; only its author or a project admin may remove it
+func (s *WorklogService) Delete(ctx context.Context, id int, userID int) error {
+	worklog, issue, err := s.getModifiable(ctx, id, userID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.worklogRepo.Delete(ctx, id); err != nil {
+		slog.Error("failed to delete worklog", "error", err, "id", id)
+		return err
+	}
+
+	if err := s.shiftRemainingEstimate(ctx, issue, worklog.TimeSpentMinutes); err != nil {
+		slog.Error("failed to restore remaining estimate", "error", err, "issue_id", issue.ID)
+	}
+
+	s.logActivity(ctx, issue, id, userID, "worklog_removed", strPtr(strconv.Itoa(worklog.TimeSpentMinutes)), nil)
+
+	slog.Info("worklog deleted", "id", id, "user_id", userID)
+	return nil
+}
+
+// getModifiable loads a worklog the user may change: their own entry on a
+// project they can write to, or any entry on a project they administer
+func (s *WorklogService) getModifiable(ctx context.Context, id int, userID int) (*models.Worklog, *models.Issue, error) {
+	worklog, err := s.worklogRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	issue, err := s.issueRepo.GetByID(ctx, worklog.IssueID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if worklog.UserID == userID {
+		err = s.authService.CheckWritePermission(ctx, issue.ProjectID, userID)
+	} else {
+		err = s.authService.CheckAdminPermission(ctx, issue.ProjectID, userID)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return worklog, issue, nil
+}
+
+// Timesheet reports the time logged in a period, by user and project, on
+// projects the user can access
+func (s *WorklogService) Timesheet(ctx context.Context, filter *models.TimesheetFilter, userID int) (*models.Timesheet, error) {
+	if !filter.To.After(filter.From) {
+		return nil, pkgerrors.NewValidationError("to must be after from")
+	}
+	if filter.To.Sub(filter.From) > maxTimesheetRange {
+		return nil, pkgerrors.NewValidationError("a timesheet covers at most 366 days")
+	}
+
+	if filter.ProjectID != nil {
+		if err := s.authService.CheckProjectAccess(ctx, *filter.ProjectID, userID); err != nil {
+			return nil, err
+		}
+	}
+
+	entries, err := s.worklogRepo.ListTimesheet(ctx, filter, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	timesheet := &models.Timesheet{
+		From:    filter.From,
+		To:      filter.To,
+		Entries: entries,
+	}
+	for _, entry := range entries {
+		timesheet.TotalTimeSpentMinutes += entry.TimeSpentMinutes
+	}
+
+	return timesheet, nil
+}
+
+// logActivity records a worklog change in the issue's activity log
+func (s *WorklogService) logActivity(ctx context.Context, issue *models.Issue, worklogID int, userID int, action string, oldValue, newValue *string) {
+	if s.activityService == nil {
+		return
+	}
+
+	var fieldName *string
+	if oldValue != nil || newValue != nil {
+		fieldName = strPtr("time_spent_minutes")
+	}
+
+	projectID, issueID := issue.ProjectID, issue.ID
+	_, _ = s.activityService.LogActivity(ctx, &models.CreateActivityRequest{
+		ProjectID:  &projectID,
+		IssueID:    &issueID,
+		UserID:     userID,
+		Action:     action,
+		EntityType: "worklog",
+		EntityID:   &worklogID,
+		FieldName:  fieldName,
+		OldValue:   oldValue,
+		NewValue:   newValue,
+	})
+}
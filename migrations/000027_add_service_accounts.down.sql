@@ -0,0 +1,5 @@
+-- Drop service account support
+DROP TABLE IF EXISTS service_account_tokens;
+DROP INDEX IF EXISTS idx_users_bot_project_id;
+ALTER TABLE users DROP COLUMN IF EXISTS bot_project_id;
+ALTER TABLE users DROP COLUMN IF EXISTS is_bot;
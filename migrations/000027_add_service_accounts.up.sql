@@ -0,0 +1,28 @@
+-- Service accounts: bot users that belong to a single project and
+-- authenticate only with API tokens (no password, no login)
+
+ALTER TABLE users ADD COLUMN IF NOT EXISTS is_bot BOOLEAN NOT NULL DEFAULT false;
+ALTER TABLE users ADD COLUMN IF NOT EXISTS bot_project_id INTEGER REFERENCES projects(id) ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS idx_users_bot_project_id ON users(bot_project_id) WHERE is_bot = true;
+
+-- API tokens for service accounts (only the SHA-256 hash is stored)
+CREATE TABLE service_account_tokens (
+    id SERIAL PRIMARY KEY,
+    user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    name VARCHAR(100) NOT NULL,
+    token_hash CHAR(64) NOT NULL UNIQUE,
+    token_prefix VARCHAR(16) NOT NULL,  -- First characters of the token, for display
+    created_by INTEGER REFERENCES users(id) ON DELETE SET NULL,
+    expires_at TIMESTAMP WITH TIME ZONE,
+    last_used_at TIMESTAMP WITH TIME ZONE,
+    revoked_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_service_account_tokens_user_id ON service_account_tokens(user_id);
+
+-- Comments
+COMMENT ON COLUMN users.is_bot IS 'True for service accounts used by integrations';
+COMMENT ON COLUMN users.bot_project_id IS 'Project a service account belongs to';
+COMMENT ON TABLE service_account_tokens IS 'API tokens for service accounts';
@@ -0,0 +1,6 @@
+-- Drop personal data exports
+DROP TABLE IF EXISTS user_exports;
+ALTER TABLE users DROP CONSTRAINT IF EXISTS chk_users_reserved_username;
+DELETE FROM users WHERE is_placeholder;
+DROP INDEX IF EXISTS idx_users_placeholder;
+ALTER TABLE users DROP COLUMN IF EXISTS is_placeholder;
@@ -0,0 +1,40 @@
+-- Personal data exports (GDPR) and account deletion support
+
+-- Placeholder user that authored content is reassigned to when an account is deleted
+-- It is identified by the flag, never by its username, so an existing account
+-- with the name is never adopted as the placeholder
+ALTER TABLE users ADD COLUMN is_placeholder BOOLEAN NOT NULL DEFAULT false;
+CREATE UNIQUE INDEX idx_users_placeholder ON users(is_placeholder) WHERE is_placeholder;
+
+-- An existing account that already uses the reserved name keeps its ID and
+-- content but is renamed, so the placeholder and the constraint below fit
+UPDATE users SET username = username || '-' || id, updated_at = NOW()
+WHERE LOWER(username) = 'deleted-user';
+
+INSERT INTO users (email, username, password_hash, name, is_placeholder)
+VALUES ('deleted-user@users.invalid', 'deleted-user', '', 'Deleted user', true);
+
+-- No other account can take the placeholder's username
+ALTER TABLE users ADD CONSTRAINT chk_users_reserved_username
+    CHECK (is_placeholder OR LOWER(username) <> 'deleted-user');
+
+-- Export jobs: each export is a ZIP in storage that can be downloaded once
+CREATE TABLE user_exports (
+    id SERIAL PRIMARY KEY,
+    user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending',  -- pending, processing, completed, failed, downloading, downloaded
+    storage_key VARCHAR(500),
+    file_size BIGINT,
+    error_message TEXT,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    completed_at TIMESTAMP WITH TIME ZONE,
+    download_claimed_at TIMESTAMP WITH TIME ZONE,
+    downloaded_at TIMESTAMP WITH TIME ZONE
+);
+
+CREATE INDEX idx_user_exports_user_id ON user_exports(user_id);
+
+-- Comments
+COMMENT ON TABLE user_exports IS 'Personal data export jobs (GDPR)';
+COMMENT ON COLUMN user_exports.storage_key IS 'Storage key of the ZIP archive, removed after download';
+COMMENT ON COLUMN user_exports.download_claimed_at IS 'Start of the download in progress; a stale claim can be taken over';
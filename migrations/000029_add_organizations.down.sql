@@ -0,0 +1,12 @@
+-- Drop organizations
+DROP VIEW IF EXISTS project_access;
+
+DROP INDEX IF EXISTS idx_projects_organization_id;
+DROP INDEX IF EXISTS idx_projects_personal_key;
+DROP INDEX IF EXISTS idx_projects_org_key;
+ALTER TABLE projects DROP COLUMN IF EXISTS organization_id;
+ALTER TABLE projects ADD CONSTRAINT projects_key_key UNIQUE (key);
+
+DROP TABLE IF EXISTS user_two_factor;
+DROP TABLE IF EXISTS organization_members;
+DROP TABLE IF EXISTS organizations;
@@ -0,0 +1,66 @@
+-- Organizations: workspaces that own projects and grant their members
+-- default access to every project of the organization
+
+CREATE TABLE organizations (
+    id SERIAL PRIMARY KEY,
+    name VARCHAR(255) NOT NULL,
+    slug VARCHAR(50) UNIQUE NOT NULL,
+    -- Org-wide settings
+    default_project_role VARCHAR(50) NOT NULL DEFAULT 'member' CHECK (default_project_role IN ('member', 'viewer')),
+    default_project_template_id INTEGER REFERENCES project_templates(id) ON DELETE SET NULL,
+    allowed_integrations TEXT[],  -- NULL allows every integration type
+    require_2fa BOOLEAN NOT NULL DEFAULT false,
+    created_at TIMESTAMP DEFAULT NOW(),
+    updated_at TIMESTAMP DEFAULT NOW()
+);
+
+CREATE TABLE organization_members (
+    organization_id INTEGER REFERENCES organizations(id) ON DELETE CASCADE,
+    user_id INTEGER REFERENCES users(id) ON DELETE CASCADE,
+    role VARCHAR(50) NOT NULL CHECK (role IN ('owner', 'admin', 'member')),
+    joined_at TIMESTAMP DEFAULT NOW(),
+    invited_by INTEGER REFERENCES users(id) ON DELETE SET NULL,
+    PRIMARY KEY (organization_id, user_id)
+);
+
+CREATE INDEX idx_organization_members_user_id ON organization_members(user_id);
+
+-- Two-factor authentication (TOTP) of users, which organizations can require
+-- of their members; the secret is pending until the first code is confirmed
+CREATE TABLE user_two_factor (
+    user_id INTEGER PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    secret VARCHAR(64) NOT NULL,
+    enabled_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+-- Projects may belong to an organization; keys are unique per organization
+ALTER TABLE projects ADD COLUMN IF NOT EXISTS organization_id INTEGER REFERENCES organizations(id);
+ALTER TABLE projects DROP CONSTRAINT IF EXISTS projects_key_key;
+
+CREATE UNIQUE INDEX idx_projects_org_key ON projects(organization_id, key) WHERE organization_id IS NOT NULL;
+CREATE UNIQUE INDEX idx_projects_personal_key ON projects(key) WHERE organization_id IS NULL;
+CREATE INDEX idx_projects_organization_id ON projects(organization_id);
+
+-- Effective project access: direct project membership overrides the
+-- organization default; org owners and admins default to project admin
+CREATE VIEW project_access AS
+SELECT pm.project_id, pm.user_id, pm.role, pm.joined_at, pm.invited_by, 'direct' AS source
+FROM project_members pm
+UNION ALL
+SELECT p.id AS project_id, om.user_id,
+       CASE WHEN om.role IN ('owner', 'admin') THEN 'admin' ELSE o.default_project_role END AS role,
+       om.joined_at, om.invited_by, 'organization' AS source
+FROM projects p
+JOIN organizations o ON o.id = p.organization_id
+JOIN organization_members om ON om.organization_id = p.organization_id
+WHERE NOT EXISTS (
+    SELECT 1 FROM project_members pm
+    WHERE pm.project_id = p.id AND pm.user_id = om.user_id
+);
+
+-- Comments
+COMMENT ON TABLE organizations IS 'Workspaces that own projects';
+COMMENT ON TABLE user_two_factor IS 'TOTP two-factor authentication of users; enabled_at is NULL while setup is pending';
+COMMENT ON COLUMN organizations.require_2fa IS 'Members must have two-factor authentication enabled';
+COMMENT ON VIEW project_access IS 'Effective project role per user from direct and organization grants';
@@ -0,0 +1,24 @@
+-- Drop teams and restore organization-only project access
+DROP VIEW IF EXISTS project_access;
+
+CREATE VIEW project_access AS
+SELECT pm.project_id, pm.user_id, pm.role, pm.joined_at, pm.invited_by, 'direct' AS source
+FROM project_members pm
+UNION ALL
+SELECT p.id AS project_id, om.user_id,
+       CASE WHEN om.role IN ('owner', 'admin') THEN 'admin' ELSE o.default_project_role END AS role,
+       om.joined_at, om.invited_by, 'organization' AS source
+FROM projects p
+JOIN organizations o ON o.id = p.organization_id
+JOIN organization_members om ON om.organization_id = p.organization_id
+WHERE NOT EXISTS (
+    SELECT 1 FROM project_members pm
+    WHERE pm.project_id = p.id AND pm.user_id = om.user_id
+);
+
+DROP INDEX IF EXISTS idx_issues_assignee_team_id;
+ALTER TABLE issues DROP COLUMN IF EXISTS assignee_team_id;
+
+DROP TABLE IF EXISTS project_teams;
+DROP TABLE IF EXISTS team_members;
+DROP TABLE IF EXISTS teams;
@@ -0,0 +1,81 @@
+-- Teams: named groups of organization members that can be granted
+-- project access, mentioned (@slug) and assigned issues as a unit
+
+CREATE TABLE teams (
+    id SERIAL PRIMARY KEY,
+    organization_id INTEGER NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+    name VARCHAR(255) NOT NULL,
+    slug VARCHAR(30) NOT NULL,  -- Mention handle, same charset as usernames
+    description TEXT,
+    created_by INTEGER REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMP DEFAULT NOW(),
+    updated_at TIMESTAMP DEFAULT NOW(),
+    UNIQUE (organization_id, slug)
+);
+
+CREATE TABLE team_members (
+    team_id INTEGER REFERENCES teams(id) ON DELETE CASCADE,
+    user_id INTEGER REFERENCES users(id) ON DELETE CASCADE,
+    added_at TIMESTAMP DEFAULT NOW(),
+    added_by INTEGER REFERENCES users(id) ON DELETE SET NULL,
+    PRIMARY KEY (team_id, user_id)
+);
+
+CREATE INDEX idx_team_members_user_id ON team_members(user_id);
+
+CREATE TABLE project_teams (
+    project_id INTEGER REFERENCES projects(id) ON DELETE CASCADE,
+    team_id INTEGER REFERENCES teams(id) ON DELETE CASCADE,
+    role VARCHAR(50) NOT NULL CHECK (role IN ('admin', 'member', 'viewer')),
+    added_at TIMESTAMP DEFAULT NOW(),
+    added_by INTEGER REFERENCES users(id) ON DELETE SET NULL,
+    PRIMARY KEY (project_id, team_id)
+);
+
+CREATE INDEX idx_project_teams_team_id ON project_teams(team_id);
+
+-- Issues can be assigned to a team instead of (or in addition to) a user
+ALTER TABLE issues ADD COLUMN IF NOT EXISTS assignee_team_id INTEGER REFERENCES teams(id) ON DELETE SET NULL;
+CREATE INDEX idx_issues_assignee_team_id ON issues(assignee_team_id);
+
+-- Effective project access: the highest of the direct and team grants wins
+-- (direct on ties); the organization default applies only without any grant
+DROP VIEW IF EXISTS project_access;
+CREATE VIEW project_access AS
+WITH grants AS (
+    SELECT pm.project_id, pm.user_id, pm.role, pm.joined_at, pm.invited_by, 'direct' AS source
+    FROM project_members pm
+    UNION ALL
+    SELECT pt.project_id, tm.user_id, pt.role,
+           GREATEST(pt.added_at, tm.added_at) AS joined_at, pt.added_by AS invited_by, 'team' AS source
+    FROM project_teams pt
+    JOIN team_members tm ON tm.team_id = pt.team_id
+),
+ranked AS (
+    SELECT g.*, ROW_NUMBER() OVER (
+        PARTITION BY g.project_id, g.user_id
+        ORDER BY CASE g.role WHEN 'owner' THEN 4 WHEN 'admin' THEN 3 WHEN 'member' THEN 2 ELSE 1 END DESC,
+                 CASE g.source WHEN 'direct' THEN 0 ELSE 1 END
+    ) AS grant_rank
+    FROM grants g
+)
+SELECT project_id, user_id, role, joined_at, invited_by, source
+FROM ranked
+WHERE grant_rank = 1
+UNION ALL
+SELECT p.id AS project_id, om.user_id,
+       CASE WHEN om.role IN ('owner', 'admin') THEN 'admin' ELSE o.default_project_role END AS role,
+       om.joined_at, om.invited_by, 'organization' AS source
+FROM projects p
+JOIN organizations o ON o.id = p.organization_id
+JOIN organization_members om ON om.organization_id = p.organization_id
+WHERE NOT EXISTS (
+    SELECT 1 FROM grants g
+    WHERE g.project_id = p.id AND g.user_id = om.user_id
+);
+
+-- Comments
+COMMENT ON TABLE teams IS 'Named groups of organization members';
+COMMENT ON TABLE project_teams IS 'Project roles granted to every member of a team';
+COMMENT ON COLUMN issues.assignee_team_id IS 'Team responsible for the issue';
+COMMENT ON VIEW project_access IS 'Effective project role per user from direct, team and organization grants';
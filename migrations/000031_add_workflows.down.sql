@@ -0,0 +1,20 @@
+-- Drop workflows and restore the fixed issue statuses
+DROP INDEX IF EXISTS idx_issues_status_category;
+ALTER TABLE issues DROP COLUMN IF EXISTS resolution;
+
+UPDATE issues SET status = CASE status_category
+    WHEN 'in_progress' THEN 'in_progress'
+    WHEN 'done' THEN 'closed'
+    ELSE 'open'
+END
+WHERE status NOT IN ('open', 'in_progress', 'closed');
+
+ALTER TABLE issues DROP COLUMN IF EXISTS status_category;
+ALTER TABLE issues ALTER COLUMN status TYPE VARCHAR(20);
+ALTER TABLE issues ADD CONSTRAINT issues_status_check
+    CHECK (status IN ('open', 'in_progress', 'closed'));
+
+DROP TABLE IF EXISTS workflow_issue_types;
+DROP TABLE IF EXISTS workflow_transitions;
+DROP TABLE IF EXISTS workflow_statuses;
+DROP TABLE IF EXISTS workflows;
@@ -0,0 +1,70 @@
+-- Workflows: per-project issue statuses with an allowed-transition graph
+-- Projects without a workflow keep the built-in open / in_progress / closed
+-- statuses where any transition is allowed
+
+CREATE TABLE workflows (
+    id SERIAL PRIMARY KEY,
+    project_id INTEGER NOT NULL REFERENCES projects(id) ON DELETE CASCADE,
+    name VARCHAR(100) NOT NULL,
+    is_default BOOLEAN NOT NULL DEFAULT false,  -- Used by issue types without their own workflow
+    created_at TIMESTAMP DEFAULT NOW(),
+    updated_at TIMESTAMP DEFAULT NOW()
+);
+
+CREATE INDEX idx_workflows_project_id ON workflows(project_id);
+CREATE UNIQUE INDEX idx_workflows_project_default ON workflows(project_id) WHERE is_default;
+
+CREATE TABLE workflow_statuses (
+    id SERIAL PRIMARY KEY,
+    workflow_id INTEGER NOT NULL REFERENCES workflows(id) ON DELETE CASCADE,
+    key VARCHAR(50) NOT NULL,  -- Stored in issues.status
+    name VARCHAR(100) NOT NULL,
+    category VARCHAR(20) NOT NULL CHECK (category IN ('todo', 'in_progress', 'done')),
+    position INTEGER NOT NULL DEFAULT 0,
+    is_initial BOOLEAN NOT NULL DEFAULT false,
+    UNIQUE (workflow_id, key)
+);
+
+CREATE TABLE workflow_transitions (
+    id SERIAL PRIMARY KEY,
+    workflow_id INTEGER NOT NULL REFERENCES workflows(id) ON DELETE CASCADE,
+    name VARCHAR(100) NOT NULL,
+    from_status VARCHAR(50),  -- NULL: from any status
+    to_status VARCHAR(50) NOT NULL,
+    allowed_roles TEXT[],     -- NULL: any role with write access
+    required_fields TEXT[] NOT NULL DEFAULT '{}',
+    FOREIGN KEY (workflow_id, from_status) REFERENCES workflow_statuses(workflow_id, key) ON DELETE CASCADE,
+    FOREIGN KEY (workflow_id, to_status) REFERENCES workflow_statuses(workflow_id, key) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_workflow_transitions_workflow_id ON workflow_transitions(workflow_id);
+
+-- Issue types with their own workflow
+CREATE TABLE workflow_issue_types (
+    project_id INTEGER NOT NULL REFERENCES projects(id) ON DELETE CASCADE,
+    issue_type VARCHAR(20) NOT NULL,
+    workflow_id INTEGER NOT NULL REFERENCES workflows(id) ON DELETE CASCADE,
+    PRIMARY KEY (project_id, issue_type)
+);
+
+-- Issue statuses are now workflow keys; the category keeps reports,
+-- progress and filters working across custom statuses
+ALTER TABLE issues DROP CONSTRAINT IF EXISTS issues_status_check;
+ALTER TABLE issues ALTER COLUMN status TYPE VARCHAR(50);
+ALTER TABLE issues ADD COLUMN IF NOT EXISTS status_category VARCHAR(20) NOT NULL DEFAULT 'todo'
+    CHECK (status_category IN ('todo', 'in_progress', 'done'));
+ALTER TABLE issues ADD COLUMN IF NOT EXISTS resolution VARCHAR(100);
+
+UPDATE issues SET status_category = CASE status
+    WHEN 'in_progress' THEN 'in_progress'
+    WHEN 'closed' THEN 'done'
+    ELSE 'todo'
+END;
+
+CREATE INDEX idx_issues_status_category ON issues(project_id, status_category);
+
+-- Comments
+COMMENT ON TABLE workflows IS 'Per-project issue workflows';
+COMMENT ON COLUMN workflow_transitions.required_fields IS 'Issue fields that must be set to perform the transition';
+COMMENT ON COLUMN issues.status_category IS 'Category of the issue status: todo, in_progress or done';
+COMMENT ON COLUMN issues.resolution IS 'How a done issue was resolved; cleared when it is reopened';
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_board_columns_statuses;
+
+ALTER TABLE board_columns DROP COLUMN IF EXISTS statuses;
@@ -0,0 +1,10 @@
+-- Statuses mapped to each board column: moving a card into a column sets
+-- the first mapped status, and a status change moves the card to its column
+ALTER TABLE board_columns ADD COLUMN statuses TEXT[] NOT NULL DEFAULT '{}';
+
+-- Map the default columns to the default workflow statuses
+UPDATE board_columns SET statuses = ARRAY['open'] WHERE name = 'Backlog';
+UPDATE board_columns SET statuses = ARRAY['in_progress'] WHERE name = 'In Progress';
+UPDATE board_columns SET statuses = ARRAY['closed'] WHERE name = 'Done';
+
+CREATE INDEX idx_board_columns_statuses ON board_columns USING GIN (statuses);
@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS board_wip_overrides;
+
+ALTER TABLE board_columns
+    DROP COLUMN IF EXISTS wip_mode,
+    DROP COLUMN IF EXISTS wip_limit;
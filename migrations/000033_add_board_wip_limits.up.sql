@@ -0,0 +1,18 @@
+-- WIP limits per board column, enforced on moves and issue creation
+ALTER TABLE board_columns
+    ADD COLUMN wip_limit INTEGER CHECK (wip_limit > 0),
+    ADD COLUMN wip_mode VARCHAR(10) NOT NULL DEFAULT 'soft' CHECK (wip_mode IN ('soft', 'hard'));
+
+-- Audit trail of admins exceeding a WIP limit
+CREATE TABLE board_wip_overrides (
+    id SERIAL PRIMARY KEY,
+    column_id INTEGER NOT NULL REFERENCES board_columns(id) ON DELETE CASCADE,
+    issue_id INTEGER NOT NULL REFERENCES issues(id) ON DELETE CASCADE,
+    user_id INTEGER REFERENCES users(id) ON DELETE SET NULL,
+    reason TEXT NOT NULL,
+    issue_count INTEGER NOT NULL,
+    wip_limit INTEGER NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
+
+CREATE INDEX idx_board_wip_overrides_column_id ON board_wip_overrides(column_id, created_at DESC);
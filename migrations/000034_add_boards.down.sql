@@ -0,0 +1,17 @@
+-- Only the columns of default boards survive
+UPDATE issues SET column_id = NULL
+WHERE column_id IN (
+    SELECT bc.id FROM board_columns bc JOIN boards b ON b.id = bc.board_id WHERE NOT b.is_default
+);
+
+DELETE FROM board_columns
+WHERE board_id IN (SELECT id FROM boards WHERE NOT is_default);
+
+DROP INDEX IF EXISTS idx_board_columns_board_id;
+
+ALTER TABLE board_columns DROP CONSTRAINT IF EXISTS board_columns_board_id_position_key;
+ALTER TABLE board_columns ADD CONSTRAINT board_columns_project_id_position_key UNIQUE (project_id, position);
+
+ALTER TABLE board_columns DROP COLUMN IF EXISTS board_id;
+
+DROP TABLE IF EXISTS boards;
@@ -0,0 +1,35 @@
+-- Several boards per project, each with its own columns, saved filter and swimlanes
+CREATE TABLE boards (
+    id SERIAL PRIMARY KEY,
+    project_id INTEGER NOT NULL REFERENCES projects(id) ON DELETE CASCADE,
+    name VARCHAR(100) NOT NULL,
+    description TEXT,
+    is_default BOOLEAN NOT NULL DEFAULT false, -- Board behind GET /projects/{id}/board; status changes move cards on it
+    filter JSONB NOT NULL DEFAULT '{}',        -- Saved issue filter: labels, types, assignee, milestone...
+    swimlane VARCHAR(20) NOT NULL DEFAULT 'none' CHECK (swimlane IN ('none', 'assignee', 'epic', 'priority', 'label')),
+    created_by INTEGER REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    UNIQUE(project_id, name)
+);
+
+CREATE UNIQUE INDEX idx_boards_default ON boards(project_id) WHERE is_default;
+
+-- The existing columns become the project's default board
+INSERT INTO boards (project_id, name, is_default)
+SELECT id, 'Board', true FROM projects;
+
+ALTER TABLE board_columns ADD COLUMN board_id INTEGER REFERENCES boards(id) ON DELETE CASCADE;
+
+UPDATE board_columns bc
+SET board_id = b.id
+FROM boards b
+WHERE b.project_id = bc.project_id AND b.is_default;
+
+ALTER TABLE board_columns ALTER COLUMN board_id SET NOT NULL;
+
+-- Column positions are unique per board instead of per project
+ALTER TABLE board_columns DROP CONSTRAINT board_columns_project_id_position_key;
+ALTER TABLE board_columns ADD CONSTRAINT board_columns_board_id_position_key UNIQUE (board_id, position);
+
+CREATE INDEX idx_board_columns_board_id ON board_columns(board_id);
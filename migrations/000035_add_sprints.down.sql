@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_issues_sprint_id;
+
+ALTER TABLE issues DROP COLUMN IF EXISTS sprint_id;
+
+DROP TABLE IF EXISTS sprints;
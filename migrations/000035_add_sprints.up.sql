@@ -0,0 +1,29 @@
+-- Sprints (iterations) planned on a board
+CREATE TABLE sprints (
+    id SERIAL PRIMARY KEY,
+    board_id INTEGER NOT NULL REFERENCES boards(id) ON DELETE CASCADE,
+    project_id INTEGER NOT NULL REFERENCES projects(id) ON DELETE CASCADE,
+    name VARCHAR(100) NOT NULL,
+    goal TEXT,
+    start_date DATE,
+    end_date DATE,
+    state VARCHAR(20) NOT NULL DEFAULT 'future' CHECK (state IN ('future', 'active', 'closed')),
+    committed_issue_ids INTEGER[] NOT NULL DEFAULT '{}', -- Issues in the sprint when it started
+    report JSONB,                                        -- Snapshot stored on completion
+    started_at TIMESTAMP WITH TIME ZONE,
+    completed_at TIMESTAMP WITH TIME ZONE,
+    created_by INTEGER REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    CHECK (start_date IS NULL OR end_date IS NULL OR end_date >= start_date)
+);
+
+CREATE INDEX idx_sprints_board_id ON sprints(board_id, state);
+
+-- A board runs one sprint at a time
+CREATE UNIQUE INDEX idx_sprints_active ON sprints(board_id) WHERE state = 'active';
+
+-- Issues without a sprint are in the backlog
+ALTER TABLE issues ADD COLUMN sprint_id INTEGER REFERENCES sprints(id) ON DELETE SET NULL;
+
+CREATE INDEX idx_issues_sprint_id ON issues(sprint_id);
@@ -0,0 +1,7 @@
+ALTER TABLE issues
+    DROP COLUMN IF EXISTS original_estimate_minutes,
+    DROP COLUMN IF EXISTS story_points;
+
+ALTER TABLE projects
+    DROP COLUMN IF EXISTS allowed_story_points,
+    DROP COLUMN IF EXISTS estimation_type;
@@ -0,0 +1,11 @@
+-- Per-project estimation: story points (limited to an allowed set) or time
+ALTER TABLE projects
+    ADD COLUMN estimation_type VARCHAR(20) NOT NULL DEFAULT 'story_points'
+        CHECK (estimation_type IN ('story_points', 'time')),
+    ADD COLUMN allowed_story_points NUMERIC(6, 1)[] NOT NULL DEFAULT '{0,1,2,3,5,8,13,21}';
+
+COMMENT ON COLUMN projects.allowed_story_points IS 'Allowed story point values; empty allows any non-negative value';
+
+ALTER TABLE issues
+    ADD COLUMN story_points NUMERIC(6, 1) CHECK (story_points >= 0),
+    ADD COLUMN original_estimate_minutes INTEGER CHECK (original_estimate_minutes >= 0);
@@ -0,0 +1,3 @@
+ALTER TABLE issues DROP COLUMN IF EXISTS remaining_estimate_minutes;
+
+DROP TABLE IF EXISTS worklogs;
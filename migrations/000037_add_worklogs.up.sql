@@ -0,0 +1,19 @@
+-- Time logged against issues, used for billing and timesheets
+CREATE TABLE worklogs (
+    id SERIAL PRIMARY KEY,
+    issue_id INTEGER NOT NULL REFERENCES issues(id) ON DELETE CASCADE,
+    user_id INTEGER NOT NULL REFERENCES users(id),
+    time_spent_minutes INTEGER NOT NULL CHECK (time_spent_minutes > 0),
+    started_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    description TEXT,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
+
+CREATE INDEX idx_worklogs_issue_id ON worklogs(issue_id);
+CREATE INDEX idx_worklogs_user_started ON worklogs(user_id, started_at);
+
+COMMENT ON TABLE worklogs IS 'Time spent on issues; reassigned to the deleted user placeholder on account deletion';
+
+ALTER TABLE issues
+    ADD COLUMN remaining_estimate_minutes INTEGER CHECK (remaining_estimate_minutes >= 0);
@@ -0,0 +1,16 @@
+-- Explicit, typed links between issues
+-- Each link is stored once in its outward direction: source blocks,
+-- duplicates or clones target; relates links are stored with the lower
+-- issue ID as source
+CREATE TABLE issue_links (
+    id SERIAL PRIMARY KEY,
+    source_issue_id INTEGER NOT NULL REFERENCES issues(id) ON DELETE CASCADE,
+    target_issue_id INTEGER NOT NULL REFERENCES issues(id) ON DELETE CASCADE,
+    link_type VARCHAR(20) NOT NULL CHECK (link_type IN ('blocks', 'duplicates', 'relates', 'clones')),
+    created_by INTEGER REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    UNIQUE (source_issue_id, target_issue_id, link_type),
+    CHECK (source_issue_id <> target_issue_id)
+);
+
+CREATE INDEX idx_issue_links_target ON issue_links(target_issue_id);
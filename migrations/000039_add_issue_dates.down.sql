@@ -0,0 +1,8 @@
+DROP TABLE IF EXISTS issue_reminders;
+
+DROP INDEX IF EXISTS idx_issues_due_date;
+
+ALTER TABLE issues
+    DROP CONSTRAINT IF EXISTS issues_start_before_due,
+    DROP COLUMN IF EXISTS due_date,
+    DROP COLUMN IF EXISTS start_date;
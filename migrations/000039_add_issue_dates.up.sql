@@ -0,0 +1,18 @@
+ALTER TABLE issues
+    ADD COLUMN start_date DATE,
+    ADD COLUMN due_date DATE,
+    ADD CONSTRAINT issues_start_before_due CHECK (start_date IS NULL OR due_date IS NULL OR start_date <= due_date);
+
+CREATE INDEX idx_issues_due_date ON issues(due_date) WHERE deleted_at IS NULL AND due_date IS NOT NULL;
+
+-- Due date reminders already sent, so restarts and concurrent schedulers
+-- never send the same reminder twice; keyed on the due date so moving it
+-- schedules new reminders
+CREATE TABLE issue_reminders (
+    issue_id INTEGER NOT NULL REFERENCES issues(id) ON DELETE CASCADE,
+    user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    kind VARCHAR(20) NOT NULL CHECK (kind IN ('due_soon', 'overdue')),
+    due_date DATE NOT NULL,
+    sent_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    PRIMARY KEY (issue_id, user_id, kind, due_date)
+);
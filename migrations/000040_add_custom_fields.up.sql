@@ -0,0 +1,27 @@
+-- Admin-defined issue fields per project
+CREATE TABLE custom_fields (
+    id SERIAL PRIMARY KEY,
+    project_id INTEGER NOT NULL REFERENCES projects(id) ON DELETE CASCADE,
+    key VARCHAR(50) NOT NULL,
+    name VARCHAR(100) NOT NULL,
+    field_type VARCHAR(20) NOT NULL CHECK (field_type IN ('text', 'number', 'date', 'single_select', 'multi_select', 'user', 'url')),
+    options TEXT[] NOT NULL DEFAULT '{}',     -- Choices of select fields
+    issue_types TEXT[] NOT NULL DEFAULT '{}', -- Empty applies to every issue type
+    required BOOLEAN NOT NULL DEFAULT FALSE,
+    position INTEGER NOT NULL DEFAULT 0,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    UNIQUE (project_id, key)
+);
+
+-- Values are JSON: strings for text, date, url and single select, numbers
+-- for number, user IDs for user and string arrays for multi select
+CREATE TABLE issue_custom_field_values (
+    issue_id INTEGER NOT NULL REFERENCES issues(id) ON DELETE CASCADE,
+    field_id INTEGER NOT NULL REFERENCES custom_fields(id) ON DELETE CASCADE,
+    value JSONB NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    PRIMARY KEY (issue_id, field_id)
+);
+
+CREATE INDEX idx_issue_custom_field_values_field ON issue_custom_field_values(field_id);
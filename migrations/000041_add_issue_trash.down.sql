@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_issues_trash;
+ALTER TABLE issues DROP COLUMN IF EXISTS deleted_by;
@@ -0,0 +1,6 @@
+-- Who moved an issue to the trash; subtasks deleted with their parent share
+-- its deleted_at so they can be restored together
+ALTER TABLE issues ADD COLUMN deleted_by INTEGER REFERENCES users(id) ON DELETE SET NULL;
+
+-- Trash listings and the retention job
+CREATE INDEX idx_issues_trash ON issues(project_id, deleted_at DESC) WHERE deleted_at IS NOT NULL;
@@ -0,0 +1,11 @@
+-- Old keys of issues moved to another project, e.g. PROJ-12 -> OPS-3
+-- Issue numbers are never reused, so an old key always points at one issue
+CREATE TABLE issue_key_redirects (
+    project_id INTEGER NOT NULL REFERENCES projects(id) ON DELETE CASCADE,
+    issue_number INTEGER NOT NULL,
+    issue_id INTEGER NOT NULL REFERENCES issues(id) ON DELETE CASCADE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (project_id, issue_number)
+);
+
+CREATE INDEX idx_issue_key_redirects_issue_id ON issue_key_redirects(issue_id);
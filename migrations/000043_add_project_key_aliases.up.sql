@@ -0,0 +1,10 @@
+-- Former keys of renamed projects, so PROJ-123 keeps resolving after a
+-- project key change
+CREATE TABLE project_key_aliases (
+    project_id INTEGER NOT NULL REFERENCES projects(id) ON DELETE CASCADE,
+    key VARCHAR(10) NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (project_id, key)
+);
+
+CREATE INDEX idx_project_key_aliases_key ON project_key_aliases(key);
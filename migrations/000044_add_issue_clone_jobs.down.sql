@@ -0,0 +1,2 @@
+-- Drop issue clone jobs
+DROP TABLE IF EXISTS issue_clone_jobs;
@@ -0,0 +1,21 @@
+-- Background jobs for cloning large issue trees (e.g. epics with children)
+-- The clone itself runs in one transaction; cloned_issues reports progress
+CREATE TABLE issue_clone_jobs (
+    id SERIAL PRIMARY KEY,
+    source_issue_id INTEGER REFERENCES issues(id) ON DELETE SET NULL,
+    target_project_id INTEGER NOT NULL REFERENCES projects(id) ON DELETE CASCADE,
+    user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending',  -- pending, processing, completed, failed
+    total_issues INTEGER NOT NULL,
+    cloned_issues INTEGER NOT NULL DEFAULT 0,
+    cloned_issue_id INTEGER REFERENCES issues(id) ON DELETE SET NULL,
+    error_message TEXT,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    completed_at TIMESTAMP WITH TIME ZONE
+);
+
+CREATE INDEX idx_issue_clone_jobs_user_id ON issue_clone_jobs(user_id);
+
+-- Comments
+COMMENT ON TABLE issue_clone_jobs IS 'Background jobs cloning issues with their subtasks or epic children';
+COMMENT ON COLUMN issue_clone_jobs.cloned_issue_id IS 'Clone of the source issue once the job completed';
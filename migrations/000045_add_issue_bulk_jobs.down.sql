@@ -0,0 +1,2 @@
+-- Drop issue bulk jobs
+DROP TABLE IF EXISTS issue_bulk_jobs;
@@ -0,0 +1,19 @@
+-- Background jobs for bulk changes to many issues of a project
+-- Issues are changed one by one; result holds the outcome of each issue
+CREATE TABLE issue_bulk_jobs (
+    id SERIAL PRIMARY KEY,
+    project_id INTEGER NOT NULL REFERENCES projects(id) ON DELETE CASCADE,
+    user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending',  -- pending, processing, completed
+    total_issues INTEGER NOT NULL,
+    processed_issues INTEGER NOT NULL DEFAULT 0,
+    result JSONB,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    completed_at TIMESTAMP WITH TIME ZONE
+);
+
+CREATE INDEX idx_issue_bulk_jobs_user_id ON issue_bulk_jobs(user_id);
+
+-- Comments
+COMMENT ON TABLE issue_bulk_jobs IS 'Background jobs applying one change to many issues';
+COMMENT ON COLUMN issue_bulk_jobs.result IS 'Per-issue outcomes once the job completed';
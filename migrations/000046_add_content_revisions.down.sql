@@ -0,0 +1,2 @@
+-- Drop content revisions
+DROP TABLE IF EXISTS content_revisions;
@@ -0,0 +1,21 @@
+-- Revision history of issue descriptions and comments
+-- Every saved text is kept as a numbered revision; redacting a revision
+-- removes its content but keeps who changed the text and when
+CREATE TABLE content_revisions (
+    id SERIAL PRIMARY KEY,
+    entity_type VARCHAR(20) NOT NULL CHECK (entity_type IN ('issue', 'comment')),
+    entity_id INTEGER NOT NULL,
+    revision INTEGER NOT NULL,
+    content TEXT,  -- NULL once redacted
+    author_id INTEGER REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    redacted_at TIMESTAMP WITH TIME ZONE,
+    redacted_by INTEGER REFERENCES users(id) ON DELETE SET NULL,
+
+    UNIQUE(entity_type, entity_id, revision)
+);
+
+-- Comments
+COMMENT ON TABLE content_revisions IS 'Saved versions of issue descriptions and comments';
+COMMENT ON COLUMN content_revisions.author_id IS 'User who saved the revision; NULL for text written before revisions were recorded';
+COMMENT ON COLUMN content_revisions.content IS 'Text of the revision, NULL when an admin redacted it';
@@ -0,0 +1,4 @@
+-- Drop issue assignees
+DROP TRIGGER IF EXISTS trigger_sync_primary_assignee ON issues;
+DROP FUNCTION IF EXISTS sync_primary_assignee();
+DROP TABLE IF EXISTS issue_assignees;
@@ -0,0 +1,39 @@
+-- Several assignees per issue
+-- issues.assignee_id stays the primary assignee and is always one of them;
+-- the trigger below keeps it in issue_assignees whenever it changes
+CREATE TABLE issue_assignees (
+    issue_id INTEGER NOT NULL REFERENCES issues(id) ON DELETE CASCADE,
+    user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (issue_id, user_id)
+);
+
+CREATE INDEX idx_issue_assignees_user_id ON issue_assignees(user_id);
+
+-- Existing assignees become the primary and only assignee
+INSERT INTO issue_assignees (issue_id, user_id)
+SELECT id, assignee_id FROM issues WHERE assignee_id IS NOT NULL;
+
+-- Replacing the primary assignee replaces it in issue_assignees too
+CREATE OR REPLACE FUNCTION sync_primary_assignee()
+RETURNS TRIGGER AS $$
+BEGIN
+    IF TG_OP = 'UPDATE' AND OLD.assignee_id IS NOT NULL AND OLD.assignee_id IS DISTINCT FROM NEW.assignee_id THEN
+        DELETE FROM issue_assignees WHERE issue_id = NEW.id AND user_id = OLD.assignee_id;
+    END IF;
+    IF NEW.assignee_id IS NOT NULL THEN
+        INSERT INTO issue_assignees (issue_id, user_id)
+        VALUES (NEW.id, NEW.assignee_id)
+        ON CONFLICT DO NOTHING;
+    END IF;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trigger_sync_primary_assignee
+AFTER INSERT OR UPDATE OF assignee_id ON issues
+FOR EACH ROW
+EXECUTE FUNCTION sync_primary_assignee();
+
+-- Comments
+COMMENT ON TABLE issue_assignees IS 'All assignees of an issue, including the primary assignee in issues.assignee_id';
@@ -0,0 +1,7 @@
+-- Drop issue votes and customer requests
+ALTER TABLE issues DROP COLUMN IF EXISTS customer_request_count;
+ALTER TABLE issues DROP COLUMN IF EXISTS vote_score;
+ALTER TABLE issues DROP COLUMN IF EXISTS downvotes;
+ALTER TABLE issues DROP COLUMN IF EXISTS upvotes;
+DROP TABLE IF EXISTS issue_customer_requests;
+DROP TABLE IF EXISTS issue_votes;
@@ -0,0 +1,33 @@
+-- Up and down votes on issues, one per user
+CREATE TABLE issue_votes (
+    issue_id INTEGER NOT NULL REFERENCES issues(id) ON DELETE CASCADE,
+    user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    value SMALLINT NOT NULL CHECK (value IN (-1, 1)),
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (issue_id, user_id)
+);
+
+CREATE INDEX idx_issue_votes_user_id ON issue_votes(user_id);
+
+-- Customers that asked for an issue, named freely since they need no account
+CREATE TABLE issue_customer_requests (
+    id SERIAL PRIMARY KEY,
+    issue_id INTEGER NOT NULL REFERENCES issues(id) ON DELETE CASCADE,
+    organization_name VARCHAR(255) NOT NULL,
+    note TEXT,
+    created_by INTEGER REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX idx_issue_customer_requests_org ON issue_customer_requests(issue_id, LOWER(organization_name));
+
+-- Totals kept on issues so lists can sort and filter by them
+ALTER TABLE issues ADD COLUMN upvotes INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE issues ADD COLUMN downvotes INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE issues ADD COLUMN vote_score INTEGER GENERATED ALWAYS AS (upvotes - downvotes) STORED;
+ALTER TABLE issues ADD COLUMN customer_request_count INTEGER NOT NULL DEFAULT 0;
+
+-- Comments
+COMMENT ON COLUMN issues.vote_score IS 'Upvotes minus downvotes';
+COMMENT ON COLUMN issues.customer_request_count IS 'Number of customers that requested the issue';
@@ -0,0 +1,275 @@
+// Package diff computes differences between two texts by line or by word
+package diff
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// Kind is the kind of a diff operation
+type Kind string
+
+const (
+	Equal  Kind = "equal"
+	Insert Kind = "insert"
+	Delete Kind = "delete"
+)
+
+// Op is a run of text that is equal in both texts, only in the new text
+// (Insert) or only in the old text (Delete)
+type Op struct {
+	Kind Kind
+	Text string
+}
+
+// maxEdits bounds the work of a diff; texts that differ in more tokens are
+// reported as a deletion of the old text followed by an insertion of the new
+const maxEdits = 1000
+
+// Lines returns the line operations turning a into b, one op per line
+// Lines keep their trailing newline
+func Lines(a, b string) []Op {
+	return compute(splitLines(a), splitLines(b))
+}
+
+// Words returns the operations turning a into b by word, with adjacent
+// operations of the same kind merged; whitespace runs count as words
+func Words(a, b string) []Op {
+	ops := compute(splitWords(a), splitWords(b))
+
+	merged := make([]Op, 0, len(ops))
+	for _, op := range ops {
+		if n := len(merged); n > 0 && merged[n-1].Kind == op.Kind {
+			merged[n-1].Text += op.Text
+			continue
+		}
+		merged = append(merged, op)
+	}
+	return merged
+}
+
+// Unified returns a unified diff of a and b with the given number of
+// context lines around each change, or "" when the texts are equal
+func Unified(a, b string, fromLabel, toLabel string, context int) string {
+	ops := Lines(a, b)
+
+	var changes []int
+	for i, op := range ops {
+		if op.Kind != Equal {
+			changes = append(changes, i)
+		}
+	}
+	if len(changes) == 0 {
+		return ""
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "--- %s\n+++ %s\n", fromLabel, toLabel)
+
+	// Changes closer than twice the context share a hunk
+	for start := 0; start < len(changes); {
+		end := start
+		for end+1 < len(changes) && changes[end+1]-changes[end] <= 2*context+1 {
+			end++
+		}
+
+		first := max(changes[start]-context, 0)
+		last := min(changes[end]+context, len(ops)-1)
+		writeHunk(&sb, ops, first, last)
+
+		start = end + 1
+	}
+
+	return sb.String()
+}
+
+// writeHunk writes the ops first..last as one hunk with its header
+func writeHunk(sb *strings.Builder, ops []Op, first, last int) {
+	aStart, bStart := 1, 1
+	for _, op := range ops[:first] {
+		if op.Kind != Insert {
+			aStart++
+		}
+		if op.Kind != Delete {
+			bStart++
+		}
+	}
+
+	aLen, bLen := 0, 0
+	for _, op := range ops[first : last+1] {
+		if op.Kind != Insert {
+			aLen++
+		}
+		if op.Kind != Delete {
+			bLen++
+		}
+	}
+	// Empty ranges name the line before them
+	if aLen == 0 {
+		aStart--
+	}
+	if bLen == 0 {
+		bStart--
+	}
+
+	fmt.Fprintf(sb, "@@ -%d,%d +%d,%d @@\n", aStart, aLen, bStart, bLen)
+	for _, op := range ops[first : last+1] {
+		prefix := " "
+		switch op.Kind {
+		case Insert:
+			prefix = "+"
+		case Delete:
+			prefix = "-"
+		}
+		sb.WriteString(prefix)
+		sb.WriteString(strings.TrimSuffix(op.Text, "\n"))
+		sb.WriteString("\n")
+	}
+}
+
+// splitLines splits a text into lines, keeping their newlines
+func splitLines(s string) []string {
+	if s == "" {
+		return nil
+	}
+	lines := strings.SplitAfter(s, "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return lines
+}
+
+// splitWords splits a text into words and runs of whitespace
+func splitWords(s string) []string {
+	var tokens []string
+	start := 0
+	for i, r := range s {
+		if i > start && unicode.IsSpace(r) != isSpaceAt(s, start) {
+			tokens = append(tokens, s[start:i])
+			start = i
+		}
+	}
+	if start < len(s) {
+		tokens = append(tokens, s[start:])
+	}
+	return tokens
+}
+
+// isSpaceAt reports whether the rune starting at byte i is whitespace
+func isSpaceAt(s string, i int) bool {
+	for _, r := range s[i:] {
+		return unicode.IsSpace(r)
+	}
+	return false
+}
+
+// compute returns the shortest edit script turning a into b (Myers' algorithm)
+func compute(a, b []string) []Op {
+	// Common prefix and suffix need no search
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(a)-prefix && suffix < len(b)-prefix && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+
+	ops := make([]Op, 0, len(a)+len(b))
+	for _, token := range a[:prefix] {
+		ops = append(ops, Op{Kind: Equal, Text: token})
+	}
+	ops = append(ops, myers(a[prefix:len(a)-suffix], b[prefix:len(b)-suffix])...)
+	for _, token := range a[len(a)-suffix:] {
+		ops = append(ops, Op{Kind: Equal, Text: token})
+	}
+	return ops
+}
+
+// myers finds the edit script by searching the furthest reaching paths for
+// an increasing number of edits d, then backtracks through the saved paths
+func myers(a, b []string) []Op {
+	n, m := len(a), len(b)
+	limit := min(n+m, maxEdits)
+	offset := limit + 1
+	v := make([]int, 2*limit+3)
+
+	var trace [][]int
+	for d := 0; d <= limit; d++ {
+		trace = append(trace, append([]int(nil), v...))
+
+		for k := -d; k <= d; k += 2 {
+			var x int
+			if k == -d || (k != d && v[offset+k-1] < v[offset+k+1]) {
+				x = v[offset+k+1] // Down: insert from b
+			} else {
+				x = v[offset+k-1] + 1 // Right: delete from a
+			}
+			y := x - k
+			for x < n && y < m && a[x] == b[y] {
+				x++
+				y++
+			}
+			v[offset+k] = x
+
+			if x >= n && y >= m {
+				return backtrack(trace, a, b, offset)
+			}
+		}
+	}
+
+	// Too many differences: replace the whole text
+	ops := make([]Op, 0, n+m)
+	for _, token := range a {
+		ops = append(ops, Op{Kind: Delete, Text: token})
+	}
+	for _, token := range b {
+		ops = append(ops, Op{Kind: Insert, Text: token})
+	}
+	return ops
+}
+
+// backtrack walks the saved paths from the end of both texts to the start
+func backtrack(trace [][]int, a, b []string, offset int) []Op {
+	x, y := len(a), len(b)
+	var reversed []Op
+
+	for d := len(trace) - 1; d > 0; d-- {
+		v := trace[d]
+		k := x - y
+
+		var prevK int
+		if k == -d || (k != d && v[offset+k-1] < v[offset+k+1]) {
+			prevK = k + 1
+		} else {
+			prevK = k - 1
+		}
+		prevX := v[offset+prevK]
+		prevY := prevX - prevK
+
+		for x > prevX && y > prevY {
+			reversed = append(reversed, Op{Kind: Equal, Text: a[x-1]})
+			x--
+			y--
+		}
+		if prevK == k+1 {
+			reversed = append(reversed, Op{Kind: Insert, Text: b[y-1]})
+			y--
+		} else {
+			reversed = append(reversed, Op{Kind: Delete, Text: a[x-1]})
+			x--
+		}
+	}
+	for x > 0 && y > 0 {
+		reversed = append(reversed, Op{Kind: Equal, Text: a[x-1]})
+		x--
+		y--
+	}
+
+	ops := make([]Op, len(reversed))
+	for i, op := range reversed {
+		ops[len(reversed)-1-i] = op
+	}
+	return ops
+}
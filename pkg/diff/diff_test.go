@@ -0,0 +1,132 @@
+package diff
+
+import (
+	"strings"
+	"testing"
+)
+
+// apply rebuilds both texts from an edit script
+func apply(ops []Op) (a, b string) {
+	var sa, sb strings.Builder
+	for _, op := range ops {
+		if op.Kind != Insert {
+			sa.WriteString(op.Text)
+		}
+		if op.Kind != Delete {
+			sb.WriteString(op.Text)
+		}
+	}
+	return sa.String(), sb.String()
+}
+
+func TestLines(t *testing.T) {
+	tests := []struct {
+		name  string
+		a, b  string
+		edits int
+	}{
+		{"equal", "one\ntwo\n", "one\ntwo\n", 0},
+		{"empty to text", "", "one\ntwo", 2},
+		{"text to empty", "one\ntwo", "", 2},
+		{"changed line", "one\ntwo\nthree\n", "one\n2\nthree\n", 2},
+		{"inserted line", "one\nthree\n", "one\ntwo\nthree\n", 1},
+		{"missing final newline", "one\ntwo", "one\ntwo\n", 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ops := Lines(tt.a, tt.b)
+
+			if a, b := apply(ops); a != tt.a || b != tt.b {
+				t.Errorf("Expected the script to rebuild %q and %q, got %q and %q", tt.a, tt.b, a, b)
+			}
+
+			edits := 0
+			for _, op := range ops {
+				if op.Kind != Equal {
+					edits++
+				}
+			}
+			if edits != tt.edits {
+				t.Errorf("Expected %d edits, got %d", tt.edits, edits)
+			}
+		})
+	}
+}
+
+func TestWords(t *testing.T) {
+	ops := Words("release the build on friday", "release the signed build on monday")
+
+	want := []Op{
+		{Equal, "release the "},
+		{Insert, "signed "},
+		{Equal, "build on "},
+		{Delete, "friday"},
+		{Insert, "monday"},
+	}
+	if len(ops) != len(want) {
+		t.Fatalf("Expected %d ops, got %d: %v", len(want), len(ops), ops)
+	}
+	for i := range want {
+		if ops[i] != want[i] {
+			t.Errorf("Expected op %d to be %v, got %v", i, want[i], ops[i])
+		}
+	}
+}
+
+func TestUnified(t *testing.T) {
+	t.Run("should return nothing for equal texts", func(t *testing.T) {
+		if got := Unified("same\n", "same\n", "a", "b", 3); got != "" {
+			t.Errorf("Expected empty diff, got %q", got)
+		}
+	})
+
+	t.Run("should write hunks with context", func(t *testing.T) {
+		a := "1\n2\n3\n4\n5\n6\n7\n8\n9\n10\n"
+		b := "1\n2\nthree\n4\n5\n6\n7\n8\n9\n10\n11\n"
+
+		want := "--- revision 1\n+++ revision 2\n" +
+			"@@ -2,3 +2,3 @@\n 2\n-3\n+three\n 4\n" +
+			"@@ -10,1 +10,2 @@\n 10\n+11\n"
+		if got := Unified(a, b, "revision 1", "revision 2", 1); got != want {
+			t.Errorf("Expected\n%s\ngot\n%s", want, got)
+		}
+	})
+
+	t.Run("should name the line before an empty range", func(t *testing.T) {
+		got := Unified("a\nb\n", "a\nb\nc\n", "old", "new", 0)
+
+		want := "--- old\n+++ new\n@@ -2,0 +3,1 @@\n+c\n"
+		if got != want {
+			t.Errorf("Expected\n%s\ngot\n%s", want, got)
+		}
+	})
+
+	t.Run("should merge nearby changes into one hunk", func(t *testing.T) {
+		got := Unified("a\nb\nc\n", "A\nb\nC\n", "old", "new", 1)
+
+		want := "--- old\n+++ new\n@@ -1,3 +1,3 @@\n-a\n+A\n b\n-c\n+C\n"
+		if got != want {
+			t.Errorf("Expected\n%s\ngot\n%s", want, got)
+		}
+	})
+}
+
+func TestMyersFallback(t *testing.T) {
+	var a, b strings.Builder
+	for i := 0; i < maxEdits; i++ {
+		a.WriteString("a\n")
+		b.WriteString("b\n")
+	}
+
+	ops := Lines(a.String(), b.String())
+	if got, want := len(ops), 2*maxEdits; got != want {
+		t.Fatalf("Expected %d ops, got %d", want, got)
+	}
+	if ops[0].Kind != Delete || ops[len(ops)-1].Kind != Insert {
+		t.Error("Expected the old text deleted and the new one inserted")
+	}
+	if ra, rb := apply(ops); ra != a.String() || rb != b.String() {
+		t.Error("Expected the script to rebuild both texts")
+	}
+}
@@ -186,3 +186,59 @@ func (c *Client) SendCommentAdded(to, issueKey, issueTitle, commenter, commentTe
 		},
 	})
 }
+
+// SendDueReminder sends an email when an issue is about to be due or overdue
+func (c *Client) SendDueReminder(to, issueKey, issueTitle, dueDate, projectName string, overdue bool) error {
+	template := `
+<!DOCTYPE html>
+<html>
+<head>
+    <style>
+        body { font-family: Arial, sans-serif; line-height: 1.6; color: #333; }
+        .container { max-width: 600px; margin: 0 auto; padding: 20px; }
+        .header { background-color: {{.Color}}; color: white; padding: 20px; text-align: center; }
+        .content { padding: 20px; background-color: #f9f9f9; }
+        .issue-key { font-weight: bold; color: {{.Color}}; }
+        .footer { margin-top: 20px; padding: 10px; font-size: 12px; color: #666; text-align: center; }
+    </style>
+</head>
+<body>
+    <div class="container">
+        <div class="header">
+            <h2>{{.Heading}}</h2>
+        </div>
+        <div class="content">
+            <p>안녕하세요,</p>
+            <p>
+                <span class="issue-key">{{.IssueKey}}</span>: {{.IssueTitle}}
+            </p>
+            <p>마감일: <strong>{{.DueDate}}</strong></p>
+            <p>프로젝트: <strong>{{.ProjectName}}</strong></p>
+        </div>
+        <div class="footer">
+            <p>이 메일은 자동으로 발송되었습니다.</p>
+        </div>
+    </div>
+</body>
+</html>
+`
+
+	heading, color := "이슈 마감일이 다가옵니다", "#FF9800"
+	if overdue {
+		heading, color = "이슈 마감일이 지났습니다", "#F44336"
+	}
+
+	return c.Send(EmailData{
+		To:          []string{to},
+		Subject:     fmt.Sprintf("[%s] %s", issueKey, heading),
+		TemplateStr: template,
+		Data: map[string]string{
+			"IssueKey":    issueKey,
+			"IssueTitle":  issueTitle,
+			"DueDate":     dueDate,
+			"ProjectName": projectName,
+			"Heading":     heading,
+			"Color":       color,
+		},
+	})
+}
@@ -15,10 +15,18 @@ var (
 	ErrUnauthorized       = errors.New("unauthorized")
 )
 
+// Machine-readable error codes for errors clients handle specifically
+const (
+	CodeWIPLimitExceeded  = "WIP_LIMIT_EXCEEDED"
+	CodeDependencyCycle   = "DEPENDENCY_CYCLE"
+	CodeTwoFactorRequired = "TWO_FACTOR_REQUIRED"
+)
+
 // AppError represents an application-specific error with HTTP status code
 type AppError struct {
 	Message    string
 	StatusCode int
+	Code       string // Optional machine-readable code
 	Err        error
 }
 
@@ -61,6 +69,35 @@ func NewFileTooLargeError(message string) *AppError {
 	}
 }
 
+// NewWIPLimitError creates a 409 Conflict error for a board column at its WIP limit
+func NewWIPLimitError(message string) *AppError {
+	return &AppError{
+		Message:    message,
+		StatusCode: 409,
+		Code:       CodeWIPLimitExceeded,
+	}
+}
+
+// NewDependencyCycleError creates a 409 Conflict error for a blocking link
+// that would close a dependency cycle
+func NewDependencyCycleError(message string) *AppError {
+	return &AppError{
+		Message:    message,
+		StatusCode: 409,
+		Code:       CodeDependencyCycle,
+	}
+}
+
+// NewTwoFactorRequiredError creates a 401 Unauthorized error for a login that
+// needs a valid one-time code
+func NewTwoFactorRequiredError(message string) *AppError {
+	return &AppError{
+		Message:    message,
+		StatusCode: 401,
+		Code:       CodeTwoFactorRequired,
+	}
+}
+
 // NewInternalError creates a 500 Internal Server Error
 func NewInternalError(message string, err error) *AppError {
 	return &AppError{
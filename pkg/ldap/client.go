@@ -0,0 +1,194 @@
+package ldap
+
+import (
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	goldap "github.com/go-ldap/ldap/v3"
+)
+
+// Sentinel errors
+var (
+	ErrInvalidCredentials = errors.New("ldap: invalid credentials")
+	ErrUserNotFound       = errors.New("ldap: user not found")
+	ErrNotConfigured      = errors.New("ldap: not configured")
+)
+
+// AttributeMapping maps directory attributes to user fields
+type AttributeMapping struct {
+	ID       string // Stable identifier, e.g. "entryUUID" or "objectGUID" (falls back to the DN)
+	Username string // e.g. "uid" or "sAMAccountName"
+	Email    string // e.g. "mail"
+	Name     string // e.g. "cn" or "displayName"
+}
+
+// Config holds LDAP configuration
+type Config struct {
+	URL                string // e.g. "ldap://ldap.example.com:389" or "ldaps://..."
+	BindDN             string // Service account used for the user search
+	BindPassword       string
+	BaseDN             string
+	UserFilter         string // "%s" is replaced with the escaped login, e.g. "(uid=%s)"
+	Attributes         AttributeMapping
+	StartTLS           bool
+	InsecureSkipVerify bool
+	Timeout            time.Duration
+}
+
+// Entry represents a directory user resolved by a successful bind
+type Entry struct {
+	DN       string
+	ID       string
+	Username string
+	Email    string
+	Name     string
+}
+
+// Client authenticates users against an LDAP directory using
+// search-then-bind: the service account locates the user entry, then the
+// user's own DN and password are used for a simple bind.
+type Client struct {
+	config Config
+}
+
+// NewClient creates a new LDAP client, filling in defaults for unset options
+func NewClient(config Config) *Client {
+	if config.UserFilter == "" {
+		config.UserFilter = "(uid=%s)"
+	}
+	if config.Attributes.Username == "" {
+		config.Attributes.Username = "uid"
+	}
+	if config.Attributes.Email == "" {
+		config.Attributes.Email = "mail"
+	}
+	if config.Attributes.Name == "" {
+		config.Attributes.Name = "cn"
+	}
+	if config.Timeout == 0 {
+		config.Timeout = 10 * time.Second
+	}
+
+	return &Client{
+		config: config,
+	}
+}
+
+// Authenticate verifies the login and password against the directory and
+// returns the mapped user entry
+func (c *Client) Authenticate(login, password string) (*Entry, error) {
+	if c.config.URL == "" {
+		return nil, ErrNotConfigured
+	}
+
+	// An empty password would be treated as an unauthenticated bind and succeed
+	if login == "" || password == "" {
+		return nil, ErrInvalidCredentials
+	}
+
+	conn, err := c.dial()
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	// Bind as the service account to search for the user
+	if c.config.BindDN != "" {
+		if err := conn.Bind(c.config.BindDN, c.config.BindPassword); err != nil {
+			return nil, fmt.Errorf("ldap: service bind failed: %w", err)
+		}
+	}
+
+	entry, err := c.findUser(conn, login)
+	if err != nil {
+		return nil, err
+	}
+
+	// Bind as the user to verify the password
+	if err := conn.Bind(entry.DN, password); err != nil {
+		if goldap.IsErrorWithCode(err, goldap.LDAPResultInvalidCredentials) {
+			return nil, ErrInvalidCredentials
+		}
+		return nil, fmt.Errorf("ldap: user bind failed: %w", err)
+	}
+
+	return entry, nil
+}
+
+// dial opens a connection to the directory, upgrading to TLS if configured
+func (c *Client) dial() (*goldap.Conn, error) {
+	tlsConfig := &tls.Config{InsecureSkipVerify: c.config.InsecureSkipVerify}
+
+	conn, err := goldap.DialURL(c.config.URL, goldap.DialWithTLSConfig(tlsConfig))
+	if err != nil {
+		return nil, fmt.Errorf("ldap: failed to connect: %w", err)
+	}
+	conn.SetTimeout(c.config.Timeout)
+
+	if c.config.StartTLS {
+		if err := conn.StartTLS(tlsConfig); err != nil {
+			conn.Close()
+			return nil, fmt.Errorf("ldap: failed to start TLS: %w", err)
+		}
+	}
+
+	return conn, nil
+}
+
+// findUser searches for exactly one entry matching the user filter
+func (c *Client) findUser(conn *goldap.Conn, login string) (*Entry, error) {
+	attrs := c.config.Attributes
+	requested := []string{"dn", attrs.Username, attrs.Email, attrs.Name}
+	if attrs.ID != "" {
+		requested = append(requested, attrs.ID)
+	}
+
+	filter := strings.ReplaceAll(c.config.UserFilter, "%s", goldap.EscapeFilter(login))
+	searchRequest := goldap.NewSearchRequest(
+		c.config.BaseDN,
+		goldap.ScopeWholeSubtree, goldap.NeverDerefAliases,
+		2, // Only need to know whether the filter is ambiguous
+		int(c.config.Timeout.Seconds()),
+		false,
+		filter,
+		requested,
+		nil,
+	)
+
+	result, err := conn.Search(searchRequest)
+	if err != nil {
+		if goldap.IsErrorWithCode(err, goldap.LDAPResultNoSuchObject) {
+			return nil, ErrUserNotFound
+		}
+		if goldap.IsErrorWithCode(err, goldap.LDAPResultSizeLimitExceeded) {
+			return nil, fmt.Errorf("ldap: filter %q matched multiple entries", filter)
+		}
+		return nil, fmt.Errorf("ldap: search failed: %w", err)
+	}
+
+	if len(result.Entries) == 0 {
+		return nil, ErrUserNotFound
+	}
+	if len(result.Entries) > 1 {
+		return nil, fmt.Errorf("ldap: filter %q matched multiple entries", filter)
+	}
+
+	e := result.Entries[0]
+	entry := &Entry{
+		DN:       e.DN,
+		ID:       e.DN,
+		Username: e.GetAttributeValue(attrs.Username),
+		Email:    e.GetAttributeValue(attrs.Email),
+		Name:     e.GetAttributeValue(attrs.Name),
+	}
+	if attrs.ID != "" {
+		if id := e.GetAttributeValue(attrs.ID); id != "" {
+			entry.ID = id
+		}
+	}
+
+	return entry, nil
+}
@@ -0,0 +1,109 @@
+package ldap
+
+import (
+	"testing"
+
+	"github.com/yourusername/issue-tracker/pkg/ldap/ldaptest"
+)
+
+func TestClient_Authenticate(t *testing.T) {
+	srv := ldaptest.NewServer(t, []ldaptest.Entry{
+		{
+			DN:       "cn=service,dc=example,dc=com",
+			Password: "service-secret",
+		},
+		{
+			DN:       "uid=alice,ou=people,dc=example,dc=com",
+			Password: "alice-secret",
+			Attrs: map[string]string{
+				"uid":       "alice",
+				"mail":      "alice@example.com",
+				"cn":        "Alice Kim",
+				"entryUUID": "5b1f0c9e-0000-4000-8000-000000000001",
+			},
+		},
+	})
+
+	client := NewClient(Config{
+		URL:          srv.URL(),
+		BindDN:       "cn=service,dc=example,dc=com",
+		BindPassword: "service-secret",
+		BaseDN:       "dc=example,dc=com",
+		Attributes:   AttributeMapping{ID: "entryUUID"},
+	})
+
+	t.Run("should authenticate and map attributes", func(t *testing.T) {
+		entry, err := client.Authenticate("alice", "alice-secret")
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		if entry.DN != "uid=alice,ou=people,dc=example,dc=com" {
+			t.Errorf("Expected DN of alice, got %s", entry.DN)
+		}
+		if entry.ID != "5b1f0c9e-0000-4000-8000-000000000001" {
+			t.Errorf("Expected ID from entryUUID, got %s", entry.ID)
+		}
+		if entry.Username != "alice" {
+			t.Errorf("Expected username alice, got %s", entry.Username)
+		}
+		if entry.Email != "alice@example.com" {
+			t.Errorf("Expected email alice@example.com, got %s", entry.Email)
+		}
+		if entry.Name != "Alice Kim" {
+			t.Errorf("Expected name Alice Kim, got %s", entry.Name)
+		}
+	})
+
+	t.Run("should reject wrong password", func(t *testing.T) {
+		_, err := client.Authenticate("alice", "wrong")
+		if err != ErrInvalidCredentials {
+			t.Errorf("Expected ErrInvalidCredentials, got %v", err)
+		}
+	})
+
+	t.Run("should reject empty password", func(t *testing.T) {
+		_, err := client.Authenticate("alice", "")
+		if err != ErrInvalidCredentials {
+			t.Errorf("Expected ErrInvalidCredentials, got %v", err)
+		}
+	})
+
+	t.Run("should return not found for unknown user", func(t *testing.T) {
+		_, err := client.Authenticate("bob", "whatever")
+		if err != ErrUserNotFound {
+			t.Errorf("Expected ErrUserNotFound, got %v", err)
+		}
+	})
+
+	t.Run("should fail when service bind is rejected", func(t *testing.T) {
+		badClient := NewClient(Config{
+			URL:          srv.URL(),
+			BindDN:       "cn=service,dc=example,dc=com",
+			BindPassword: "wrong",
+			BaseDN:       "dc=example,dc=com",
+		})
+
+		_, err := badClient.Authenticate("alice", "alice-secret")
+		if err == nil || err == ErrInvalidCredentials {
+			t.Errorf("Expected service bind error, got %v", err)
+		}
+	})
+
+	t.Run("should fall back to DN when ID attribute is not mapped", func(t *testing.T) {
+		dnClient := NewClient(Config{
+			URL:          srv.URL(),
+			BindDN:       "cn=service,dc=example,dc=com",
+			BindPassword: "service-secret",
+			BaseDN:       "dc=example,dc=com",
+		})
+
+		entry, err := dnClient.Authenticate("alice", "alice-secret")
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if entry.ID != entry.DN {
+			t.Errorf("Expected ID to equal DN, got %s", entry.ID)
+		}
+	})
+}
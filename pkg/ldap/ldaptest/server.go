@@ -0,0 +1,131 @@
+// Package ldaptest provides a minimal in-process LDAP server for tests
+package ldaptest
+
+import (
+	"fmt"
+	"net"
+	"testing"
+
+	ber "github.com/go-asn1-ber/asn1-ber"
+	goldap "github.com/go-ldap/ldap/v3"
+)
+
+// Entry is a directory entry served by the test server
+type Entry struct {
+	DN       string
+	Password string
+	Attrs    map[string]string
+}
+
+// Server is a minimal in-process LDAP server supporting simple bind and
+// equality searches on uid, which is all the client needs
+type Server struct {
+	listener net.Listener
+	entries  []Entry
+}
+
+// NewServer starts a server for the entries; it stops when the test ends
+func NewServer(t testing.TB, entries []Entry) *Server {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Failed to listen: %v", err)
+	}
+
+	srv := &Server{listener: listener, entries: entries}
+	go srv.serve()
+	t.Cleanup(func() { listener.Close() })
+
+	return srv
+}
+
+// URL returns the ldap:// URL of the server
+func (s *Server) URL() string {
+	return "ldap://" + s.listener.Addr().String()
+}
+
+func (s *Server) serve() {
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+		go s.handle(conn)
+	}
+}
+
+func (s *Server) handle(conn net.Conn) {
+	defer conn.Close()
+
+	for {
+		packet, err := ber.ReadPacket(conn)
+		if err != nil || len(packet.Children) < 2 {
+			return
+		}
+
+		messageID := packet.Children[0].Value.(int64)
+		op := packet.Children[1]
+
+		switch op.Tag {
+		case goldap.ApplicationBindRequest:
+			dn := op.Children[1].Value.(string)
+			password := op.Children[2].Data.String()
+			code := uint16(goldap.LDAPResultInvalidCredentials)
+			if dn == "" || s.checkPassword(dn, password) {
+				code = goldap.LDAPResultSuccess
+			}
+			s.write(conn, messageID, ldapResult(goldap.ApplicationBindResponse, code))
+		case goldap.ApplicationSearchRequest:
+			filter, _ := goldap.DecompileFilter(op.Children[6])
+			for _, entry := range s.entries {
+				if filter == fmt.Sprintf("(uid=%s)", entry.Attrs["uid"]) {
+					s.write(conn, messageID, searchEntry(entry))
+				}
+			}
+			s.write(conn, messageID, ldapResult(goldap.ApplicationSearchResultDone, goldap.LDAPResultSuccess))
+		case goldap.ApplicationUnbindRequest:
+			return
+		}
+	}
+}
+
+func (s *Server) checkPassword(dn, password string) bool {
+	for _, entry := range s.entries {
+		if entry.DN == dn {
+			return entry.Password == password
+		}
+	}
+	return false
+}
+
+func (s *Server) write(conn net.Conn, messageID int64, op *ber.Packet) {
+	envelope := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "LDAP Response")
+	envelope.AppendChild(ber.NewInteger(ber.ClassUniversal, ber.TypePrimitive, ber.TagInteger, messageID, "MessageID"))
+	envelope.AppendChild(op)
+	conn.Write(envelope.Bytes())
+}
+
+func ldapResult(application ber.Tag, code uint16) *ber.Packet {
+	result := ber.Encode(ber.ClassApplication, ber.TypeConstructed, application, nil, "Result")
+	result.AppendChild(ber.NewInteger(ber.ClassUniversal, ber.TypePrimitive, ber.TagEnumerated, int64(code), "Result Code"))
+	result.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, "", "Matched DN"))
+	result.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, "", "Diagnostic Message"))
+	return result
+}
+
+func searchEntry(entry Entry) *ber.Packet {
+	result := ber.Encode(ber.ClassApplication, ber.TypeConstructed, goldap.ApplicationSearchResultEntry, nil, "Search Result Entry")
+	result.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, entry.DN, "DN"))
+
+	attributes := ber.NewSequence("Attributes")
+	for name, value := range entry.Attrs {
+		attribute := ber.NewSequence("Attribute")
+		attribute.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, name, "Type"))
+		values := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSet, nil, "Values")
+		values.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, value, "Value"))
+		attribute.AppendChild(values)
+		attributes.AppendChild(attribute)
+	}
+	result.AppendChild(attributes)
+
+	return result
+}